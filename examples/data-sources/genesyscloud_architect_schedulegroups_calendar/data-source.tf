@@ -0,0 +1,12 @@
+data "genesyscloud_architect_schedulegroups_calendar" "december" {
+  schedule_group_id = genesyscloud_architect_schedulegroups.sample_schedule_groups.id
+  start_date        = "2024-12-01"
+  end_date          = "2024-12-31"
+}
+
+check "schedule_overlaps" {
+  assert {
+    condition     = length(data.genesyscloud_architect_schedulegroups_calendar.december.overlaps) == 0
+    error_message = "Schedules overlap from ${join(", ", [for overlap in data.genesyscloud_architect_schedulegroups_calendar.december.overlaps : "${overlap.start} to ${overlap.end}"])}."
+  }
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_auth_role_permissions" "agent_role" {
+  role_id = genesyscloud_auth_role.agent_role.id
+}
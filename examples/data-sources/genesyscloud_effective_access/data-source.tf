@@ -0,0 +1,17 @@
+data "genesyscloud_effective_access" "agent" {
+  user_id = genesyscloud_user.agent.id
+}
+
+data "genesyscloud_effective_access" "queue_editors" {
+  object {
+    permission  = "routing:queue:edit"
+    division_id = genesyscloud_routing_queue.support.division_id
+  }
+}
+
+check "agent_cannot_edit_queues" {
+  assert {
+    condition     = !contains(data.genesyscloud_effective_access.agent.permissions[*].permission, "routing:queue:edit")
+    error_message = "Agents must not be able to edit queues."
+  }
+}
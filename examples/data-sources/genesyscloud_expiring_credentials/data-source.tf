@@ -0,0 +1,13 @@
+data "genesyscloud_expiring_credentials" "soon" {
+  within_days = 45
+}
+
+check "no_expiring_certificates" {
+  assert {
+    condition = length(data.genesyscloud_expiring_credentials.soon.items) == 0
+    error_message = join("\n", [
+      for item in data.genesyscloud_expiring_credentials.soon.items :
+      "${item.resource_type} ${item.resource_name}: ${item.subject} expires on ${item.not_after}"
+    ])
+  }
+}
@@ -0,0 +1,17 @@
+data "genesyscloud_location_address_validation" "headquarters" {
+  address {
+    street1  = "7601 Interactive Way"
+    city     = "Indianapolis"
+    state    = "IN"
+    zip_code = "46278"
+    country  = "US"
+  }
+  emergency_number = "+13172222222"
+}
+
+check "headquarters_address" {
+  assert {
+    condition     = data.genesyscloud_location_address_validation.headquarters.valid
+    error_message = "The headquarters location failed validation: ${join("; ", data.genesyscloud_location_address_validation.headquarters.messages)}"
+  }
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_outbound_campaign_stats" "campaign_stats" {
+  campaign_id = genesyscloud_outbound_campaign.campaign.id
+}
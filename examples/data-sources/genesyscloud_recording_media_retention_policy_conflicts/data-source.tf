@@ -0,0 +1,30 @@
+data "genesyscloud_recording_media_retention_policy_conflicts" "sales_calls" {
+  policies {
+    name    = "Sales calls"
+    order   = 1
+    enabled = true
+    media_policies {
+      call_policy {
+        conditions {
+          for_queue_ids = [genesyscloud_routing_queue.sales.id]
+          directions    = ["INBOUND"]
+        }
+        actions {
+          retain_recording = true
+          retention_duration {
+            delete_retention {
+              days = 90
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+check "media_retention_policies_do_not_conflict" {
+  assert {
+    condition     = !data.genesyscloud_recording_media_retention_policy_conflicts.sales_calls.has_conflicts
+    error_message = "Media retention policies conflict: ${join("; ", [for conflict in data.genesyscloud_recording_media_retention_policy_conflicts.sales_calls.conflicts : "${conflict.media_type}: ${conflict.description}"])}"
+  }
+}
@@ -0,0 +1,15 @@
+data "genesyscloud_recording_media_retention_policy_simulation" "sales_call" {
+  media_type       = "call"
+  queue_id         = genesyscloud_routing_queue.sales.id
+  user_id          = genesyscloud_user.agent.id
+  direction        = "INBOUND"
+  duration_seconds = 300
+  timestamp        = "2024-05-01T14:30:00Z"
+}
+
+check "sales_calls_are_evaluated" {
+  assert {
+    condition     = anytrue([for policy in data.genesyscloud_recording_media_retention_policy_simulation.sales_call.matched_policies : length(policy.evaluation_form_ids) > 0])
+    error_message = "No media retention policy assigns an evaluation to sales calls. Policies: ${join("; ", [for policy in data.genesyscloud_recording_media_retention_policy_simulation.sales_call.policies : "${policy.name}: ${policy.matched ? "matched" : join(", ", policy.reasons)}"])}"
+  }
+}
@@ -0,0 +1,11 @@
+data "genesyscloud_site_dial_plan_test" "emergency" {
+  site_id        = genesyscloud_telephony_providers_edges_site.site.id
+  dialed_strings = ["911", "3175550100", "+442071234567"]
+}
+
+check "emergency_dial_plan" {
+  assert {
+    condition     = data.genesyscloud_site_dial_plan_test.emergency.results[0].classification == "Emergency"
+    error_message = "911 is classified as ${data.genesyscloud_site_dial_plan_test.emergency.results[0].classification}"
+  }
+}
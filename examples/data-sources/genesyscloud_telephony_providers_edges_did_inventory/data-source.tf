@@ -0,0 +1,9 @@
+data "genesyscloud_telephony_providers_edges_did_inventory" "all" {
+}
+
+check "did_assignments" {
+  assert {
+    condition     = length(data.genesyscloud_telephony_providers_edges_did_inventory.all.conflicting_numbers) == 0
+    error_message = "Numbers assigned in two places: ${join(", ", data.genesyscloud_telephony_providers_edges_did_inventory.all.conflicting_numbers)}"
+  }
+}
@@ -0,0 +1,10 @@
+data "genesyscloud_telephony_providers_edges_edge_health" "site" {
+  site_id = genesyscloud_telephony_providers_edges_site.site1.id
+}
+
+check "site_edges_online" {
+  assert {
+    condition     = data.genesyscloud_telephony_providers_edges_edge_health.site.all_online
+    error_message = "Offline edges: ${join(", ", [for edge in data.genesyscloud_telephony_providers_edges_edge_health.site.edges : edge.name if !edge.online])}"
+  }
+}
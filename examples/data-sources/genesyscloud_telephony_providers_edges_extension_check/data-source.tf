@@ -0,0 +1,23 @@
+data "genesyscloud_telephony_providers_edges_extension_check" "extensions" {
+  extension_pools {
+    start_number = genesyscloud_telephony_providers_edges_extension_pool.agents.start_number
+    end_number   = genesyscloud_telephony_providers_edges_extension_pool.agents.end_number
+  }
+
+  extensions {
+    extension = "1001"
+    owner     = genesyscloud_user.jane.email
+  }
+
+  extensions {
+    extension = "1100"
+    owner     = "group sales"
+  }
+}
+
+check "extensions" {
+  assert {
+    condition     = data.genesyscloud_telephony_providers_edges_extension_check.extensions.valid
+    error_message = "Unpooled extensions: ${join(", ", data.genesyscloud_telephony_providers_edges_extension_check.extensions.unpooled_extensions)}. Duplicate extensions: ${join(", ", [for duplicate in data.genesyscloud_telephony_providers_edges_extension_check.extensions.duplicate_extensions : "${duplicate.extension} (${join(", ", duplicate.owners)})"])}. Overlapping pools: ${join(", ", data.genesyscloud_telephony_providers_edges_extension_check.extensions.overlapping_pools)}."
+  }
+}
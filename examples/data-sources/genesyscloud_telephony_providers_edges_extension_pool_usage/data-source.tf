@@ -0,0 +1,9 @@
+data "genesyscloud_telephony_providers_edges_extension_pool_usage" "all" {
+}
+
+check "extension_pool_capacity" {
+  assert {
+    condition     = alltrue([for pool in data.genesyscloud_telephony_providers_edges_extension_pool_usage.all.pools : pool.free >= 10])
+    error_message = "Extension pools running out of extensions: ${join(", ", [for pool in data.genesyscloud_telephony_providers_edges_extension_pool_usage.all.pools : "${pool.start_number}-${pool.end_number}" if pool.free < 10])}"
+  }
+}
@@ -0,0 +1,22 @@
+data "genesyscloud_telephony_providers_edges_trunk_health" "carrier" {
+  site_id                = genesyscloud_telephony_providers_edges_site.site1.id
+  trunk_type             = "EXTERNAL"
+  trunk_base_settings_id = genesyscloud_telephony_providers_edges_trunkbasesettings.trunk-base-settings1.id
+}
+
+// To enable this resource, set ENABLE_STANDALONE_OUTBOUND_ROUTES as an environment variable
+resource "genesyscloud_telephony_providers_edges_site_outbound_routes" "carrier" {
+  site_id                 = genesyscloud_telephony_providers_edges_site.site1.id
+  name                    = "Carrier"
+  classification_types    = ["International", "National"]
+  external_trunk_base_ids = [genesyscloud_telephony_providers_edges_trunkbasesettings.trunk-base-settings1.id]
+  distribution            = "SEQUENTIAL"
+  enabled                 = true
+
+  lifecycle {
+    precondition {
+      condition     = data.genesyscloud_telephony_providers_edges_trunk_health.carrier.all_connected
+      error_message = "Carrier trunks are not connected: ${join(", ", [for trunk in data.genesyscloud_telephony_providers_edges_trunk_health.carrier.trunks : "${trunk.name} (${trunk.last_error})" if trunk.enabled && !trunk.connected])}"
+    }
+  }
+}
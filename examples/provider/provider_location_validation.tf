@@ -0,0 +1,6 @@
+provider "genesyscloud" {
+  location_validation {
+    address_validation_endpoint = "https://addresses.example.com/validate"
+    timeout_seconds             = 5
+  }
+}
@@ -0,0 +1,24 @@
+provider "genesyscloud" {
+  policy {
+    rule {
+      resource_type = "genesyscloud_routing_queue"
+      attribute     = "name"
+      pattern       = "^(SALES|SUPPORT|BILLING) - "
+      message       = "Queue names start with the business unit, e.g. \"SALES - Inbound\"."
+    }
+
+    rule {
+      resource_type = "genesyscloud_routing_wrapupcode"
+      attribute     = "name"
+      pattern       = "^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$"
+      message       = "Wrap-up codes are in upper snake case."
+    }
+
+    rule {
+      attribute = "description"
+      pattern   = "\\S"
+      severity  = "warning"
+      message   = "Describe every object that has a description."
+    }
+  }
+}
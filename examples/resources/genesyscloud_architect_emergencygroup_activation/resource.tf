@@ -0,0 +1,18 @@
+resource "genesyscloud_architect_emergencygroup" "weather_closure" {
+  name = "Weather Closure"
+  emergency_call_flows {
+    emergency_flow_id = genesyscloud_flow.flow.id
+    ivr_ids           = [genesyscloud_architect_ivr.ivr1.id]
+  }
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
+
+resource "genesyscloud_architect_emergencygroup_activation" "holiday_closure" {
+  emergency_group_id = genesyscloud_architect_emergencygroup.weather_closure.id
+  activation_window {
+    start = "2024-12-24T18:00:00Z"
+    end   = "2024-12-26T08:00:00Z"
+  }
+}
@@ -0,0 +1,23 @@
+resource "genesyscloud_auth_division_assignment" "emea" {
+  division_id = genesyscloud_auth_division.emea.id
+  batch_size  = 50
+
+  objects {
+    type = "USER"
+    ids  = [genesyscloud_user.emea_supervisor.id, genesyscloud_user.emea_agent.id]
+  }
+
+  selector {
+    type       = "QUEUE"
+    name_regex = "^EMEA "
+  }
+
+  selector {
+    type       = "FLOW"
+    name_regex = "(?i)emea"
+  }
+}
+
+output "division_move_failures" {
+  value = genesyscloud_auth_division_assignment.emea.failed_objects
+}
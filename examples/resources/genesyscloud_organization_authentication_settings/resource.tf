@@ -1,16 +1,23 @@
 resource "genesyscloud_organization_authentication_settings" "example-authentication-settings" {
-  multifactorAuthenticationRequired = true
-  domainAllowlistEnabled            = true
-  domainAllowlist                   = ["example.com", "example2.com"]
-  ipAddressAllowlist                = ["0.0.0.0/32"]
-  passwordRequirements {
-    minimumLength     = 8
-    minimumDigits     = 5
-    minimumLetters    = 2
-    minimumUpper      = 1
-    minimumLower      = 1
-    minimumSpecials   = 1
-    minimumAgeSeconds = 2
-    expirationDays    = 90
+  multifactor_authentication_required = true
+  domain_allowlist_enabled            = true
+  domain_allowlist                    = ["example.com", "example2.com"]
+  ip_address_allowlist                = ["203.0.113.0/24", "198.51.100.7/32"]
+  password_requirements {
+    minimum_length      = 8
+    minimum_digits      = 5
+    minimum_letters     = 2
+    minimum_upper       = 1
+    minimum_lower       = 1
+    minimum_specials    = 1
+    minimum_age_seconds = 2
+    expiration_days     = 90
   }
-}
\ No newline at end of file
+}
+
+output "ip_address_allowlist_changes" {
+  value = {
+    added   = genesyscloud_organization_authentication_settings.example-authentication-settings.ip_address_allowlist_added
+    removed = genesyscloud_organization_authentication_settings.example-authentication-settings.ip_address_allowlist_removed
+  }
+}
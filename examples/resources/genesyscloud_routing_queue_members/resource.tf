@@ -0,0 +1,20 @@
+// WARNING: In authoritative mode this resource removes every user member of the queue that it does not declare
+// Do not also set the members attribute of the genesyscloud_routing_queue resource for the same queue
+resource "genesyscloud_routing_queue_members" "example-name" {
+  queue_id     = genesyscloud_routing_queue.example-queue.id
+  mode         = "additive"
+  members_file = "${path.module}/members.csv"
+  chunk_size   = 50
+  members {
+    user_id  = genesyscloud_user.example-user.id
+    ring_num = 2
+  }
+  member_selector {
+    department = "Support"
+    skill_ids  = [genesyscloud_routing_skill.example-skill.id]
+  }
+  member_selector {
+    location_ids = [genesyscloud_location.example-location.id]
+    ring_num     = 3
+  }
+}
\ No newline at end of file
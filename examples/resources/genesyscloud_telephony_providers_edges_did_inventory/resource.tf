@@ -0,0 +1,10 @@
+resource "genesyscloud_telephony_providers_edges_did_inventory" "porting" {
+  did_pool_ids = [genesyscloud_telephony_providers_edges_did_pool.main.id]
+
+  # Numbers held back while they are ported to the new carrier
+  reserved_numbers = ["+13175550140", "+13175550141"]
+}
+
+output "numbers_assigned_twice" {
+  value = genesyscloud_telephony_providers_edges_did_inventory.porting.conflicting_numbers
+}
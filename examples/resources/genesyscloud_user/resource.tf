@@ -74,4 +74,11 @@ resource "genesyscloud_user" "example_user" {
       interrupting_label_ids = [genesyscloud_routing_utilization_label.red_label.id]
     }
   }
+  lifecycle_on_destroy     = "inactivate"
+  reactivate_inactive_user = true
+  inactivation_rules {
+    remove_queue_memberships = true
+    remove_roles             = true
+    remove_stations          = true
+  }
 }
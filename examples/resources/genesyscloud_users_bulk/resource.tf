@@ -0,0 +1,7 @@
+resource "genesyscloud_users_bulk" "agents" {
+  filepath           = "${path.module}/users.csv"
+  file_content_hash  = filesha256("${path.module}/users.csv")
+  max_concurrency    = 10
+  deactivate_missing = true
+  destroy_action     = "deactivate"
+}
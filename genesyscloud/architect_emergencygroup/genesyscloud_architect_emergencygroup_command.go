@@ -0,0 +1,179 @@
+package architect_emergencygroup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/user"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_architect_emergencygroup_command.go file contains the emergency group command of the provider binary.
+It enables or disables an emergency group directly, without a Terraform run, for use during an incident:
+
+	terraform-provider-genesyscloud emergency-group -name "Weather Closure" -enabled=true -reason "Office closed due to snow"
+
+The command authenticates with the same GENESYSCLOUD_OAUTHCLIENT_ID, GENESYSCLOUD_OAUTHCLIENT_SECRET and GENESYSCLOUD_REGION
+environment variables as the provider. Every change is written as a JSON audit record to stderr and, if -audit-log is set,
+appended to that file.
+*/
+
+// CommandName is the first argument that runs the emergency group command instead of the provider
+const CommandName = "emergency-group"
+
+type toggleCommandOptions struct {
+	id       string
+	name     string
+	enabled  bool
+	reason   string
+	auditLog string
+}
+
+// auditRecord is the audit log entry written for each run of the command
+type auditRecord struct {
+	Time             string `json:"time"`
+	EmergencyGroupId string `json:"emergencyGroupId"`
+	Name             string `json:"name,omitempty"`
+	PreviousEnabled  bool   `json:"previousEnabled"`
+	Enabled          bool   `json:"enabled"`
+	Changed          bool   `json:"changed"`
+	Reason           string `json:"reason"`
+	User             string `json:"user,omitempty"`
+	OAuthClientId    string `json:"oauthClientId,omitempty"`
+}
+
+// RunCommand runs the emergency group command with the arguments that follow the command name
+func RunCommand(args []string) error {
+	options, err := parseToggleCommandArgs(args, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	sdkConfig, err := provider.AuthorizeSdk()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	ap := getArchitectEmergencyGroupProxy(sdkConfig)
+
+	emergencyGroupId := options.id
+	if emergencyGroupId == "" {
+		emergencyGroupId, err = getEmergencyGroupIdByName(ctx, ap, options.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	previous, diagErr := setEmergencyGroupEnabled(ctx, ap, emergencyGroupId, options.enabled)
+	if diagErr != nil {
+		return fmt.Errorf("failed to set enabled to %t on emergency group %s: %v", options.enabled, emergencyGroupId, diagErr)
+	}
+
+	record := auditRecord{
+		Time:             time.Now().UTC().Format(time.RFC3339),
+		EmergencyGroupId: emergencyGroupId,
+		Name:             options.name,
+		PreviousEnabled:  previous,
+		Enabled:          options.enabled,
+		Changed:          previous != options.enabled,
+		Reason:           options.reason,
+		OAuthClientId:    os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"),
+	}
+	if currentUser, err := user.Current(); err == nil {
+		record.User = currentUser.Username
+	}
+	return writeAuditRecord(record, os.Stderr, options.auditLog)
+}
+
+func parseToggleCommandArgs(args []string, output io.Writer) (*toggleCommandOptions, error) {
+	options := &toggleCommandOptions{}
+	flags := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.StringVar(&options.id, "id", "", "ID of the emergency group. Either -id or -name must be set.")
+	flags.StringVar(&options.name, "name", "", "Name of the emergency group. Either -id or -name must be set.")
+	flags.BoolVar(&options.enabled, "enabled", false, "Whether the emergency group should be active. Required.")
+	flags.StringVar(&options.reason, "reason", "", "Why the emergency group is being changed, recorded in the audit log. Required.")
+	flags.StringVar(&options.auditLog, "audit-log", os.Getenv("GENESYSCLOUD_EMERGENCYGROUP_AUDIT_LOG"), "File to append audit records to. Defaults to the GENESYSCLOUD_EMERGENCYGROUP_AUDIT_LOG environment variable.")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	enabledSet := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "enabled" {
+			enabledSet = true
+		}
+	})
+
+	var errs []error
+	if (options.id == "") == (options.name == "") {
+		errs = append(errs, fmt.Errorf("exactly one of -id or -name must be set"))
+	}
+	if !enabledSet {
+		errs = append(errs, fmt.Errorf("-enabled must be set"))
+	}
+	if options.reason == "" {
+		errs = append(errs, fmt.Errorf("-reason must be set"))
+	}
+	if flags.NArg() > 0 {
+		errs = append(errs, fmt.Errorf("unexpected arguments %v", flags.Args()))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return options, nil
+}
+
+func getEmergencyGroupIdByName(ctx context.Context, ap *architectEmergencyGroupProxy, name string) (string, error) {
+	emergencyGroups, resp, err := ap.getArchitectEmergencyGroupIdByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to search for emergency group %s: %v %v", name, err, resp)
+	}
+	var matches []platformclientv2.Emergencygroup
+	if emergencyGroups.Entities != nil {
+		for _, emergencyGroup := range *emergencyGroups.Entities {
+			if emergencyGroup.Name != nil && *emergencyGroup.Name == name {
+				matches = append(matches, emergencyGroup)
+			}
+		}
+	}
+	if len(matches) != 1 {
+		return "", fmt.Errorf("found %d emergency groups named %s, use -id instead", len(matches), name)
+	}
+	return *matches[0].Id, nil
+}
+
+// writeAuditRecord writes the record as a JSON line to output and, if auditLog is set, appends it to that file
+func writeAuditRecord(record auditRecord, output io.Writer, auditLog string) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if _, err := output.Write(line); err != nil {
+		log.Printf("Failed to write audit record: %v", err)
+	}
+	if auditLog == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("emergency group %s was updated but the audit log %s could not be opened: %v", record.EmergencyGroupId, auditLog, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(line); err != nil {
+		return fmt.Errorf("emergency group %s was updated but the audit log %s could not be written: %v", record.EmergencyGroupId, auditLog, err)
+	}
+	return nil
+}
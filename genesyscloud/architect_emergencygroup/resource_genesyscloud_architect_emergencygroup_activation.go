@@ -0,0 +1,117 @@
+package architect_emergencygroup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_architect_emergencygroup_activation.go file manages the activation of an existing emergency group.
+It only ever changes the enabled flag of the group and always reads back the actual state, so that the group can also be
+toggled outside of Terraform without the next apply reverting it when `enabled` is in `ignore_changes`.
+*/
+
+func createEmergencyGroupActivation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	emergencyGroupId := d.Get("emergency_group_id").(string)
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	ap := getArchitectEmergencyGroupProxy(sdkConfig)
+
+	if enabled, ok := getDesiredActivation(d); ok {
+		if _, diagErr := setEmergencyGroupEnabled(ctx, ap, emergencyGroupId, enabled); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	d.SetId(emergencyGroupId)
+	log.Printf("Managing activation of emergency group %s", emergencyGroupId)
+	return readEmergencyGroupActivation(ctx, d, meta)
+}
+
+func readEmergencyGroupActivation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	ap := getArchitectEmergencyGroupProxy(sdkConfig)
+
+	log.Printf("Reading activation of emergency group %s", d.Id())
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		emergencyGroup, resp, getErr := ap.getArchitectEmergencyGroup(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(activationResourceName, fmt.Sprintf("Failed to read emergency group %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(activationResourceName, fmt.Sprintf("Failed to read emergency group %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		if emergencyGroup.State != nil && *emergencyGroup.State == "deleted" {
+			d.SetId("")
+			return nil
+		}
+
+		_ = d.Set("emergency_group_id", d.Id())
+		_ = d.Set("enabled", emergencyGroup.Enabled != nil && *emergencyGroup.Enabled)
+
+		log.Printf("Read activation of emergency group %s", d.Id())
+		return nil
+	})
+}
+
+func updateEmergencyGroupActivation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	ap := getArchitectEmergencyGroupProxy(sdkConfig)
+
+	if enabled, ok := getDesiredActivation(d); ok {
+		if _, diagErr := setEmergencyGroupEnabled(ctx, ap, d.Id(), enabled); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Finished updating activation of emergency group %s", d.Id())
+	return readEmergencyGroupActivation(ctx, d, meta)
+}
+
+func deleteEmergencyGroupActivation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("Leaving emergency group %s in its current state", d.Id())
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	ap := getArchitectEmergencyGroupProxy(sdkConfig)
+
+	if _, diagErr := setEmergencyGroupEnabled(ctx, ap, d.Id(), false); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Disabled emergency group %s", d.Id())
+	return nil
+}
+
+// customizeEmergencyGroupActivationDiff validates the activation window and plans the enabled state the window calls for at the time of the plan
+func customizeEmergencyGroupActivationDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	window, ok := diff.GetOk("activation_window")
+	if !ok || len(window.([]interface{})) == 0 || window.([]interface{})[0] == nil {
+		return nil
+	}
+	windowSettings := window.([]interface{})[0].(map[string]interface{})
+	start, end, err := parseActivationWindow(windowSettings["start"].(string), windowSettings["end"].(string))
+	if err != nil {
+		// Unknown values are validated on a later plan
+		if windowSettings["start"].(string) == "" || windowSettings["end"].(string) == "" {
+			return nil
+		}
+		return err
+	}
+
+	enabled := isWithinActivationWindow(time.Now(), start, end)
+	if diff.Get("enabled").(bool) != enabled {
+		return diff.SetNew("enabled", enabled)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package architect_emergencygroup
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud/architect_flow"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceArchitectEmergencyGroupActivation(t *testing.T) {
+	var (
+		groupResource      = "test_emergency_group"
+		activationResource = "test_activation"
+		activationFullName = "genesyscloud_architect_emergencygroup_activation." + activationResource
+		name               = "Test Group " + uuid.NewString()
+
+		flowResource      = "test_flow"
+		flowName          = "Terraform Emergency Activation Test Flow " + uuid.NewString()
+		flowFilePath      = "../../examples/resources/genesyscloud_flow/inboundcall_flow_example.yaml"
+		inboundCallConfig = fmt.Sprintf("inboundCall:\n  name: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n  initialGreeting:\n    tts: Archy says hi!!!\n  menus:\n    - menu:\n        name: Main Menu\n        audio:\n          tts: You are at the Main Menu, press 9 to disconnect.\n        refId: mainMenu\n        choices:\n          - menuDisconnect:\n              name: Disconnect\n              dtmf: digit_9", flowName)
+
+		windowStart = time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+		windowEnd   = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	)
+
+	config, err := provider.AuthorizeSdk()
+	if err != nil {
+		t.Skip("failed to authorize client credentials")
+	}
+
+	ivrId := "f94e084e-40eb-470b-80d6-0f99cf22d102"
+	if v := os.Getenv("GENESYSCLOUD_REGION"); v == "tca" {
+		ivrId = "770e3998-11b7-4c96-beb8-215b83201c29"
+	}
+
+	if !ivrExists(config, ivrId) {
+		t.Skip("Skipping because IVR does not exists in the target org.")
+	}
+
+	groupConfig := architect_flow.GenerateFlowResource(
+		flowResource,
+		flowFilePath,
+		inboundCallConfig,
+		false,
+	) + fmt.Sprintf(`resource "genesyscloud_architect_emergencygroup" "%s" {
+		name = "%s"
+		%s
+		lifecycle {
+			ignore_changes = [enabled]
+		}
+	}
+	`, groupResource, name, generateEmergencyCallFlow("genesyscloud_flow."+flowResource+".id", strconv.Quote(ivrId)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: groupConfig + generateEmergencyGroupActivationResource(activationResource, groupResource, "enabled = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(activationFullName, "emergency_group_id", "genesyscloud_architect_emergencygroup."+groupResource, "id"),
+					resource.TestCheckResourceAttr(activationFullName, "enabled", util.TrueValue),
+				),
+			},
+			{
+				Config: groupConfig + generateEmergencyGroupActivationResource(activationResource, groupResource, "enabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(activationFullName, "enabled", util.FalseValue),
+				),
+			},
+			{
+				// A window that has already ended leaves the group disabled
+				Config: groupConfig + generateEmergencyGroupActivationResource(activationResource, groupResource, fmt.Sprintf(`activation_window {
+					start = "%s"
+					end   = "%s"
+				}`, windowStart, windowEnd)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(activationFullName, "enabled", util.FalseValue),
+					resource.TestCheckResourceAttr(activationFullName, "activation_window.0.start", windowStart),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            activationFullName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_window", "disable_on_destroy"},
+			},
+		},
+		CheckDestroy: testVerifyEmergencyGroupDestroyed,
+	})
+}
+
+func generateEmergencyGroupActivationResource(activationResource string, groupResource string, attrs string) string {
+	return fmt.Sprintf(`resource "genesyscloud_architect_emergencygroup_activation" "%s" {
+		emergency_group_id = genesyscloud_architect_emergencygroup.%s.id
+		%s
+	}
+	`, activationResource, groupResource, attrs)
+}
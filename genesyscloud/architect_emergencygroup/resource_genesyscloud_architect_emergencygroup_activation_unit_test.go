@@ -0,0 +1,125 @@
+package architect_emergencygroup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseActivationWindow(t *testing.T) {
+	start, end, err := parseActivationWindow("2024-12-24T18:00:00Z", "2024-12-26T08:00:00+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 12, 24, 18, 0, 0, 0, time.UTC), start.UTC())
+	assert.Equal(t, time.Date(2024, 12, 26, 7, 0, 0, 0, time.UTC), end.UTC())
+
+	_, _, err = parseActivationWindow("2024-12-24T18:00:00Z", "2024-12-24T18:00:00Z")
+	assert.ErrorContains(t, err, "must be after start")
+
+	_, _, err = parseActivationWindow("2024-12-24", "2024-12-26T08:00:00Z")
+	assert.ErrorContains(t, err, "invalid activation window start")
+}
+
+func TestUnitIsWithinActivationWindow(t *testing.T) {
+	start := time.Date(2024, 12, 24, 18, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 26, 8, 0, 0, 0, time.UTC)
+
+	assert.False(t, isWithinActivationWindow(start.Add(-time.Second), start, end))
+	assert.True(t, isWithinActivationWindow(start, start, end))
+	assert.True(t, isWithinActivationWindow(end.Add(-time.Second), start, end))
+	assert.False(t, isWithinActivationWindow(end, start, end))
+}
+
+func TestUnitSetEmergencyGroupEnabled(t *testing.T) {
+	var (
+		id          = "emergency-group-id"
+		name        = "Weather Closure"
+		description = "Office closed"
+		version     = 3
+		enabled     = false
+		updates     []platformclientv2.Emergencygroup
+	)
+
+	ap := &architectEmergencyGroupProxy{}
+	ap.getArchitectEmergencyGroupAttr = func(ctx context.Context, p *architectEmergencyGroupProxy, emergencyGroupId string) (*platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, id, emergencyGroupId)
+		currentEnabled := enabled
+		return &platformclientv2.Emergencygroup{Id: &id, Name: &name, Description: &description, Version: &version, Enabled: &currentEnabled},
+			&platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	ap.updateArchitectEmergencyGroupAttr = func(ctx context.Context, p *architectEmergencyGroupProxy, emergencyGroupId string, emergencyGroup platformclientv2.Emergencygroup) (*platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error) {
+		updates = append(updates, emergencyGroup)
+		enabled = *emergencyGroup.Enabled
+		return &emergencyGroup, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	previous, diagErr := setEmergencyGroupEnabled(context.Background(), ap, id, true)
+	assert.Nil(t, diagErr)
+	assert.False(t, previous)
+	if assert.Len(t, updates, 1) {
+		assert.True(t, *updates[0].Enabled)
+		assert.Equal(t, name, *updates[0].Name)
+		assert.Equal(t, description, *updates[0].Description)
+		assert.Equal(t, version, *updates[0].Version)
+	}
+
+	// The group is already enabled so no update is made
+	previous, diagErr = setEmergencyGroupEnabled(context.Background(), ap, id, true)
+	assert.Nil(t, diagErr)
+	assert.True(t, previous)
+	assert.Len(t, updates, 1)
+}
+
+func TestUnitParseToggleCommandArgs(t *testing.T) {
+	var output bytes.Buffer
+
+	options, err := parseToggleCommandArgs([]string{"-name", "Weather Closure", "-enabled=true", "-reason", "Snow"}, &output)
+	assert.NoError(t, err)
+	assert.Equal(t, "Weather Closure", options.name)
+	assert.True(t, options.enabled)
+	assert.Equal(t, "Snow", options.reason)
+
+	options, err = parseToggleCommandArgs([]string{"-id", "emergency-group-id", "-enabled=false", "-reason", "All clear"}, &output)
+	assert.NoError(t, err)
+	assert.Equal(t, "emergency-group-id", options.id)
+	assert.False(t, options.enabled)
+
+	_, err = parseToggleCommandArgs([]string{"-id", "emergency-group-id", "-name", "Weather Closure"}, &output)
+	assert.ErrorContains(t, err, "exactly one of -id or -name must be set")
+	assert.ErrorContains(t, err, "-enabled must be set")
+	assert.ErrorContains(t, err, "-reason must be set")
+}
+
+func TestUnitWriteAuditRecord(t *testing.T) {
+	auditLog := filepath.Join(t.TempDir(), "audit.log")
+	record := auditRecord{
+		Time:             "2024-12-24T18:00:00Z",
+		EmergencyGroupId: "emergency-group-id",
+		PreviousEnabled:  false,
+		Enabled:          true,
+		Changed:          true,
+		Reason:           "Snow",
+	}
+
+	var output bytes.Buffer
+	assert.NoError(t, writeAuditRecord(record, &output, auditLog))
+	assert.NoError(t, writeAuditRecord(record, &output, auditLog))
+
+	contents, err := os.ReadFile(auditLog)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	assert.Len(t, lines, 2)
+
+	var written auditRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &written))
+	assert.Equal(t, record, written)
+	assert.Equal(t, lines[0]+"\n"+lines[1]+"\n", output.String())
+}
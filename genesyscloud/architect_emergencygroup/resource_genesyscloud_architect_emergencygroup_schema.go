@@ -2,16 +2,19 @@ package architect_emergencygroup
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 )
 
 const resourceName = "genesyscloud_architect_emergencygroup"
+const activationResourceName = "genesyscloud_architect_emergencygroup_activation"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceArchitectEmergencyGroup())
+	regInstance.RegisterResource(activationResourceName, ResourceArchitectEmergencyGroupActivation())
 	regInstance.RegisterDataSource(resourceName, DataSourceArchitectEmergencyGroup())
 	regInstance.RegisterExporter(resourceName, ArchitectEmergencyGroupExporter())
 }
@@ -76,6 +79,67 @@ func ResourceArchitectEmergencyGroup() *schema.Resource {
 	}
 }
 
+func ResourceArchitectEmergencyGroupActivation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Architect Emergency Group Activation. Manages only whether an existing emergency group is active, either directly or from a scheduled activation window. " +
+			"When using this resource, add `lifecycle { ignore_changes = [enabled] }` to the `genesyscloud_architect_emergencygroup` resource so the two do not revert each other.",
+
+		CreateContext: provider.CreateWithPooledClient(createEmergencyGroupActivation),
+		ReadContext:   provider.ReadWithPooledClient(readEmergencyGroupActivation),
+		UpdateContext: provider.UpdateWithPooledClient(updateEmergencyGroupActivation),
+		DeleteContext: provider.DeleteWithPooledClient(deleteEmergencyGroupActivation),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeEmergencyGroupActivationDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"emergency_group_id": {
+				Description: "ID of the emergency group to activate.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description:   "Whether the emergency group is active. Always reflects the current state of the emergency group, so changes made outside of Terraform (for example with the provider's `emergency-group` command) are kept when `enabled` is in `ignore_changes`. Conflicts with `activation_window`.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"activation_window"},
+			},
+			"activation_window": {
+				Description:   "Period during which the emergency group is active. The group is enabled by an apply made during the window and disabled by an apply made after it. Conflicts with `enabled`.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"enabled"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Description:      "Start of the window in RFC 3339 format, e.g. `2024-12-24T18:00:00Z`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+						},
+						"end": {
+							Description:      "End of the window in RFC 3339 format. Must be after `start`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+						},
+					},
+				},
+			},
+			"disable_on_destroy": {
+				Description: "Disable the emergency group when this resource is destroyed. If false, the emergency group is left in its current state.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
 func DataSourceArchitectEmergencyGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Data source for Genesys Cloud Emergency Groups. Select an emergency group by name.",
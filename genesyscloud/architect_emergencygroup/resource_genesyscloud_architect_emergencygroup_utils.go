@@ -1,6 +1,13 @@
 package architect_emergencygroup
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
 )
@@ -51,3 +58,67 @@ func flattenEmergencyCallFlows(emergencyCallFlows []platformclientv2.Emergencyca
 	}
 	return callFlows
 }
+
+// getDesiredActivation returns the planned enabled state of an activation resource. ok is false when neither enabled nor
+// an activation window is configured, in which case the resource only tracks the state of the group.
+func getDesiredActivation(d *schema.ResourceData) (enabled bool, ok bool) {
+	window := d.Get("activation_window").([]interface{})
+	if len(window) == 0 && d.GetRawConfig().GetAttr("enabled").IsNull() {
+		return false, false
+	}
+	return d.Get("enabled").(bool), true
+}
+
+func parseActivationWindow(startValue, endValue string) (start time.Time, end time.Time, err error) {
+	start, err = time.Parse(time.RFC3339, startValue)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid activation window start %s: %v", startValue, err)
+	}
+	end, err = time.Parse(time.RFC3339, endValue)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid activation window end %s: %v", endValue, err)
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("activation window end %s must be after start %s", endValue, startValue)
+	}
+	return start, end, nil
+}
+
+// isWithinActivationWindow reports whether now is in [start, end)
+func isWithinActivationWindow(now, start, end time.Time) bool {
+	return !now.Before(start) && now.Before(end)
+}
+
+// setEmergencyGroupEnabled enables or disables an emergency group, leaving the rest of the group unchanged.
+// It returns the previous enabled state of the group. No update is made if the group is already in the requested state.
+func setEmergencyGroupEnabled(ctx context.Context, ap *architectEmergencyGroupProxy, emergencyGroupId string, enabled bool) (previous bool, diagErr diag.Diagnostics) {
+	diagErr = util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		emergencyGroup, resp, getErr := ap.getArchitectEmergencyGroup(ctx, emergencyGroupId)
+		if getErr != nil {
+			return resp, util.BuildAPIDiagnosticError(activationResourceName, fmt.Sprintf("Failed to read emergency group %s error: %s", emergencyGroupId, getErr), resp)
+		}
+
+		previous = emergencyGroup.Enabled != nil && *emergencyGroup.Enabled
+		if previous == enabled {
+			log.Printf("Emergency group %s already has enabled set to %t", emergencyGroupId, enabled)
+			return resp, nil
+		}
+
+		log.Printf("Setting enabled to %t on emergency group %s", enabled, emergencyGroupId)
+		updatedEmergencyGroup := platformclientv2.Emergencygroup{
+			Name:               emergencyGroup.Name,
+			Division:           emergencyGroup.Division,
+			Description:        emergencyGroup.Description,
+			Version:            emergencyGroup.Version,
+			State:              emergencyGroup.State,
+			Enabled:            &enabled,
+			EmergencyCallFlows: emergencyGroup.EmergencyCallFlows,
+		}
+		_, resp, putErr := ap.updateArchitectEmergencyGroup(ctx, emergencyGroupId, updatedEmergencyGroup)
+		if putErr != nil {
+			return resp, util.BuildAPIDiagnosticError(activationResourceName, fmt.Sprintf("Failed to update emergency group %s error: %s", emergencyGroupId, putErr), resp)
+		}
+		return resp, nil
+	})
+	return previous, diagErr
+}
@@ -26,6 +26,7 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_architect_ivr"] = architect_ivr.ResourceArchitectIvrConfig()
 	providerResources["genesyscloud_flow"] = flow.ResourceArchitectFlow()
 	providerResources["genesyscloud_architect_emergencygroup"] = ResourceArchitectEmergencyGroup()
+	providerResources["genesyscloud_architect_emergencygroup_activation"] = ResourceArchitectEmergencyGroupActivation()
 }
 
 // registerTestDataSources registers all data sources used in the tests.
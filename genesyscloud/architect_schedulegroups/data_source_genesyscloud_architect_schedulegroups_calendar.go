@@ -0,0 +1,70 @@
+package architect_schedulegroups
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_architect_schedulegroups_calendar.go contains the data source implementation
+   that expands a schedule group into concrete open, closed and holiday intervals.
+*/
+
+const calendarDateFormat = "2006-01-02"
+
+// maxCalendarDays is the longest date range the calendar data source will expand
+const maxCalendarDays = 366
+
+// dataSourceArchitectSchedulegroupsCalendarRead expands the schedules of a schedule group for a date range
+func dataSourceArchitectSchedulegroupsCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := newArchitectSchedulegroupsProxy(sdkConfig)
+
+	scheduleGroupId := d.Get("schedule_group_id").(string)
+	startDate := d.Get("start_date").(string)
+	endDate := d.Get("end_date").(string)
+
+	scheduleGroup, proxyResponse, err := proxy.getArchitectSchedulegroupsById(ctx, scheduleGroupId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(calendarDataSourceName, fmt.Sprintf("Failed to read schedule group %s | error: %s", scheduleGroupId, err), proxyResponse)
+	}
+
+	loc, err := getScheduleGroupLocation(scheduleGroup)
+	if err != nil {
+		return util.BuildDiagnosticError(calendarDataSourceName, fmt.Sprintf("Failed to expand schedule group %s", scheduleGroupId), err)
+	}
+
+	from, err := time.ParseInLocation(calendarDateFormat, startDate, loc)
+	if err != nil {
+		return util.BuildDiagnosticError(calendarDataSourceName, fmt.Sprintf("Failed to parse start_date %s", startDate), err)
+	}
+	lastDay, err := time.ParseInLocation(calendarDateFormat, endDate, loc)
+	if err != nil {
+		return util.BuildDiagnosticError(calendarDataSourceName, fmt.Sprintf("Failed to parse end_date %s", endDate), err)
+	}
+	// end_date is inclusive
+	to := lastDay.AddDate(0, 0, 1)
+	if !to.After(from) {
+		return diag.Errorf("end_date %s must not be before start_date %s", endDate, startDate)
+	}
+	if from.AddDate(0, 0, maxCalendarDays).Before(to) {
+		return diag.Errorf("the date range from %s to %s must not be longer than %d days", startDate, endDate, maxCalendarDays)
+	}
+
+	intervals, err := expandScheduleGroup(ctx, proxy, scheduleGroup, loc, from, to)
+	if err != nil {
+		return util.BuildDiagnosticError(calendarDataSourceName, fmt.Sprintf("Failed to expand schedule group %s", scheduleGroupId), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scheduleGroupId, startDate, endDate))
+	_ = d.Set("time_zone", loc.String())
+	_ = d.Set("intervals", flattenScheduleIntervals(intervals))
+	_ = d.Set("overlaps", flattenScheduleOverlaps(findScheduleOverlaps(intervals)))
+	return nil
+}
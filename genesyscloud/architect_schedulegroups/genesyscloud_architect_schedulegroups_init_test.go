@@ -41,6 +41,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceArchitectSchedulegroups()
+	providerDataSources[calendarDataSourceName] = DataSourceArchitectSchedulegroupsCalendar()
 }
 
 // initTestResources initializes all test resources and data sources.
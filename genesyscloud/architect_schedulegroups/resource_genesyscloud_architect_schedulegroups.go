@@ -59,7 +59,7 @@ func createArchitectSchedulegroups(ctx context.Context, d *schema.ResourceData,
 	d.SetId(*scheduleGroup.Id)
 
 	log.Printf("Created schedule group %s %s", *schedGroup.Name, *scheduleGroup.Id)
-	return readArchitectSchedulegroups(ctx, d, meta)
+	return append(readArchitectSchedulegroups(ctx, d, meta), getScheduleOverlapWarnings(ctx, proxy, d.Id())...)
 }
 
 // readArchitectSchedulegroups is used by the architect_schedulegroups resource to read an architect schedulegroups from genesys cloud
@@ -133,7 +133,7 @@ func updateArchitectSchedulegroups(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("Updated schedule group %s %s", *scheduleGroup.Name, d.Id())
-	return readArchitectSchedulegroups(ctx, d, meta)
+	return append(readArchitectSchedulegroups(ctx, d, meta), getScheduleOverlapWarnings(ctx, proxy, d.Id())...)
 }
 
 // deleteArchitectSchedulegroups is used by the architect_schedulegroups resource to delete an architect schedulegroups from Genesys cloud
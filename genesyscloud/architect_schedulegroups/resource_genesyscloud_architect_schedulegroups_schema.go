@@ -4,6 +4,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,18 +18,21 @@ resource_genesycloud_architect_schedulegroups_schema.go holds four functions wit
 4.  The resource exporter configuration for the architect_schedulegroups exporter.
 */
 const resourceName = "genesyscloud_architect_schedulegroups"
+const calendarDataSourceName = "genesyscloud_architect_schedulegroups_calendar"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceArchitectSchedulegroups())
 	regInstance.RegisterDataSource(resourceName, DataSourceArchitectSchedulegroups())
+	regInstance.RegisterDataSource(calendarDataSourceName, DataSourceArchitectSchedulegroupsCalendar())
 	regInstance.RegisterExporter(resourceName, ArchitectSchedulegroupsExporter())
 }
 
 // ResourceArchitectSchedulegroups registers the genesyscloud_architect_schedulegroups resource with Terraform
 func ResourceArchitectSchedulegroups() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud Architect Schedule Groups",
+		Description: "Genesys Cloud Architect Schedule Groups. A warning is returned on create and update when two schedules of different types in the group overlap within the next year, e.g. an open and a closed schedule, or a holiday and an open schedule. " +
+			"The warning is only shown when the configuration is applied, as the schedules are read from Genesys Cloud. To report the overlaps of the schedules already in Genesys Cloud on every plan, use the `genesyscloud_architect_schedulegroups_calendar` data source in a `check` block.",
 
 		CreateContext: provider.CreateWithPooledClient(createArchitectSchedulegroups),
 		ReadContext:   provider.ReadWithPooledClient(readArchitectSchedulegroups),
@@ -112,3 +116,103 @@ func DataSourceArchitectSchedulegroups() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceArchitectSchedulegroupsCalendar registers the genesyscloud_architect_schedulegroups_calendar data source
+func DataSourceArchitectSchedulegroupsCalendar() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source that expands the open, closed and holiday schedules of a Genesys Cloud Schedule Group into concrete intervals for a date range, in the group's time zone.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectSchedulegroupsCalendarRead),
+		Schema: map[string]*schema.Schema{
+			"schedule_group_id": {
+				Description: "ID of the schedule group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start_date": {
+				Description:      "First day of the date range, in the format YYYY-MM-DD.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"end_date": {
+				Description:      "Last day of the date range, in the format YYYY-MM-DD. The range may cover at most 366 days.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"time_zone": {
+				Description: "The time zone the intervals are expressed in. This is the schedule group's time zone, or UTC if the group has none.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"intervals": {
+				Description: "Every occurrence of the group's schedules that overlaps the date range, sorted by start time.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The type of the schedule: open, closed or holiday.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schedule_id": {
+							Description: "ID of the schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schedule_name": {
+							Description: "Name of the schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start": {
+							Description: "Start of the interval as an RFC 3339 date time.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end": {
+							Description: "End of the interval as an RFC 3339 date time.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"overlaps": {
+				Description: "Periods during which two schedules of different types in the group are both active: an open and a closed schedule, or a holiday schedule and an open or closed schedule. Two of the schedule IDs of an overlap are set.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"open_schedule_id": {
+							Description: "ID of the open schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"closed_schedule_id": {
+							Description: "ID of the closed schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"holiday_schedule_id": {
+							Description: "ID of the holiday schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start": {
+							Description: "Start of the overlap as an RFC 3339 date time.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end": {
+							Description: "End of the overlap as an RFC 3339 date time.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
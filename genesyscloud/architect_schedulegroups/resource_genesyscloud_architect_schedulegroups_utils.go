@@ -0,0 +1,259 @@
+package architect_schedulegroups
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_architect_schedulegroups_utils.go file contains the helper methods used to expand the schedules
+of a schedule group into concrete intervals and to detect overlapping open and closed schedules.
+*/
+
+const (
+	scheduleTypeOpen    = "open"
+	scheduleTypeClosed  = "closed"
+	scheduleTypeHoliday = "holiday"
+)
+
+// overlapCheckPeriod is how far ahead the schedules of a group are checked for overlaps after the group is created or updated
+const overlapCheckPeriod = 365 * 24 * time.Hour
+
+// scheduleInterval is a single occurrence of a schedule in a schedule group
+type scheduleInterval struct {
+	scheduleType string
+	scheduleId   string
+	scheduleName string
+	start        time.Time
+	end          time.Time
+}
+
+// scheduleOverlap is a period during which two schedules of different types in the same group are both active.
+// first is the open schedule of an open and a closed or holiday schedule, and the closed schedule of a closed and a holiday schedule.
+type scheduleOverlap struct {
+	first  scheduleInterval
+	second scheduleInterval
+	start  time.Time
+	end    time.Time
+}
+
+// scheduleTypeOrder orders the schedules of an overlap
+var scheduleTypeOrder = map[string]int{
+	scheduleTypeOpen:    0,
+	scheduleTypeClosed:  1,
+	scheduleTypeHoliday: 2,
+}
+
+// getScheduleGroupLocation returns the location of the group's time zone, defaulting to UTC when the group has none
+func getScheduleGroupLocation(scheduleGroup *platformclientv2.Schedulegroup) (*time.Location, error) {
+	if scheduleGroup.TimeZone == nil || *scheduleGroup.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(*scheduleGroup.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %s: %v", *scheduleGroup.TimeZone, err)
+	}
+	return loc, nil
+}
+
+// expandScheduleGroup returns every interval of the group's open, closed and holiday schedules that overlaps [from, to), sorted by start time
+func expandScheduleGroup(ctx context.Context, proxy *architectSchedulegroupsProxy, scheduleGroup *platformclientv2.Schedulegroup, loc *time.Location, from, to time.Time) ([]scheduleInterval, error) {
+	schedulesByType := []struct {
+		scheduleType string
+		schedules    *[]platformclientv2.Domainentityref
+	}{
+		{scheduleTypeOpen, scheduleGroup.OpenSchedules},
+		{scheduleTypeClosed, scheduleGroup.ClosedSchedules},
+		{scheduleTypeHoliday, scheduleGroup.HolidaySchedules},
+	}
+
+	var intervals []scheduleInterval
+	for _, group := range schedulesByType {
+		if group.schedules == nil {
+			continue
+		}
+		for _, ref := range *group.schedules {
+			if ref.Id == nil {
+				continue
+			}
+			schedule, resp, err := proxy.getArchitectScheduleById(ctx, *ref.Id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read schedule %s: %v %v", *ref.Id, err, resp)
+			}
+			scheduleIntervals, err := expandSchedule(schedule, group.scheduleType, loc, from, to)
+			if err != nil {
+				return nil, err
+			}
+			intervals = append(intervals, scheduleIntervals...)
+		}
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+	return intervals, nil
+}
+
+// expandSchedule returns the occurrences of a schedule that overlap [from, to). Schedule start and end times carry no
+// time zone, so they are interpreted in loc.
+func expandSchedule(schedule *platformclientv2.Schedule, scheduleType string, loc *time.Location, from, to time.Time) ([]scheduleInterval, error) {
+	if schedule.Id == nil || schedule.Start == nil || schedule.End == nil {
+		return nil, nil
+	}
+	name := ""
+	if schedule.Name != nil {
+		name = *schedule.Name
+	}
+
+	dtstart := toLocation(*schedule.Start, loc)
+	duration := schedule.End.Sub(*schedule.Start)
+	newInterval := func(start time.Time) scheduleInterval {
+		return scheduleInterval{
+			scheduleType: scheduleType,
+			scheduleId:   *schedule.Id,
+			scheduleName: name,
+			start:        start,
+			end:          start.Add(duration),
+		}
+	}
+
+	var intervals []scheduleInterval
+	if schedule.Rrule == nil || *schedule.Rrule == "" {
+		if dtstart.Before(to) && dtstart.Add(duration).After(from) {
+			intervals = append(intervals, newInterval(dtstart))
+		}
+		return intervals, nil
+	}
+
+	rule, err := rrule.Parse(*schedule.Rrule, loc)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s has an invalid rrule %s: %v", *schedule.Id, *schedule.Rrule, err)
+	}
+	if len(rule.UnsupportedParts) > 0 {
+		return nil, fmt.Errorf("the rrule %s of schedule %s cannot be expanded as it uses %s", *schedule.Rrule, *schedule.Id, strings.Join(rule.UnsupportedParts, ", "))
+	}
+	// Include occurrences that started before the window but are still running at its start
+	for _, occurrence := range rule.Between(dtstart, from.Add(-duration), to) {
+		if occurrence.Add(duration).After(from) {
+			intervals = append(intervals, newInterval(occurrence))
+		}
+	}
+	return intervals, nil
+}
+
+// findScheduleOverlaps returns every period during which two intervals of different schedule types overlap: open and closed
+// schedules that contradict each other, and holiday schedules that override open or closed schedules.
+func findScheduleOverlaps(intervals []scheduleInterval) []scheduleOverlap {
+	var overlaps []scheduleOverlap
+	for i, first := range intervals {
+		for _, second := range intervals[i+1:] {
+			if first.scheduleType == second.scheduleType {
+				continue
+			}
+			if !first.start.Before(second.end) || !second.start.Before(first.end) {
+				continue
+			}
+			overlap := scheduleOverlap{first: first, second: second, start: first.start, end: first.end}
+			if scheduleTypeOrder[second.scheduleType] < scheduleTypeOrder[first.scheduleType] {
+				overlap.first, overlap.second = second, first
+			}
+			if second.start.After(overlap.start) {
+				overlap.start = second.start
+			}
+			if second.end.Before(overlap.end) {
+				overlap.end = second.end
+			}
+			overlaps = append(overlaps, overlap)
+		}
+	}
+
+	sort.SliceStable(overlaps, func(i, j int) bool {
+		return overlaps[i].start.Before(overlaps[j].start)
+	})
+	return overlaps
+}
+
+// getScheduleOverlapWarnings returns a warning for each pair of schedules of different types in the group that overlap in the next year.
+// The schedules are read from Genesys Cloud, so the warnings can only be computed once the group has been created or updated.
+// Overlaps are only reported, so failing to compute them is logged rather than returned.
+func getScheduleOverlapWarnings(ctx context.Context, proxy *architectSchedulegroupsProxy, scheduleGroupId string) diag.Diagnostics {
+	scheduleGroup, resp, err := proxy.getArchitectSchedulegroupsById(ctx, scheduleGroupId)
+	if err != nil {
+		log.Printf("Failed to read schedule group %s to check for overlapping schedules: %v %v", scheduleGroupId, err, resp)
+		return nil
+	}
+	loc, err := getScheduleGroupLocation(scheduleGroup)
+	if err != nil {
+		log.Printf("Failed to check schedule group %s for overlapping schedules: %v", scheduleGroupId, err)
+		return nil
+	}
+
+	from := time.Now().In(loc)
+	intervals, err := expandScheduleGroup(ctx, proxy, scheduleGroup, loc, from, from.Add(overlapCheckPeriod))
+	if err != nil {
+		log.Printf("Failed to check schedule group %s for overlapping schedules: %v", scheduleGroupId, err)
+		return nil
+	}
+
+	// Report each pair of schedules once, with its first overlap
+	var warnings diag.Diagnostics
+	reported := make(map[string]bool)
+	for _, overlap := range findScheduleOverlaps(intervals) {
+		key := overlap.first.scheduleId + "/" + overlap.second.scheduleId
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Schedules overlap in schedule group %s", scheduleGroupId),
+			Detail: fmt.Sprintf("The %s schedule %s (%s) and the %s schedule %s (%s) are both active from %s to %s.",
+				overlap.first.scheduleType, overlap.first.scheduleName, overlap.first.scheduleId,
+				overlap.second.scheduleType, overlap.second.scheduleName, overlap.second.scheduleId,
+				overlap.start.Format(time.RFC3339), overlap.end.Format(time.RFC3339)),
+		})
+	}
+	return warnings
+}
+
+// toLocation returns the same wall clock time as t in loc
+func toLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+func flattenScheduleIntervals(intervals []scheduleInterval) []interface{} {
+	flattened := make([]interface{}, 0, len(intervals))
+	for _, interval := range intervals {
+		flattened = append(flattened, map[string]interface{}{
+			"type":          interval.scheduleType,
+			"schedule_id":   interval.scheduleId,
+			"schedule_name": interval.scheduleName,
+			"start":         interval.start.Format(time.RFC3339),
+			"end":           interval.end.Format(time.RFC3339),
+		})
+	}
+	return flattened
+}
+
+func flattenScheduleOverlaps(overlaps []scheduleOverlap) []interface{} {
+	flattened := make([]interface{}, 0, len(overlaps))
+	for _, overlap := range overlaps {
+		overlapMap := map[string]interface{}{
+			"start": overlap.start.Format(time.RFC3339),
+			"end":   overlap.end.Format(time.RFC3339),
+		}
+		for _, interval := range []scheduleInterval{overlap.first, overlap.second} {
+			overlapMap[interval.scheduleType+"_schedule_id"] = interval.scheduleId
+		}
+		flattened = append(flattened, overlapMap)
+	}
+	return flattened
+}
@@ -0,0 +1,92 @@
+package architect_schedulegroups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExpandScheduleInTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	schedule := buildTestSchedule("Weekdays", "2024-01-01T09:00:00", "2024-01-01T17:00:00", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR")
+	from := time.Date(2024, time.March, 4, 0, 0, 0, 0, loc)
+	to := time.Date(2024, time.March, 11, 0, 0, 0, 0, loc)
+
+	intervals, err := expandSchedule(schedule, scheduleTypeOpen, loc, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, intervals, 5)
+	assert.Equal(t, "2024-03-04T09:00:00-05:00", intervals[0].start.Format(time.RFC3339))
+	assert.Equal(t, "2024-03-04T17:00:00-05:00", intervals[0].end.Format(time.RFC3339))
+	assert.Equal(t, "2024-03-08T09:00:00-05:00", intervals[4].start.Format(time.RFC3339))
+}
+
+func TestUnitExpandScheduleWithoutRrule(t *testing.T) {
+	schedule := buildTestSchedule("New Year", "2024-12-31T18:00:00", "2025-01-01T23:59:00", "")
+
+	intervals, err := expandSchedule(schedule, scheduleTypeHoliday, time.UTC, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Len(t, intervals, 1)
+
+	intervals, err = expandSchedule(schedule, scheduleTypeHoliday, time.UTC, time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.February, 2, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Len(t, intervals, 0)
+}
+
+func TestUnitExpandScheduleWithUnsupportedRrule(t *testing.T) {
+	// BYSETPOS is accepted by Genesys Cloud but not expanded, so the occurrences of the schedule are unknown
+	schedule := buildTestSchedule("Last weekday", "2024-01-01T09:00:00", "2024-01-01T17:00:00", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+
+	_, err := expandSchedule(schedule, scheduleTypeOpen, time.UTC, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorContains(t, err, "cannot be expanded as it uses BYSETPOS")
+}
+
+func TestUnitFindScheduleOverlaps(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)
+
+	open, err := expandSchedule(buildTestSchedule("Open", "2024-01-01T09:00:00", "2024-01-01T17:00:00", "FREQ=DAILY"), scheduleTypeOpen, time.UTC, from, to)
+	assert.NoError(t, err)
+	closed, err := expandSchedule(buildTestSchedule("Lunch", "2024-01-03T12:00:00", "2024-01-03T13:00:00", ""), scheduleTypeClosed, time.UTC, from, to)
+	assert.NoError(t, err)
+	holiday, err := expandSchedule(buildTestSchedule("Holiday", "2024-01-05T00:00:00", "2024-01-06T00:00:00", ""), scheduleTypeHoliday, time.UTC, from, to)
+	assert.NoError(t, err)
+
+	intervals := append(append(open, closed...), holiday...)
+	overlaps := findScheduleOverlaps(intervals)
+	assert.Len(t, overlaps, 2)
+	assert.Equal(t, time.Date(2024, time.January, 3, 12, 0, 0, 0, time.UTC), overlaps[0].start)
+	assert.Equal(t, time.Date(2024, time.January, 3, 13, 0, 0, 0, time.UTC), overlaps[0].end)
+	assert.Equal(t, scheduleTypeOpen, overlaps[0].first.scheduleType)
+	assert.Equal(t, scheduleTypeClosed, overlaps[0].second.scheduleType)
+
+	// The holiday overrides the open schedule for the whole day
+	assert.Equal(t, time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC), overlaps[1].start)
+	assert.Equal(t, time.Date(2024, time.January, 5, 17, 0, 0, 0, time.UTC), overlaps[1].end)
+	assert.Equal(t, scheduleTypeOpen, overlaps[1].first.scheduleType)
+	assert.Equal(t, scheduleTypeHoliday, overlaps[1].second.scheduleType)
+
+	flattened := flattenScheduleOverlaps(overlaps)
+	assert.Equal(t, map[string]interface{}{
+		"open_schedule_id":    overlaps[1].first.scheduleId,
+		"holiday_schedule_id": overlaps[1].second.scheduleId,
+		"start":               "2024-01-05T09:00:00Z",
+		"end":                 "2024-01-05T17:00:00Z",
+	}, flattened[1])
+}
+
+func buildTestSchedule(name, start, end, rrule string) *platformclientv2.Schedule {
+	startTime, _ := time.Parse("2006-01-02T15:04:05", start)
+	endTime, _ := time.Parse("2006-01-02T15:04:05", end)
+	return &platformclientv2.Schedule{
+		Id:    platformclientv2.String(uuid.NewString()),
+		Name:  &name,
+		Start: &startTime,
+		End:   &endTime,
+		Rrule: &rrule,
+	}
+}
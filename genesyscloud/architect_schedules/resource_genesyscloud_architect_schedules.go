@@ -18,6 +18,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
 )
@@ -240,6 +241,37 @@ func deleteArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 	})
 }
 
+// customizeArchitectSchedulesDiff checks at plan time that the schedule ends after it starts and that its rrule can recur
+func customizeArchitectSchedulesDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("start") || !diff.NewValueKnown("end") || !diff.NewValueKnown("rrule") {
+		return nil
+	}
+	start, err := time.Parse(timeFormat, diff.Get("start").(string))
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(timeFormat, diff.Get("end").(string))
+	if err != nil {
+		return nil
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end %s must be after start %s", diff.Get("end").(string), diff.Get("start").(string))
+	}
+
+	rruleStr := diff.Get("rrule").(string)
+	if rruleStr == "" {
+		return nil
+	}
+	rule, err := rrule.Parse(rruleStr, time.UTC)
+	if err != nil {
+		return fmt.Errorf("invalid rrule %s: %v", rruleStr, err)
+	}
+	if rule.Until != nil && rule.Until.Before(start) {
+		return fmt.Errorf("rrule %s ends before the schedule starts at %s", rruleStr, diff.Get("start").(string))
+	}
+	return nil
+}
+
 func GenerateArchitectSchedulesResource(
 	schedResource1 string,
 	name string,
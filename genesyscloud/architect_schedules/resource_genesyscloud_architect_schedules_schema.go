@@ -30,6 +30,7 @@ func ResourceArchitectSchedules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeArchitectSchedulesDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
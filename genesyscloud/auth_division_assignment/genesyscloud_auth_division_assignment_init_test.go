@@ -0,0 +1,62 @@
+package auth_division_assignment
+
+import (
+	"sync"
+	authDivision "terraform-provider-genesyscloud/genesyscloud/auth_division"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_auth_division_assignment_init_test.go file is used to initialize the data sources and resources
+   used in testing the auth_division_assignment resource.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources[resourceName] = ResourceAuthDivisionAssignment()
+	providerResources["genesyscloud_auth_division"] = authDivision.ResourceAuthDivision()
+	providerResources["genesyscloud_routing_queue"] = routingQueue.ResourceRoutingQueue()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the auth_division_assignment package
+	initTestResources()
+
+	// Run the test suite for the auth_division_assignment package
+	m.Run()
+}
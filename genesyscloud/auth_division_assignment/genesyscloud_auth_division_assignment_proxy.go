@@ -0,0 +1,140 @@
+package auth_division_assignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_auth_division_assignment_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *authDivisionAssignmentProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAuthDivisionFunc func(ctx context.Context, p *authDivisionAssignmentProxy, id string) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
+type moveObjectsToDivisionFunc func(ctx context.Context, p *authDivisionAssignmentProxy, divisionId string, objectType string, objectIds []string) (*platformclientv2.APIResponse, error)
+type getObjectNamesFunc func(ctx context.Context, p *authDivisionAssignmentProxy, objectType string) (map[string]string, *platformclientv2.APIResponse, error)
+
+// authDivisionAssignmentProxy contains all of the methods that call genesys cloud APIs.
+type authDivisionAssignmentProxy struct {
+	clientConfig              *platformclientv2.Configuration
+	authorizationApi          *platformclientv2.AuthorizationApi
+	routingApi                *platformclientv2.RoutingApi
+	usersApi                  *platformclientv2.UsersApi
+	architectApi              *platformclientv2.ArchitectApi
+	getAuthDivisionAttr       getAuthDivisionFunc
+	moveObjectsToDivisionAttr moveObjectsToDivisionFunc
+	getObjectNamesAttr        getObjectNamesFunc
+}
+
+// newAuthDivisionAssignmentProxy initializes the auth division assignment proxy with all of the data needed to communicate with Genesys Cloud
+func newAuthDivisionAssignmentProxy(clientConfig *platformclientv2.Configuration) *authDivisionAssignmentProxy {
+	return &authDivisionAssignmentProxy{
+		clientConfig:              clientConfig,
+		authorizationApi:          platformclientv2.NewAuthorizationApiWithConfig(clientConfig),
+		routingApi:                platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		usersApi:                  platformclientv2.NewUsersApiWithConfig(clientConfig),
+		architectApi:              platformclientv2.NewArchitectApiWithConfig(clientConfig),
+		getAuthDivisionAttr:       getAuthDivisionFn,
+		moveObjectsToDivisionAttr: moveObjectsToDivisionFn,
+		getObjectNamesAttr:        getObjectNamesFn,
+	}
+}
+
+// getAuthDivisionAssignmentProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getAuthDivisionAssignmentProxy(clientConfig *platformclientv2.Configuration) *authDivisionAssignmentProxy {
+	if internalProxy == nil {
+		internalProxy = newAuthDivisionAssignmentProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAuthDivision returns a Genesys Cloud division by Id
+func (p *authDivisionAssignmentProxy) getAuthDivision(ctx context.Context, id string) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+	return p.getAuthDivisionAttr(ctx, p, id)
+}
+
+// moveObjectsToDivision moves objects of one type into a division
+func (p *authDivisionAssignmentProxy) moveObjectsToDivision(ctx context.Context, divisionId string, objectType string, objectIds []string) (*platformclientv2.APIResponse, error) {
+	return p.moveObjectsToDivisionAttr(ctx, p, divisionId, objectType, objectIds)
+}
+
+// getObjectNames returns the names of all objects of a type that can be selected by name, keyed by object Id
+func (p *authDivisionAssignmentProxy) getObjectNames(ctx context.Context, objectType string) (map[string]string, *platformclientv2.APIResponse, error) {
+	return p.getObjectNamesAttr(ctx, p, objectType)
+}
+
+// getAuthDivisionFn is an implementation of the function to get a Genesys Cloud division by Id
+func getAuthDivisionFn(ctx context.Context, p *authDivisionAssignmentProxy, id string) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+	return p.authorizationApi.GetAuthorizationDivision(id, false)
+}
+
+// moveObjectsToDivisionFn is an implementation of the function to move objects of one type into a Genesys Cloud division
+func moveObjectsToDivisionFn(ctx context.Context, p *authDivisionAssignmentProxy, divisionId string, objectType string, objectIds []string) (*platformclientv2.APIResponse, error) {
+	return p.authorizationApi.PostAuthorizationDivisionObject(divisionId, objectType, objectIds)
+}
+
+// getObjectNamesFn is an implementation of the function to list the names of the queues, users or flows in Genesys Cloud
+func getObjectNamesFn(ctx context.Context, p *authDivisionAssignmentProxy, objectType string) (map[string]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	names := make(map[string]string)
+
+	switch objectType {
+	case "QUEUE":
+		for pageNum := 1; ; pageNum++ {
+			queues, resp, err := p.routingApi.GetRoutingQueues(pageNum, pageSize, "", "", nil, nil, nil, "", false)
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get queues: %s", err)
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				return names, resp, nil
+			}
+			for _, queue := range *queues.Entities {
+				names[*queue.Id] = *queue.Name
+			}
+			if queues.PageCount == nil || pageNum >= *queues.PageCount {
+				return names, resp, nil
+			}
+		}
+	case "USER":
+		for pageNum := 1; ; pageNum++ {
+			users, resp, err := p.usersApi.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", "")
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get users: %s", err)
+			}
+			if users.Entities == nil || len(*users.Entities) == 0 {
+				return names, resp, nil
+			}
+			for _, user := range *users.Entities {
+				names[*user.Id] = *user.Name
+			}
+			if users.PageCount == nil || pageNum >= *users.PageCount {
+				return names, resp, nil
+			}
+		}
+	case "FLOW":
+		for pageNum := 1; ; pageNum++ {
+			flows, resp, err := p.architectApi.GetFlows(nil, pageNum, pageSize, "", "", nil, "", "", "", "", "", "", "", "", false, false, "", "", nil)
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get flows: %s", err)
+			}
+			if flows.Entities == nil || len(*flows.Entities) == 0 {
+				return names, resp, nil
+			}
+			for _, flow := range *flows.Entities {
+				names[*flow.Id] = *flow.Name
+			}
+			if flows.PageCount == nil || pageNum >= *flows.PageCount {
+				return names, resp, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("objects of type %s cannot be selected by name", objectType)
+}
@@ -0,0 +1,80 @@
+package auth_division_assignment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_auth_division_assignment.go contains all the methods that perform the core logic for a resource.
+*/
+
+// createAuthDivisionAssignment is used by the auth_division_assignment resource to move objects into a Genesys Cloud division
+func createAuthDivisionAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	return updateAuthDivisionAssignment(ctx, d, meta)
+}
+
+// readAuthDivisionAssignment is used by the auth_division_assignment resource to check that the target division still exists
+func readAuthDivisionAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthDivisionAssignmentProxy(sdkConfig)
+	divisionId := d.Get("division_id").(string)
+
+	log.Printf("Reading division assignment %s to division %s", d.Id(), divisionId)
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := proxy.getAuthDivision(ctx, divisionId)
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read division %s | error: %s", divisionId, getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read division %s | error: %s", divisionId, getErr), resp))
+		}
+
+		log.Printf("Read division assignment %s to division %s", d.Id(), divisionId)
+		return nil
+	})
+}
+
+// updateAuthDivisionAssignment is used by the auth_division_assignment resource to move the selected objects into the division
+func updateAuthDivisionAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthDivisionAssignmentProxy(sdkConfig)
+	divisionId := d.Get("division_id").(string)
+
+	objects, resp, err := resolveDivisionObjects(ctx, d, proxy)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to select the objects to move to division %s | error: %s", divisionId, err), resp)
+	}
+
+	log.Printf("Moving objects to division %s", divisionId)
+	moved, failed := moveDivisionObjects(ctx, proxy, divisionId, objects, d.Get("batch_size").(int))
+	_ = d.Set("moved_objects", flattenMovedObjects(moved))
+	_ = d.Set("failed_objects", flattenFailedObjects(failed))
+	log.Printf("Moved %d objects to division %s, %d failed", len(moved), divisionId, len(failed))
+
+	var diags diag.Diagnostics
+	if len(failed) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d objects could not be moved to division %s", len(failed), divisionId),
+			Detail:   fmt.Sprintf("The objects are listed in failed_objects and will be retried on the next apply. First error: %s %s: %v", failed[0].objectType, failed[0].id, failed[0].err),
+		})
+	}
+	return append(diags, readAuthDivisionAssignment(ctx, d, meta)...)
+}
+
+// deleteAuthDivisionAssignment leaves the objects in the division, as there is no division to move them back to
+func deleteAuthDivisionAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Removing division assignment %s from state. The objects remain in division %s", d.Id(), d.Get("division_id").(string))
+	return nil
+}
@@ -0,0 +1,153 @@
+package auth_division_assignment
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_auth_division_assignment_schema.go holds the registration code and the resource schema for the
+auth_division_assignment resource. There is no exporter as the assignment is an operation on objects managed elsewhere.
+*/
+const resourceName = "genesyscloud_auth_division_assignment"
+
+// selectableObjectTypes are the object types that can be selected by name
+var selectableObjectTypes = []string{"QUEUE", "USER", "FLOW"}
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceAuthDivisionAssignment())
+}
+
+var (
+	objectTypeSchema = &schema.Schema{
+		Description:  "Object type as accepted by the division object-move API, e.g. `QUEUE`, `USER`, `FLOW`, `SCHEDULE` or `SCHEDULEGROUP`.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(objectTypePattern, "must be an object type in upper case, e.g. QUEUE"),
+	}
+
+	assignmentObjectsResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": objectTypeSchema,
+			"ids": {
+				Description: "IDs of the objects to move.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	assignmentSelectorResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "Type of the objects to select. Valid values: `QUEUE`, `USER`, `FLOW`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(selectableObjectTypes, false),
+			},
+			"name_regex": {
+				Description:  "Regular expression matched against the object names.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+		},
+	}
+
+	movedObjectResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "Object type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"id": {
+				Description: "Object ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	failedObjectResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "Object type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"id": {
+				Description: "Object ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"error": {
+				Description: "Error returned when moving the object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+)
+
+// ResourceAuthDivisionAssignment registers the genesyscloud_auth_division_assignment resource with Terraform
+func ResourceAuthDivisionAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Moves objects into a Genesys Cloud division in bulk. Objects are listed by ID or selected by type and a regular expression on their name, and are moved in batches with the division object-move API. " +
+			"Selectors are resolved when the resource is created or updated. Objects that fail to move are reported in `failed_objects` and retried on the next apply. " +
+			"Destroying the resource leaves the objects in the division. Objects moved by this resource should not also set `division_id` in their own resource.",
+
+		CreateContext: provider.CreateWithPooledClient(createAuthDivisionAssignment),
+		ReadContext:   provider.ReadWithPooledClient(readAuthDivisionAssignment),
+		UpdateContext: provider.UpdateWithPooledClient(updateAuthDivisionAssignment),
+		DeleteContext: provider.DeleteWithPooledClient(deleteAuthDivisionAssignment),
+		CustomizeDiff: customizeAuthDivisionAssignmentDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Description: "ID of the division to move the objects into.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"objects": {
+				Description:  "Objects to move by ID, grouped by type.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         assignmentObjectsResource,
+				AtLeastOneOf: []string{"objects", "selector"},
+			},
+			"selector": {
+				Description:  "Selects the objects of a type whose name matches a regular expression.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         assignmentSelectorResource,
+				AtLeastOneOf: []string{"objects", "selector"},
+			},
+			"batch_size": {
+				Description:  "Number of objects moved with each call to the division object-move API.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"moved_objects": {
+				Description: "Objects moved into the division.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        movedObjectResource,
+			},
+			"failed_objects": {
+				Description: "Objects that could not be moved into the division.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        failedObjectResource,
+			},
+		},
+	}
+}
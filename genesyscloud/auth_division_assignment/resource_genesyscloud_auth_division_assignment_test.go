@@ -0,0 +1,70 @@
+package auth_division_assignment
+
+import (
+	"fmt"
+	"strings"
+	authDivision "terraform-provider-genesyscloud/genesyscloud/auth_division"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAuthDivisionAssignment(t *testing.T) {
+	var (
+		divisionResource   = "target-division"
+		divisionName       = "Terraform Division Assignment " + uuid.NewString()
+		queuePrefix        = "tf-division-assignment-" + uuid.NewString()
+		queueResource1     = "queue-1"
+		queueResource2     = "queue-2"
+		assignmentResource = "assignment"
+	)
+
+	// The queues depend on the division so that they are destroyed before it
+	config := authDivision.GenerateAuthDivisionBasic(divisionResource, divisionName) +
+		routingQueue.GenerateRoutingQueueResourceBasicWithDepends(queueResource1, "genesyscloud_auth_division."+divisionResource, queuePrefix+"-1") +
+		routingQueue.GenerateRoutingQueueResourceBasicWithDepends(queueResource2, "genesyscloud_auth_division."+divisionResource, queuePrefix+"-2") +
+		generateAuthDivisionAssignmentResource(
+			assignmentResource,
+			"genesyscloud_auth_division."+divisionResource+".id",
+			fmt.Sprintf(`selector {
+				type       = "QUEUE"
+				name_regex = "^%s-"
+			}`, queuePrefix),
+			"depends_on = [genesyscloud_routing_queue."+queueResource1+", genesyscloud_routing_queue."+queueResource2+"]",
+		)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_auth_division_assignment."+assignmentResource, "moved_objects.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_auth_division_assignment."+assignmentResource, "failed_objects.#", "0"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_auth_division_assignment."+assignmentResource, "moved_objects.*.id", "genesyscloud_routing_queue."+queueResource1, "id"),
+				),
+			},
+			{
+				// Refresh the queues to check that they were moved
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResource1, "division_id", "genesyscloud_auth_division."+divisionResource, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResource2, "division_id", "genesyscloud_auth_division."+divisionResource, "id"),
+				),
+			},
+		},
+	})
+}
+
+func generateAuthDivisionAssignmentResource(resourceID string, divisionId string, blocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_auth_division_assignment" "%s" {
+		division_id = %s
+		%s
+	}
+	`, resourceID, divisionId, strings.Join(blocks, "\n"))
+}
@@ -0,0 +1,121 @@
+package auth_division_assignment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAuthDivisionAssignmentCreate(t *testing.T) {
+	divisionId := uuid.NewString()
+	moves := make(map[string][][]string)
+
+	assignmentProxy := &authDivisionAssignmentProxy{}
+	assignmentProxy.getObjectNamesAttr = func(ctx context.Context, p *authDivisionAssignmentProxy, objectType string) (map[string]string, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "QUEUE", objectType)
+		return map[string]string{
+			"queue-1": "Sales East",
+			"queue-2": "Sales West",
+			"queue-3": "Support",
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	assignmentProxy.moveObjectsToDivisionAttr = func(ctx context.Context, p *authDivisionAssignmentProxy, id string, objectType string, objectIds []string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, divisionId, id)
+		moves[objectType] = append(moves[objectType], objectIds)
+		for _, objectId := range objectIds {
+			if objectId == "user-locked" {
+				return &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("user %s cannot be moved", objectId)
+			}
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	assignmentProxy.getAuthDivisionAttr = func(ctx context.Context, p *authDivisionAssignmentProxy, id string) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Authzdivision{Id: &id}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = assignmentProxy
+	defer func() { internalProxy = nil }()
+
+	resourceSchema := ResourceAuthDivisionAssignment().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"division_id": divisionId,
+		"batch_size":  2,
+		"objects": []interface{}{
+			map[string]interface{}{
+				"type": "USER",
+				"ids":  []interface{}{"user-1", "user-locked", "user-2"},
+			},
+			map[string]interface{}{
+				"type": "QUEUE",
+				"ids":  []interface{}{"queue-3"},
+			},
+		},
+		"selector": []interface{}{
+			map[string]interface{}{
+				"type":       "QUEUE",
+				"name_regex": "^Sales ",
+			},
+		},
+	})
+
+	diags := createAuthDivisionAssignment(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Summary, "1 objects could not be moved")
+
+	assert.Equal(t, [][]string{{"queue-1", "queue-2"}, {"queue-3"}}, moves["QUEUE"])
+	assert.Equal(t, [][]string{{"user-1", "user-2"}, {"user-locked"}}, moves["USER"])
+
+	assert.Len(t, d.Get("moved_objects").([]interface{}), 5)
+	failed := d.Get("failed_objects").([]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"type":  "USER",
+		"id":    "user-locked",
+		"error": "user user-locked cannot be moved",
+	}}, failed)
+}
+
+func TestUnitAuthDivisionAssignmentRetriesFailedObjects(t *testing.T) {
+	assignmentResource := ResourceAuthDivisionAssignment()
+	state := &terraform.InstanceState{
+		ID: uuid.NewString(),
+		Attributes: map[string]string{
+			"division_id":            "division",
+			"batch_size":             "50",
+			"objects.#":              "1",
+			"objects.0.type":         "USER",
+			"objects.0.ids.#":        "1",
+			"objects.0.ids.0":        "user-1",
+			"moved_objects.#":        "0",
+			"failed_objects.#":       "1",
+			"failed_objects.0.type":  "USER",
+			"failed_objects.0.id":    "user-1",
+			"failed_objects.0.error": "failed",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"division_id": "division",
+		"objects": []interface{}{
+			map[string]interface{}{"type": "USER", "ids": []interface{}{"user-1"}},
+		},
+	})
+
+	diff, err := assignmentResource.SimpleDiff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["failed_objects.#"].NewComputed)
+
+	state.Attributes["failed_objects.#"] = "0"
+	delete(state.Attributes, "failed_objects.0.type")
+	delete(state.Attributes, "failed_objects.0.id")
+	delete(state.Attributes, "failed_objects.0.error")
+	diff, err = assignmentResource.SimpleDiff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+}
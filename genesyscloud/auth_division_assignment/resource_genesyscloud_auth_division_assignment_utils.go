@@ -0,0 +1,149 @@
+package auth_division_assignment
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_auth_division_assignment_utils.go file contains various helper methods to resolve the objects
+to move and to marshal the results into formats consumable by Terraform.
+*/
+
+var objectTypePattern = regexp.MustCompile(`^[A-Z]+$`)
+
+// divisionObject identifies an object that was moved, or failed to move, into the division
+type divisionObject struct {
+	objectType string
+	id         string
+	err        error
+}
+
+// resolveDivisionObjects returns the sorted IDs of the objects to move, keyed by object type. Selectors are resolved by
+// listing the objects of their type and matching their names.
+func resolveDivisionObjects(ctx context.Context, d *schema.ResourceData, proxy *authDivisionAssignmentProxy) (map[string][]string, *platformclientv2.APIResponse, error) {
+	ids := make(map[string]map[string]bool)
+	add := func(objectType, id string) {
+		if ids[objectType] == nil {
+			ids[objectType] = make(map[string]bool)
+		}
+		ids[objectType][id] = true
+	}
+
+	if objects, ok := d.Get("objects").(*schema.Set); ok {
+		for _, object := range objects.List() {
+			objectMap := object.(map[string]interface{})
+			for _, id := range objectMap["ids"].(*schema.Set).List() {
+				add(objectMap["type"].(string), id.(string))
+			}
+		}
+	}
+
+	names := make(map[string]map[string]string)
+	for _, selector := range d.Get("selector").([]interface{}) {
+		selectorMap := selector.(map[string]interface{})
+		objectType := selectorMap["type"].(string)
+		nameRegex, err := regexp.Compile(selectorMap["name_regex"].(string))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if names[objectType] == nil {
+			objectNames, resp, err := proxy.getObjectNames(ctx, objectType)
+			if err != nil {
+				return nil, resp, err
+			}
+			names[objectType] = objectNames
+		}
+		for _, id := range selectObjectsByName(names[objectType], nameRegex) {
+			add(objectType, id)
+		}
+	}
+
+	resolved := make(map[string][]string, len(ids))
+	for objectType, typeIds := range ids {
+		for id := range typeIds {
+			resolved[objectType] = append(resolved[objectType], id)
+		}
+		sort.Strings(resolved[objectType])
+	}
+	return resolved, nil, nil
+}
+
+// selectObjectsByName returns the IDs of the objects whose name matches the regular expression
+func selectObjectsByName(names map[string]string, nameRegex *regexp.Regexp) []string {
+	var ids []string
+	for id, name := range names {
+		if nameRegex.MatchString(name) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// moveDivisionObjects moves the objects of each type into the division in batches and returns the objects that were moved and those that failed
+func moveDivisionObjects(ctx context.Context, proxy *authDivisionAssignmentProxy, divisionId string, objects map[string][]string, batchSize int) (moved []divisionObject, failed []divisionObject) {
+	objectTypes := make([]string, 0, len(objects))
+	for objectType := range objects {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	move := func(divisionId string, objectType string, objectIds []string) (*platformclientv2.APIResponse, error) {
+		return proxy.moveObjectsToDivision(ctx, divisionId, objectType, objectIds)
+	}
+	for _, objectType := range objectTypes {
+		failedIds := util.MoveObjectsToDivision(move, divisionId, objectType, objects[objectType], batchSize)
+		for _, id := range objects[objectType] {
+			if err, ok := failedIds[id]; ok {
+				failed = append(failed, divisionObject{objectType: objectType, id: id, err: err})
+			} else {
+				moved = append(moved, divisionObject{objectType: objectType, id: id})
+			}
+		}
+	}
+	return moved, failed
+}
+
+// flattenMovedObjects maps the moved objects into a []interface{}
+func flattenMovedObjects(objects []divisionObject) []interface{} {
+	flattened := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		flattened = append(flattened, map[string]interface{}{
+			"type": object.objectType,
+			"id":   object.id,
+		})
+	}
+	return flattened
+}
+
+// flattenFailedObjects maps the objects that failed to move into a []interface{}
+func flattenFailedObjects(objects []divisionObject) []interface{} {
+	flattened := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		flattened = append(flattened, map[string]interface{}{
+			"type":  object.objectType,
+			"id":    object.id,
+			"error": fmt.Sprintf("%v", object.err),
+		})
+	}
+	return flattened
+}
+
+// customizeAuthDivisionAssignmentDiff plans an update while objects have failed to move, so that they are retried on the next apply
+func customizeAuthDivisionAssignmentDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || len(diff.Get("failed_objects").([]interface{})) == 0 {
+		return nil
+	}
+	if err := diff.SetNewComputed("moved_objects"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("failed_objects")
+}
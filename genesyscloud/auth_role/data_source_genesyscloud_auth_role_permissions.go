@@ -0,0 +1,65 @@
+package auth_role
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_auth_role_permissions.go contains the data source implementation
+   that expands a role into the permissions it grants.
+*/
+
+// DataSourceAuthRolePermissionsRead expands the permission policies of a role against the permissions available to the org
+func DataSourceAuthRolePermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthRoleProxy(sdkConfig)
+
+	roleId := d.Get("role_id").(string)
+
+	role, resp, err := proxy.getAuthRoleById(ctx, roleId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(permissionsDataSourceName, fmt.Sprintf("Failed to read role %s | error: %s", roleId, err), resp)
+	}
+
+	catalog, resp, err := proxy.getPermissionCatalog(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(permissionsDataSourceName, fmt.Sprintf("Failed to get org permissions | error: %s", err), resp)
+	}
+
+	var permissions []string
+	if role.Permissions != nil {
+		permissions = *role.Permissions
+	}
+	var effectivePermissions []EffectivePermission
+	if role.PermissionPolicies != nil {
+		effectivePermissions = catalog.effectivePermissions(BuildPermissionPolicies(*role.PermissionPolicies))
+	}
+
+	d.SetId(roleId)
+	_ = d.Set("permissions", lists.StringListToSet(permissions))
+	_ = d.Set("effective_permissions", flattenEffectivePermissions(effectivePermissions))
+	return nil
+}
+
+func flattenEffectivePermissions(permissions []EffectivePermission) []interface{} {
+	permissionList := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionList = append(permissionList, map[string]interface{}{
+			"permission":     permission.String(),
+			"domain":         permission.Domain,
+			"entity_name":    permission.EntityName,
+			"action":         permission.Action,
+			"label":          permission.Label,
+			"conditional":    permission.Conditional,
+			"division_aware": permission.DivisionAware,
+		})
+	}
+	return permissionList
+}
@@ -0,0 +1,61 @@
+package auth_role
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuthRolePermissions(t *testing.T) {
+	var (
+		roleResource1   = "auth-role-permissions"
+		roleDataSource1 = "auth-role-permissions-data"
+		roleName1       = "Terraform Role-" + uuid.NewString()
+		roleDesc1       = "Terraform test role"
+		perm1           = "group_creation"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: GenerateAuthRoleResource(
+					roleResource1,
+					roleName1,
+					roleDesc1,
+					GenerateRolePermissions(strconv.Quote(perm1)),
+					GenerateRolePermPolicy("directory", "user", strconv.Quote("add")),
+					GenerateRolePermPolicy("directory", "group", strconv.Quote("*")),
+				) + generateAuthRolePermissionsDataSource(roleDataSource1, "genesyscloud_auth_role."+roleResource1+".id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_auth_role_permissions."+roleDataSource1, "role_id", "genesyscloud_auth_role."+roleResource1, "id"),
+					resource.TestCheckTypeSetElemAttr("data.genesyscloud_auth_role_permissions."+roleDataSource1, "permissions.*", perm1),
+					resource.TestCheckTypeSetElemNestedAttrs("data.genesyscloud_auth_role_permissions."+roleDataSource1, "effective_permissions.*", map[string]string{
+						"permission":  "directory:user:add",
+						"conditional": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.genesyscloud_auth_role_permissions."+roleDataSource1, "effective_permissions.*", map[string]string{
+						"permission": "directory:group:add",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.genesyscloud_auth_role_permissions."+roleDataSource1, "effective_permissions.*", map[string]string{
+						"permission": "directory:group:delete",
+					}),
+				),
+			},
+		},
+		CheckDestroy: testVerifyRolesDestroyed,
+	})
+}
+
+func generateAuthRolePermissionsDataSource(resourceID string, roleId string) string {
+	return fmt.Sprintf(`data "genesyscloud_auth_role_permissions" "%s" {
+		role_id = %s
+	}
+	`, resourceID, roleId)
+}
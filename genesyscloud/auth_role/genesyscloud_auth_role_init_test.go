@@ -39,6 +39,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceAuthRole()
+	providerDataSources[permissionsDataSourceName] = DataSourceAuthRolePermissions()
 }
 
 // initTestResources initializes all test resources and data sources.
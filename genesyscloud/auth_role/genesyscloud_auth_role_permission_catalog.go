@@ -0,0 +1,272 @@
+package auth_role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_auth_role_permission_catalog.go file validates permission policies against the permissions available to
+the org and expands the wildcards of a role into the individual permissions it grants.
+*/
+
+// permissionCatalog indexes the permissions available to the org by domain, entity and action
+type permissionCatalog map[string]map[string]map[string]platformclientv2.Domainpermission
+
+// conditionVariablePattern matches condition variable names such as Conversation.queues
+var conditionVariablePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)+$`)
+
+func newPermissionCatalog(collections []platformclientv2.Domainpermissioncollection) permissionCatalog {
+	catalog := make(permissionCatalog)
+	for _, collection := range collections {
+		if collection.PermissionMap == nil {
+			continue
+		}
+		for entityName, permissions := range *collection.PermissionMap {
+			entityName := entityName
+			for _, permission := range permissions {
+				permission.EntityType = &entityName
+				if permission.Domain == nil || permission.Action == nil {
+					continue
+				}
+				domain := *permission.Domain
+				if catalog[domain] == nil {
+					catalog[domain] = make(map[string]map[string]platformclientv2.Domainpermission)
+				}
+				if catalog[domain][entityName] == nil {
+					catalog[domain][entityName] = make(map[string]platformclientv2.Domainpermission)
+				}
+				catalog[domain][entityName][*permission.Action] = permission
+			}
+		}
+	}
+	return catalog
+}
+
+// validatePolicy checks that the domain, entity and actions of the policy exist and that its conditions, if any, are supported
+func (c permissionCatalog) validatePolicy(policy platformclientv2.Domainpermissionpolicy) error {
+	domain := *policy.Domain
+	entityName := *policy.EntityName
+
+	entities, ok := c[domain]
+	if !ok {
+		return fmt.Errorf("domain %s not found%s", domain, didYouMean(domain, stringmap.SortedKeys(c)))
+	}
+	if entityName != "*" {
+		if _, ok := entities[entityName]; !ok {
+			return fmt.Errorf("entity_name %s not found for domain %s%s", entityName, domain, didYouMean(entityName, stringmap.SortedKeys(entities)))
+		}
+	}
+
+	var errs []error
+	for _, action := range *policy.ActionSet {
+		if action == "*" {
+			continue
+		}
+		if len(c.expand(domain, entityName, []string{action})) == 0 {
+			errs = append(errs, fmt.Errorf("action %s not found for domain %s, entity name %s%s", action, domain, entityName, didYouMean(action, c.actions(domain, entityName))))
+		}
+	}
+	if len(errs) > 0 || policy.ResourceConditionNode == nil {
+		return errors.Join(errs...)
+	}
+
+	// Conditions are only accepted on permissions that support them. A wildcard only needs one such permission.
+	wildcard := entityName == "*" || containsWildcard(*policy.ActionSet)
+	supported := 0
+	for _, permission := range c.expand(domain, entityName, *policy.ActionSet) {
+		if permission.AllowsConditions != nil && *permission.AllowsConditions {
+			supported++
+		} else if !wildcard {
+			errs = append(errs, fmt.Errorf("permission %s does not support conditions", permissionString(permission)))
+		}
+	}
+	if wildcard && supported == 0 {
+		errs = append(errs, fmt.Errorf("none of the permissions granted by %s:%s support conditions", domain, entityName))
+	}
+	return errors.Join(errs...)
+}
+
+// expand returns the permissions granted by the entity name and actions of a policy in the domain, resolving wildcards
+func (c permissionCatalog) expand(domain string, entityName string, actions []string) []platformclientv2.Domainpermission {
+	var permissions []platformclientv2.Domainpermission
+	for _, entity := range stringmap.SortedKeys(c[domain]) {
+		if entityName != "*" && entity != entityName {
+			continue
+		}
+		for _, action := range stringmap.SortedKeys(c[domain][entity]) {
+			for _, policyAction := range actions {
+				if policyAction == "*" || policyAction == action {
+					permissions = append(permissions, c[domain][entity][action])
+					break
+				}
+			}
+		}
+	}
+	return permissions
+}
+
+// PermissionPolicy is a permission policy of a role, as returned with the role itself or with the grants of a subject
+type PermissionPolicy struct {
+	Domain      string
+	EntityName  string
+	Actions     []string
+	Conditional bool
+}
+
+// EffectivePermission is a single permission granted by the permission policies of a role
+type EffectivePermission struct {
+	Domain        string
+	EntityName    string
+	Action        string
+	Label         string
+	Conditional   bool
+	DivisionAware bool
+}
+
+func (p EffectivePermission) String() string {
+	return fmt.Sprintf("%s:%s:%s", p.Domain, p.EntityName, p.Action)
+}
+
+// ExpandPermissionPolicies expands permission policies into the individual permissions available to the org that they grant
+func ExpandPermissionPolicies(ctx context.Context, clientConfig *platformclientv2.Configuration, policies []PermissionPolicy) ([]EffectivePermission, *platformclientv2.APIResponse, error) {
+	catalog, resp, err := getAuthRoleProxy(clientConfig).getPermissionCatalog(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return catalog.effectivePermissions(policies), resp, nil
+}
+
+// BuildPermissionPolicies converts the permission policies of a role from the SDK
+func BuildPermissionPolicies(sdkPolicies []platformclientv2.Domainpermissionpolicy) []PermissionPolicy {
+	var policies []PermissionPolicy
+	for _, sdkPolicy := range sdkPolicies {
+		if sdkPolicy.Domain == nil || sdkPolicy.EntityName == nil || sdkPolicy.ActionSet == nil {
+			continue
+		}
+		policies = append(policies, PermissionPolicy{
+			Domain:      *sdkPolicy.Domain,
+			EntityName:  *sdkPolicy.EntityName,
+			Actions:     *sdkPolicy.ActionSet,
+			Conditional: sdkPolicy.ResourceConditionNode != nil,
+		})
+	}
+	return policies
+}
+
+// effectivePermissions expands the policies of a role into the individual permissions they grant, sorted by domain,
+// entity name and action. A permission granted by several policies is only conditional if every one of them is.
+func (c permissionCatalog) effectivePermissions(policies []PermissionPolicy) []EffectivePermission {
+	permissions := make(map[string]EffectivePermission)
+	add := func(permission EffectivePermission) {
+		if existing, ok := permissions[permission.String()]; ok {
+			permission.Conditional = permission.Conditional && existing.Conditional
+		}
+		permissions[permission.String()] = permission
+	}
+
+	for _, policy := range policies {
+		expanded := c.expand(policy.Domain, policy.EntityName, policy.Actions)
+		for _, permission := range expanded {
+			add(EffectivePermission{
+				Domain:        *permission.Domain,
+				EntityName:    *permission.EntityType,
+				Action:        *permission.Action,
+				Label:         util.StringValue(permission.Label),
+				Conditional:   policy.Conditional,
+				DivisionAware: permission.DivisionAware != nil && *permission.DivisionAware,
+			})
+		}
+		if len(expanded) > 0 {
+			continue
+		}
+
+		// Permissions the org no longer has are still reported as granted when they are named explicitly
+		for _, action := range policy.Actions {
+			if policy.EntityName == "*" || action == "*" {
+				log.Printf("Unable to expand permission %s:%s:%s, it is not available to the org", policy.Domain, policy.EntityName, action)
+				continue
+			}
+			add(EffectivePermission{Domain: policy.Domain, EntityName: policy.EntityName, Action: action, Conditional: policy.Conditional})
+		}
+	}
+
+	result := make([]EffectivePermission, 0, len(permissions))
+	for _, key := range stringmap.SortedKeys(permissions) {
+		result = append(result, permissions[key])
+	}
+	return result
+}
+
+// actions returns the distinct actions available on an entity, or on every entity of the domain for a wildcard
+func (c permissionCatalog) actions(domain string, entityName string) []string {
+	actionSet := make(map[string]bool)
+	for _, permission := range c.expand(domain, entityName, []string{"*"}) {
+		actionSet[*permission.Action] = true
+	}
+	return stringmap.SortedKeys(actionSet)
+}
+
+// validatePolicyConditions checks the condition terms of a policy config for mistakes the API would reject or silently ignore
+func validatePolicyConditions(policyName string, conditions []interface{}) []error {
+	if len(conditions) == 0 || conditions[0] == nil {
+		return nil
+	}
+	var errs []error
+	conditionMap := conditions[0].(map[string]interface{})
+	for _, term := range conditionMap["terms"].(*schema.Set).List() {
+		termMap := term.(map[string]interface{})
+		variableName := termMap["variable_name"].(string)
+		operator := termMap["operator"].(string)
+		operands := termMap["operands"].(*schema.Set).List()
+
+		if variableName != "" && !conditionVariablePattern.MatchString(variableName) {
+			errs = append(errs, fmt.Errorf("permission_policies %s: variable_name %s must be an object and attribute separated by a period, e.g. Conversation.queues", policyName, variableName))
+		}
+		if operator != "IN" && len(operands) > 1 {
+			errs = append(errs, fmt.Errorf("permission_policies %s: variable_name %s uses operator %s which takes a single operand, use IN to match any of %d operands", policyName, variableName, operator, len(operands)))
+		}
+		for _, operand := range operands {
+			operandMap := operand.(map[string]interface{})
+			operandType := operandMap["type"].(string)
+			if operandMap["queue_id"].(string) != "" && operandType != "QUEUE" {
+				errs = append(errs, fmt.Errorf("permission_policies %s: variable_name %s has a %s operand with queue_id set, queue_id is only used by QUEUE operands", policyName, variableName, operandType))
+			}
+			if operandMap["user_id"].(string) != "" && operandType != "USER" {
+				errs = append(errs, fmt.Errorf("permission_policies %s: variable_name %s has a %s operand with user_id set, user_id is only used by USER operands", policyName, variableName, operandType))
+			}
+			if operandMap["value"].(string) != "" && (operandType == "QUEUE" || operandType == "USER") {
+				errs = append(errs, fmt.Errorf("permission_policies %s: variable_name %s has a %s operand with value set, use queue_id or user_id instead", policyName, variableName, operandType))
+			}
+		}
+	}
+	return errs
+}
+
+func permissionString(permission platformclientv2.Domainpermission) string {
+	return fmt.Sprintf("%s:%s:%s", *permission.Domain, *permission.EntityType, *permission.Action)
+}
+
+func containsWildcard(actions []string) bool {
+	for _, action := range actions {
+		if action == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func didYouMean(target string, candidates []string) string {
+	if match, ok := util.ClosestMatch(target, candidates); ok {
+		return fmt.Sprintf(", did you mean %s?", match)
+	}
+	return ""
+}
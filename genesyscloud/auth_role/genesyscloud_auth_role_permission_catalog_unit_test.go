@@ -0,0 +1,168 @@
+package auth_role
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestPermission(domain string, entityType string, action string, allowsConditions bool) platformclientv2.Domainpermission {
+	label := action + " " + entityType
+	divisionAware := domain == "routing"
+	return platformclientv2.Domainpermission{
+		Domain:           &domain,
+		EntityType:       &entityType,
+		Action:           &action,
+		Label:            &label,
+		AllowsConditions: &allowsConditions,
+		DivisionAware:    &divisionAware,
+	}
+}
+
+func buildTestPermissionCollections() []platformclientv2.Domainpermissioncollection {
+	routing := "routing"
+	quality := "quality"
+	return []platformclientv2.Domainpermissioncollection{
+		{
+			Domain: &routing,
+			PermissionMap: &map[string][]platformclientv2.Domainpermission{
+				"queue": {
+					buildTestPermission(routing, "queue", "view", false),
+					buildTestPermission(routing, "queue", "edit", false),
+				},
+				"skill": {buildTestPermission(routing, "skill", "manage", false)},
+			},
+		},
+		{
+			Domain: &quality,
+			PermissionMap: &map[string][]platformclientv2.Domainpermission{
+				"evaluation": {
+					buildTestPermission(quality, "evaluation", "add", true),
+					buildTestPermission(quality, "evaluation", "view", false),
+				},
+			},
+		},
+	}
+}
+
+func buildTestPolicy(domain string, entityName string, conditional bool, actions ...string) platformclientv2.Domainpermissionpolicy {
+	policy := platformclientv2.Domainpermissionpolicy{Domain: &domain, EntityName: &entityName, ActionSet: &actions}
+	if conditional {
+		conjunction := "AND"
+		policy.ResourceConditionNode = &platformclientv2.Domainresourceconditionnode{Conjunction: &conjunction}
+	}
+	return policy
+}
+
+func TestUnitPermissionCatalogValidatePolicy(t *testing.T) {
+	catalog := newPermissionCatalog(buildTestPermissionCollections())
+
+	assert.NoError(t, catalog.validatePolicy(buildTestPolicy("routing", "queue", false, "view", "edit")))
+	assert.NoError(t, catalog.validatePolicy(buildTestPolicy("routing", "*", false, "manage")))
+	assert.NoError(t, catalog.validatePolicy(buildTestPolicy("quality", "evaluation", true, "add")))
+	assert.NoError(t, catalog.validatePolicy(buildTestPolicy("quality", "evaluation", true, "*")))
+
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("routin", "queue", false, "view")), "domain routin not found, did you mean routing?")
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("outbound", "campaign", false, "view")), "domain outbound not found")
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("routing", "queu", false, "view")), "entity_name queu not found for domain routing, did you mean queue?")
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("routing", "queue", false, "veiw")), "action veiw not found for domain routing, entity name queue, did you mean view?")
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("routing", "*", false, "delete")), "action delete not found for domain routing, entity name *")
+
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("quality", "evaluation", true, "add", "view")), "permission quality:evaluation:view does not support conditions")
+	assert.EqualError(t, catalog.validatePolicy(buildTestPolicy("routing", "queue", true, "*")), "none of the permissions granted by routing:queue support conditions")
+}
+
+func TestUnitPermissionCatalogEffectivePermissions(t *testing.T) {
+	catalog := newPermissionCatalog(buildTestPermissionCollections())
+
+	permissions := catalog.effectivePermissions(BuildPermissionPolicies([]platformclientv2.Domainpermissionpolicy{
+		buildTestPolicy("routing", "*", false, "*"),
+		buildTestPolicy("quality", "evaluation", true, "add"),
+		buildTestPolicy("quality", "evaluation", false, "add", "view"),
+		buildTestPolicy("quality", "calibration", true, "add"),
+		buildTestPolicy("outbound", "*", false, "view"),
+	}))
+
+	var names []string
+	for _, permission := range permissions {
+		names = append(names, permission.String())
+	}
+	assert.Equal(t, []string{
+		"quality:calibration:add",
+		"quality:evaluation:add",
+		"quality:evaluation:view",
+		"routing:queue:edit",
+		"routing:queue:view",
+		"routing:skill:manage",
+	}, names)
+
+	assert.True(t, permissions[0].Conditional)
+	assert.Equal(t, "", permissions[0].Label)
+	// Also granted unconditionally by the second quality policy
+	assert.False(t, permissions[1].Conditional)
+	assert.Equal(t, "add evaluation", permissions[1].Label)
+	assert.True(t, permissions[3].DivisionAware)
+}
+
+func TestUnitValidatePolicyConditions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceAuthRole().Schema, map[string]interface{}{
+		"name": "Role",
+		"permission_policies": []interface{}{
+			map[string]interface{}{
+				"domain":      "quality",
+				"entity_name": "evaluation",
+				"action_set":  []interface{}{"add"},
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"conjunction": "AND",
+						"terms": []interface{}{
+							map[string]interface{}{
+								"variable_name": "Conversation queues",
+								"operator":      "EQ",
+								"operands": []interface{}{
+									map[string]interface{}{"type": "QUEUE", "queue_id": "queue-1"},
+									map[string]interface{}{"type": "USER", "queue_id": "queue-2", "value": "user-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	policy := d.Get("permission_policies").(*schema.Set).List()[0].(map[string]interface{})
+	errs := validatePolicyConditions("quality:evaluation", policy["conditions"].([]interface{}))
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.ElementsMatch(t, []string{
+		"permission_policies quality:evaluation: variable_name Conversation queues must be an object and attribute separated by a period, e.g. Conversation.queues",
+		"permission_policies quality:evaluation: variable_name Conversation queues uses operator EQ which takes a single operand, use IN to match any of 2 operands",
+		"permission_policies quality:evaluation: variable_name Conversation queues has a USER operand with queue_id set, queue_id is only used by QUEUE operands",
+		"permission_policies quality:evaluation: variable_name Conversation queues has a USER operand with value set, use queue_id or user_id instead",
+	}, messages)
+}
+
+func TestUnitGetPermissionCatalogIsCached(t *testing.T) {
+	calls := 0
+	proxy := &authRoleProxy{}
+	proxy.getPermissionCatalogAttr = func(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainpermissioncollection, *platformclientv2.APIResponse, error) {
+		calls++
+		collections := buildTestPermissionCollections()
+		return &collections, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		catalog, _, err := proxy.getPermissionCatalog(context.Background())
+		assert.NoError(t, err)
+		assert.Contains(t, catalog, "routing")
+	}
+	assert.Equal(t, 1, calls)
+}
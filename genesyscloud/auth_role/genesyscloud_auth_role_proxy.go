@@ -3,6 +3,7 @@ package auth_role
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -27,22 +28,26 @@ type getDefaultRoleIdFunc func(ctx context.Context, p *authRoleProxy, defaultRol
 type updateAuthRoleFunc func(ctx context.Context, p *authRoleProxy, id string, domainOrganizationRole *platformclientv2.Domainorganizationroleupdate) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
 type deleteAuthRoleFunc func(ctx context.Context, p *authRoleProxy, id string) (response *platformclientv2.APIResponse, err error)
 type restoreDefaultRolesFunc func(ctx context.Context, p *authRoleProxy, roles *[]platformclientv2.Domainorganizationrole) (*platformclientv2.APIResponse, error)
-type getAllowedPermissionsFunc func(p *authRoleProxy, domain string) (*map[string][]platformclientv2.Domainpermission, *platformclientv2.APIResponse, error)
+type getPermissionCatalogFunc func(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainpermissioncollection, *platformclientv2.APIResponse, error)
 
 // authRoleProxy contains all of the methods that call genesys cloud APIs.
 type authRoleProxy struct {
-	clientConfig              *platformclientv2.Configuration
-	authorizationApi          *platformclientv2.AuthorizationApi
-	createAuthRoleAttr        createAuthRoleFunc
-	getAllAuthRoleAttr        getAllAuthRoleFunc
-	getAuthRoleIdByNameAttr   getAuthRoleIdByNameFunc
-	getAuthRoleByIdAttr       getAuthRoleByIdFunc
-	getDefaultRoleIdAttr      getDefaultRoleIdFunc
-	updateAuthRoleAttr        updateAuthRoleFunc
-	deleteAuthRoleAttr        deleteAuthRoleFunc
-	restoreDefaultRolesAttr   restoreDefaultRolesFunc
-	getAllowedPermissionsAttr getAllowedPermissionsFunc
-	authRoleCache             rc.CacheInterface[platformclientv2.Domainorganizationrole]
+	clientConfig             *platformclientv2.Configuration
+	authorizationApi         *platformclientv2.AuthorizationApi
+	createAuthRoleAttr       createAuthRoleFunc
+	getAllAuthRoleAttr       getAllAuthRoleFunc
+	getAuthRoleIdByNameAttr  getAuthRoleIdByNameFunc
+	getAuthRoleByIdAttr      getAuthRoleByIdFunc
+	getDefaultRoleIdAttr     getDefaultRoleIdFunc
+	updateAuthRoleAttr       updateAuthRoleFunc
+	deleteAuthRoleAttr       deleteAuthRoleFunc
+	restoreDefaultRolesAttr  restoreDefaultRolesFunc
+	getPermissionCatalogAttr getPermissionCatalogFunc
+	authRoleCache            rc.CacheInterface[platformclientv2.Domainorganizationrole]
+
+	// The permission catalog of the org is only fetched once and shared by every role
+	permissionCatalogMutex sync.Mutex
+	permissionCatalog      permissionCatalog
 }
 
 // newAuthRoleProxy initializes the auth role proxy with all of the data needed to communicate with Genesys Cloud
@@ -50,18 +55,18 @@ func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRolePro
 	api := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
 	authRoleCache := rc.NewResourceCache[platformclientv2.Domainorganizationrole]() // Create Cache for authRole resource
 	return &authRoleProxy{
-		clientConfig:              clientConfig,
-		authorizationApi:          api,
-		authRoleCache:             authRoleCache,
-		createAuthRoleAttr:        createAuthRoleFn,
-		getAllAuthRoleAttr:        getAllAuthRoleFn,
-		getAuthRoleIdByNameAttr:   getAuthRoleIdByNameFn,
-		getAuthRoleByIdAttr:       getAuthRoleByIdFn,
-		getDefaultRoleIdAttr:      getDefaultRoleIdFn,
-		updateAuthRoleAttr:        updateAuthRoleFn,
-		deleteAuthRoleAttr:        deleteAuthRoleFn,
-		restoreDefaultRolesAttr:   restoreDefaultRolesFn,
-		getAllowedPermissionsAttr: getAllowedPermissionsFn,
+		clientConfig:             clientConfig,
+		authorizationApi:         api,
+		authRoleCache:            authRoleCache,
+		createAuthRoleAttr:       createAuthRoleFn,
+		getAllAuthRoleAttr:       getAllAuthRoleFn,
+		getAuthRoleIdByNameAttr:  getAuthRoleIdByNameFn,
+		getAuthRoleByIdAttr:      getAuthRoleByIdFn,
+		getDefaultRoleIdAttr:     getDefaultRoleIdFn,
+		updateAuthRoleAttr:       updateAuthRoleFn,
+		deleteAuthRoleAttr:       deleteAuthRoleFn,
+		restoreDefaultRolesAttr:  restoreDefaultRolesFn,
+		getPermissionCatalogAttr: getPermissionCatalogFn,
 	}
 }
 
@@ -119,9 +124,20 @@ func (p *authRoleProxy) restoreDefaultRoles(ctx context.Context, roles *[]platfo
 	return p.restoreDefaultRolesAttr(ctx, p, roles)
 }
 
-// getAllowedPermissions returns an array of available permissions for a given domain e.g. outbound
-func (p *authRoleProxy) getAllowedPermissions(domain string) (*map[string][]platformclientv2.Domainpermission, *platformclientv2.APIResponse, error) {
-	return p.getAllowedPermissionsAttr(p, domain)
+// getPermissionCatalog returns every permission available to the org, indexed by domain, entity and action
+func (p *authRoleProxy) getPermissionCatalog(ctx context.Context) (permissionCatalog, *platformclientv2.APIResponse, error) {
+	p.permissionCatalogMutex.Lock()
+	defer p.permissionCatalogMutex.Unlock()
+
+	if p.permissionCatalog != nil {
+		return p.permissionCatalog, nil, nil
+	}
+	collections, apiResponse, err := p.getPermissionCatalogAttr(ctx, p)
+	if err != nil {
+		return nil, apiResponse, err
+	}
+	p.permissionCatalog = newPermissionCatalog(*collections)
+	return p.permissionCatalog, apiResponse, nil
 }
 
 // createAuthRoleFn is an implementation function for creating a Genesys Cloud auth role
@@ -221,40 +237,29 @@ func restoreDefaultRolesFn(ctx context.Context, p *authRoleProxy, roles *[]platf
 	return apiResponse, nil
 }
 
-// getAllowedPermissionsFn is an implementation function for getting all allowed permissions for a domain
-func getAllowedPermissionsFn(p *authRoleProxy, domain string) (*map[string][]platformclientv2.Domainpermission, *platformclientv2.APIResponse, error) {
+// getPermissionCatalogFn is an implementation function for getting all permissions available to the org
+func getPermissionCatalogFn(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainpermissioncollection, *platformclientv2.APIResponse, error) {
 	const pageSize = 100
-	allowedPermissions := make(map[string][]platformclientv2.Domainpermission)
+	var allCollections []platformclientv2.Domainpermissioncollection
 
-	permissions, apiResponse, err := p.authorizationApi.GetAuthorizationPermissions(pageSize, 1, "domain", domain)
+	permissions, apiResponse, err := p.authorizationApi.GetAuthorizationPermissions(pageSize, 1, "", "")
 	if err != nil {
-		return nil, apiResponse, err
+		return nil, apiResponse, fmt.Errorf("failed to get page of permissions: %s", err)
 	}
-
 	if permissions.Entities == nil || len(*permissions.Entities) == 0 {
-		return &allowedPermissions, apiResponse, nil
-	}
-
-	for _, permission := range *permissions.Entities {
-		for entityType, entityPermissions := range *permission.PermissionMap {
-			allowedPermissions[entityType] = entityPermissions
-		}
+		return &allCollections, apiResponse, nil
 	}
+	allCollections = append(allCollections, *permissions.Entities...)
 
 	for pageNum := 2; pageNum <= *permissions.PageCount; pageNum++ {
-		permissions, apiResponse, err := p.authorizationApi.GetAuthorizationPermissions(pageSize, pageNum, "domain", domain)
+		permissions, apiResponse, err := p.authorizationApi.GetAuthorizationPermissions(pageSize, pageNum, "", "")
 		if err != nil {
-			return nil, apiResponse, err
+			return nil, apiResponse, fmt.Errorf("failed to get page of permissions: %s", err)
 		}
 		if permissions.Entities == nil || len(*permissions.Entities) == 0 {
 			break
 		}
-
-		for _, permission := range *permissions.Entities {
-			for entityType, entityPermissions := range *permission.PermissionMap {
-				allowedPermissions[entityType] = entityPermissions
-			}
-		}
+		allCollections = append(allCollections, *permissions.Entities...)
 	}
-	return &allowedPermissions, apiResponse, nil
+	return &allCollections, apiResponse, nil
 }
@@ -51,7 +51,7 @@ func createAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{
 	policies := buildSdkRolePermPolicies(d)
 	if policies != nil {
 		for _, policy := range *policies {
-			resp, err := validatePermissionPolicy(proxy, policy)
+			resp, err := validatePermissionPolicy(ctx, proxy, policy)
 			if err != nil {
 				return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Permission policy not found: %s, ensure your org has the required product for this permission", err), resp)
 			}
@@ -139,7 +139,7 @@ func updateAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{
 	policies := buildSdkRolePermPolicies(d)
 	if policies != nil {
 		for _, policy := range *policies {
-			resp, err := validatePermissionPolicy(proxy, policy)
+			resp, err := validatePermissionPolicy(ctx, proxy, policy)
 			if err != nil {
 				return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Permission policy not found: %s, ensure your org has the required product for this permission", err), resp)
 			}
@@ -17,11 +17,13 @@ resource_genesycloud_auth_role_schema.go holds four functions within it:
 4.  The resource exporter configuration for the auth_role exporter.
 */
 const resourceName = "genesyscloud_auth_role"
+const permissionsDataSourceName = "genesyscloud_auth_role_permissions"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceAuthRole())
 	regInstance.RegisterDataSource(resourceName, DataSourceAuthRole())
+	regInstance.RegisterDataSource(permissionsDataSourceName, DataSourceAuthRolePermissions())
 	regInstance.RegisterExporter(resourceName, AuthRoleExporter())
 }
 
@@ -131,6 +133,7 @@ func ResourceAuthRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeAuthRoleDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -150,7 +153,7 @@ func ResourceAuthRole() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"permission_policies": {
-				Description: "Role permission policies.",
+				Description: "Role permission policies. The domain, entity name and actions of each policy are validated at plan time against the permissions available to the org.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        rolePermPolicyResource,
@@ -195,3 +198,68 @@ func DataSourceAuthRole() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceAuthRolePermissions registers the genesyscloud_auth_role_permissions data source
+func DataSourceAuthRolePermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source for the effective permissions of a Genesys Cloud Role. Wildcards in the permission policies of the role are expanded into the individual permissions available to the org.`,
+		ReadContext: provider.ReadWithPooledClient(DataSourceAuthRolePermissionsRead),
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description: `Role ID.`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"permissions": {
+				Description: `General permissions of the role. e.g. 'group_creation'`,
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_permissions": {
+				Description: `Permissions granted by the permission policies of the role, sorted by domain, entity name and action.`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission": {
+							Description: `Permission in the form domain:entity_name:action. e.g. 'routing:queue:view'`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"domain": {
+							Description: `Permission domain.`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"entity_name": {
+							Description: `Permission entity.`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"action": {
+							Description: `Permission action.`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"label": {
+							Description: `Display label of the permission.`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"conditional": {
+							Description: `Whether the permission is only granted when the conditions of its policy are met.`,
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"division_aware": {
+							Description: `Whether the permission is granted per division.`,
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package auth_role
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
@@ -335,6 +336,44 @@ func TestAccResourceAuthRoleConditions(t *testing.T) {
 	})
 }
 
+func TestAccResourceAuthRoleInvalidPermissionPolicy(t *testing.T) {
+	var (
+		roleResource1 = "auth-role-invalid"
+		roleName1     = "Terraform Role-" + uuid.NewString()
+		roleDesc1     = "Terraform test role"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Misspelled entity is rejected at plan time
+				Config: GenerateAuthRoleResource(
+					roleResource1,
+					roleName1,
+					roleDesc1,
+					GenerateRolePermPolicy("routing", "queu", strconv.Quote("view")),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("entity_name queu not found for domain routing, did you mean queue?"),
+			},
+			{
+				// Misspelled action is rejected at plan time
+				Config: GenerateAuthRoleResource(
+					roleResource1,
+					roleName1,
+					roleDesc1,
+					GenerateRolePermPolicy("routing", "queue", strconv.Quote("veiw")),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("action veiw not found for domain routing, entity name queue, did you mean view?"),
+			},
+		},
+		CheckDestroy: testVerifyRolesDestroyed,
+	})
+}
+
 func generateRolePermPolicyCondition(domain string, entityName string, action string, conj string, terms ...string) string {
 	return fmt.Sprintf(` permission_policies {
 		domain = "%s"
@@ -1,52 +1,67 @@
 package auth_role
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
 )
 
-func validatePermissionPolicy(proxy *authRoleProxy, policy platformclientv2.Domainpermissionpolicy) (*platformclientv2.APIResponse, error) {
-	allowedPermissions, resp, err := proxy.getAllowedPermissions(*policy.Domain)
+// validatePermissionPolicy checks the policy against the permissions available to the org
+func validatePermissionPolicy(ctx context.Context, proxy *authRoleProxy, policy platformclientv2.Domainpermissionpolicy) (*platformclientv2.APIResponse, error) {
+	catalog, resp, err := proxy.getPermissionCatalog(ctx)
 	if err != nil {
 		return resp, fmt.Errorf("error requesting org permissions: %s", err)
 	}
-	if len(*allowedPermissions) == 0 {
-		return resp, fmt.Errorf("domain %s not found", *policy.Domain)
-	}
+	return resp, catalog.validatePolicy(policy)
+}
 
-	if *policy.EntityName == "*" {
-		return resp, nil
+// customizeAuthRoleDiff validates the permission policies against the permissions available to the org at plan time
+func customizeAuthRoleDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("permission_policies") {
+		return nil
+	}
+	policies := diff.Get("permission_policies").(*schema.Set).List()
+	if len(policies) == 0 {
+		return nil
 	}
 
-	// Check entity type (e.g. callableTimeSet) exists in the map of allowed permissions
-	if entityPermissions, ok := (*allowedPermissions)[*policy.EntityName]; ok {
-		// Check if the policy actions exist for the given domain permission e.g. callableTimeSet: add
-		for _, action := range *policy.ActionSet {
-			if action == "*" && len(entityPermissions) >= 1 {
-				break
-			}
+	var errs []error
+	var sdkPolicies []platformclientv2.Domainpermissionpolicy
+	for _, configPolicy := range policies {
+		policyMap := configPolicy.(map[string]interface{})
+		policy := buildSdkRolePermPolicy(policyMap)
+		policyName := fmt.Sprintf("%s:%s", *policy.Domain, *policy.EntityName)
+		errs = append(errs, validatePolicyConditions(policyName, policyMap["conditions"].([]interface{}))...)
 
-			var found bool
-			for _, entityPermission := range entityPermissions {
-				if action == *entityPermission.Action {
-					// action found, move to next action
-					found = true
-					break
-				}
-			}
-			if !found {
-				return resp, fmt.Errorf("action %s not found for domain %s, entity name %s", action, *policy.Domain, *policy.EntityName)
-			}
+		// Unknown values are validated on a later plan
+		if *policy.Domain == "" || *policy.EntityName == "" || lists.ItemInSlice("", *policy.ActionSet) {
+			continue
 		}
-		// All actions have been found, permission exists
-		return resp, nil
+		sdkPolicies = append(sdkPolicies, policy)
 	}
 
-	return resp, fmt.Errorf("entity_name %s not found for domain %s", *policy.EntityName, *policy.Domain)
+	if meta != nil && len(sdkPolicies) > 0 {
+		proxy := getAuthRoleProxy(meta.(*provider.ProviderMeta).ClientConfig)
+		catalog, resp, err := proxy.getPermissionCatalog(ctx)
+		if err != nil {
+			// The permissions are validated again before the role is created or updated
+			log.Printf("Skipping plan time validation of permission policies, failed to get org permissions: %s %v", err, resp)
+			return errors.Join(errs...)
+		}
+		for _, policy := range sdkPolicies {
+			if err := catalog.validatePolicy(policy); err != nil {
+				errs = append(errs, fmt.Errorf("permission_policies %s:%s: %w", *policy.Domain, *policy.EntityName, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func buildSdkRolePermissions(d *schema.ResourceData) *[]string {
@@ -61,24 +76,27 @@ func buildSdkRolePermPolicies(d *schema.ResourceData) *[]platformclientv2.Domain
 	if configPolicies, ok := d.GetOk("permission_policies"); ok {
 		policyList := configPolicies.(*schema.Set).List()
 		for _, configPolicy := range policyList {
-			policyMap := configPolicy.(map[string]interface{})
-			domain := policyMap["domain"].(string)
-			entityName := policyMap["entity_name"].(string)
-			policy := platformclientv2.Domainpermissionpolicy{
-				Domain:     &domain,
-				EntityName: &entityName,
-				ActionSet:  buildSdkPermPolicyActions(policyMap),
-			}
-			if conditions, ok := policyMap["conditions"]; ok {
-				conditionsList := conditions.([]interface{})
-				policy.ResourceConditionNode = buildSdkPermPolicyConditions(conditionsList)
-			}
-			sdkPolicies = append(sdkPolicies, policy)
+			sdkPolicies = append(sdkPolicies, buildSdkRolePermPolicy(configPolicy.(map[string]interface{})))
 		}
 	}
 	return &sdkPolicies
 }
 
+func buildSdkRolePermPolicy(policyMap map[string]interface{}) platformclientv2.Domainpermissionpolicy {
+	domain := policyMap["domain"].(string)
+	entityName := policyMap["entity_name"].(string)
+	policy := platformclientv2.Domainpermissionpolicy{
+		Domain:     &domain,
+		EntityName: &entityName,
+		ActionSet:  buildSdkPermPolicyActions(policyMap),
+	}
+	if conditions, ok := policyMap["conditions"]; ok {
+		conditionsList := conditions.([]interface{})
+		policy.ResourceConditionNode = buildSdkPermPolicyConditions(conditionsList)
+	}
+	return policy
+}
+
 func buildSdkPermPolicyActions(policyAttrs map[string]interface{}) *[]string {
 	if actions, ok := policyAttrs["action_set"]; ok {
 		return lists.SetToStringList(actions.(*schema.Set))
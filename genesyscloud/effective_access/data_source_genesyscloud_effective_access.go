@@ -0,0 +1,102 @@
+package effective_access
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_effective_access.go contains the data source implementation
+   for the effective access analyzer.
+*/
+
+// dataSourceEffectiveAccessRead resolves the effective access of a user, group or object
+func dataSourceEffectiveAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	proxy := getEffectiveAccessProxy(sdkConfig)
+
+	if object, ok := d.GetOk("object"); ok {
+		objectMap := object.([]interface{})[0].(map[string]interface{})
+		return readObjectAccess(ctx, d, proxy, objectMap["permission"].(string), objectMap["division_id"].(string))
+	}
+
+	subjectId := d.Get("user_id").(string)
+	if subjectId == "" {
+		subjectId = d.Get("group_id").(string)
+	}
+	return readSubjectAccess(ctx, d, proxy, subjectId)
+}
+
+func readSubjectAccess(ctx context.Context, d *schema.ResourceData, proxy *effectiveAccessProxy, subjectId string) diag.Diagnostics {
+	log.Printf("Resolving effective access of subject %s", subjectId)
+	sdkGrants, resp, err := proxy.getSubjectGrants(ctx, subjectId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get grants of subject %s | error: %s", subjectId, err), resp)
+	}
+	grants := buildSubjectGrants(subjectId, *sdkGrants)
+
+	var roles []platformclientv2.Domainorganizationrole
+	seen := make(map[string]bool)
+	for _, grant := range grants {
+		if seen[grant.roleId] {
+			continue
+		}
+		seen[grant.roleId] = true
+		role, resp, err := proxy.getAuthRole(ctx, grant.roleId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get role %s granted to subject %s | error: %s", grant.roleId, subjectId, err), resp)
+		}
+		roles = append(roles, *role)
+	}
+
+	rolePermissions, resp, err := getRolePermissions(ctx, proxy, roles)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, err.Error(), resp)
+	}
+
+	d.SetId(subjectId)
+	_ = d.Set("grants", flattenSubjectGrants(grants))
+	_ = d.Set("permissions", flattenResolvedPermissions(resolvePermissions(grants, rolePermissions)))
+	_ = d.Set("subjects", []interface{}{})
+	log.Printf("Resolved effective access of subject %s", subjectId)
+	return nil
+}
+
+func readObjectAccess(ctx context.Context, d *schema.ResourceData, proxy *effectiveAccessProxy, permission string, divisionId string) diag.Diagnostics {
+	log.Printf("Resolving subjects with permission %s in division %s", permission, divisionId)
+	roles, resp, err := proxy.getAuthRolesWithPermission(ctx, permission)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get roles with permission %s | error: %s", permission, err), resp)
+	}
+
+	rolePermissions, resp, err := getRolePermissions(ctx, proxy, *roles)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, err.Error(), resp)
+	}
+
+	roleSubjects := make(map[string][]platformclientv2.Subjectdivisiongrants)
+	for _, role := range *roles {
+		if role.Id == nil {
+			continue
+		}
+		subjects, resp, err := proxy.getRoleSubjectGrants(ctx, *role.Id)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get subjects of role %s | error: %s", *role.Id, err), resp)
+		}
+		roleSubjects[*role.Id] = *subjects
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", permission, divisionId))
+	_ = d.Set("grants", []interface{}{})
+	_ = d.Set("permissions", []interface{}{})
+	_ = d.Set("subjects", flattenObjectSubjects(resolveObjectSubjects(permission, divisionId, roleSubjects, rolePermissions)))
+	log.Printf("Resolved subjects with permission %s in division %s", permission, divisionId)
+	return nil
+}
@@ -0,0 +1,81 @@
+package effective_access
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/user"
+	userRoles "terraform-provider-genesyscloud/genesyscloud/user_roles"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEffectiveAccess(t *testing.T) {
+	var (
+		userResource1     = "test-user"
+		email1            = "terraform-" + uuid.NewString() + "@example.com"
+		userName1         = "Effective Access Terraform"
+		roleResource1     = "test-role-1"
+		roleName1         = "Terraform Effective Access Test" + uuid.NewString()
+		userRoleResource1 = "test-user-roles"
+		accessDataSource  = "user-access"
+		objectDataSource  = "queue-edit-access"
+	)
+
+	config := "data \"genesyscloud_auth_division_home\" \"home\" {}\n" + user.GenerateBasicUserResource(
+		userResource1,
+		email1,
+		userName1,
+	) + authRole.GenerateAuthRoleResource(
+		roleResource1,
+		roleName1,
+		"Terraform effective access test",
+		authRole.GenerateRolePermPolicy("routing", "queue", strconv.Quote("edit"), strconv.Quote("view")),
+	) + userRoles.GenerateUserRoles(
+		userRoleResource1,
+		userResource1,
+		fmt.Sprintf(`roles {
+			role_id      = genesyscloud_auth_role.%s.id
+			division_ids = [data.genesyscloud_auth_division_home.home.id]
+		}`, roleResource1),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config + generateEffectiveAccessDataSource(accessDataSource,
+					"user_id    = genesyscloud_user."+userResource1+".id",
+					"depends_on = [genesyscloud_user_roles."+userRoleResource1+"]",
+				) + generateEffectiveAccessDataSource(objectDataSource,
+					`object {
+						permission  = "routing:queue:edit"
+						division_id = data.genesyscloud_auth_division_home.home.id
+					}`,
+					"depends_on = [genesyscloud_user_roles."+userRoleResource1+"]",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.genesyscloud_effective_access."+accessDataSource, "grants.*.role_id", "genesyscloud_auth_role."+roleResource1, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.genesyscloud_effective_access."+accessDataSource, "permissions.*", map[string]string{
+						"permission":  "routing:queue:edit",
+						"conditional": "false",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.genesyscloud_effective_access."+objectDataSource, "subjects.*.subject_id", "genesyscloud_user."+userResource1, "id"),
+				),
+			},
+		},
+	})
+}
+
+func generateEffectiveAccessDataSource(resourceID string, attrs ...string) string {
+	return fmt.Sprintf(`data "genesyscloud_effective_access" "%s" {
+		%s
+	}
+	`, resourceID, strings.Join(attrs, "\n"))
+}
@@ -0,0 +1,165 @@
+package effective_access
+
+import (
+	"context"
+	"fmt"
+	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_effective_access_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *effectiveAccessProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getSubjectGrantsFunc func(ctx context.Context, p *effectiveAccessProxy, subjectId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
+type getAuthRoleFunc func(ctx context.Context, p *effectiveAccessProxy, roleId string) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
+type getAuthRolesWithPermissionFunc func(ctx context.Context, p *effectiveAccessProxy, permission string) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
+type getRoleSubjectGrantsFunc func(ctx context.Context, p *effectiveAccessProxy, roleId string) (*[]platformclientv2.Subjectdivisiongrants, *platformclientv2.APIResponse, error)
+type expandPermissionPoliciesFunc func(ctx context.Context, p *effectiveAccessProxy, policies []authRole.PermissionPolicy) ([]authRole.EffectivePermission, *platformclientv2.APIResponse, error)
+
+// effectiveAccessProxy contains all of the methods that call genesys cloud APIs.
+type effectiveAccessProxy struct {
+	clientConfig                   *platformclientv2.Configuration
+	authorizationApi               *platformclientv2.AuthorizationApi
+	getSubjectGrantsAttr           getSubjectGrantsFunc
+	getAuthRoleAttr                getAuthRoleFunc
+	getAuthRolesWithPermissionAttr getAuthRolesWithPermissionFunc
+	getRoleSubjectGrantsAttr       getRoleSubjectGrantsFunc
+	expandPermissionPoliciesAttr   expandPermissionPoliciesFunc
+}
+
+// newEffectiveAccessProxy initializes the effective access proxy with all of the data needed to communicate with Genesys Cloud
+func newEffectiveAccessProxy(clientConfig *platformclientv2.Configuration) *effectiveAccessProxy {
+	api := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
+	return &effectiveAccessProxy{
+		clientConfig:                   clientConfig,
+		authorizationApi:               api,
+		getSubjectGrantsAttr:           getSubjectGrantsFn,
+		getAuthRoleAttr:                getAuthRoleFn,
+		getAuthRolesWithPermissionAttr: getAuthRolesWithPermissionFn,
+		getRoleSubjectGrantsAttr:       getRoleSubjectGrantsFn,
+		expandPermissionPoliciesAttr:   expandPermissionPoliciesFn,
+	}
+}
+
+// getEffectiveAccessProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getEffectiveAccessProxy(clientConfig *platformclientv2.Configuration) *effectiveAccessProxy {
+	if internalProxy == nil {
+		internalProxy = newEffectiveAccessProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getSubjectGrants returns the grants of a user or group, including the grants a user inherits from its groups
+func (p *effectiveAccessProxy) getSubjectGrants(ctx context.Context, subjectId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	return p.getSubjectGrantsAttr(ctx, p, subjectId)
+}
+
+// getAuthRole returns a single Genesys Cloud auth role by Id
+func (p *effectiveAccessProxy) getAuthRole(ctx context.Context, roleId string) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	return p.getAuthRoleAttr(ctx, p, roleId)
+}
+
+// getAuthRolesWithPermission returns all roles that grant a permission e.g. routing:queue:edit
+func (p *effectiveAccessProxy) getAuthRolesWithPermission(ctx context.Context, permission string) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	return p.getAuthRolesWithPermissionAttr(ctx, p, permission)
+}
+
+// getRoleSubjectGrants returns the users and groups a role is granted to and the divisions it is granted in
+func (p *effectiveAccessProxy) getRoleSubjectGrants(ctx context.Context, roleId string) (*[]platformclientv2.Subjectdivisiongrants, *platformclientv2.APIResponse, error) {
+	return p.getRoleSubjectGrantsAttr(ctx, p, roleId)
+}
+
+// expandPermissionPolicies expands the permission policies of a role into the individual permissions they grant
+func (p *effectiveAccessProxy) expandPermissionPolicies(ctx context.Context, policies []authRole.PermissionPolicy) ([]authRole.EffectivePermission, *platformclientv2.APIResponse, error) {
+	return p.expandPermissionPoliciesAttr(ctx, p, policies)
+}
+
+// getSubjectGrantsFn is an implementation function for getting the grants of a subject
+func getSubjectGrantsFn(ctx context.Context, p *effectiveAccessProxy, subjectId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	var grants []platformclientv2.Authzgrant
+	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(subjectId, true)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get grants for subject %s: %s", subjectId, err)
+	}
+	if subject != nil && subject.Grants != nil {
+		grants = *subject.Grants
+	}
+	return &grants, resp, nil
+}
+
+// getAuthRoleFn is an implementation function for getting a role by Id
+func getAuthRoleFn(ctx context.Context, p *effectiveAccessProxy, roleId string) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	role, resp, err := p.authorizationApi.GetAuthorizationRole(roleId, false, nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve role %s by id: %s", roleId, err)
+	}
+	return role, resp, nil
+}
+
+// getAuthRolesWithPermissionFn is an implementation function for getting all roles that grant a permission
+func getAuthRolesWithPermissionFn(ctx context.Context, p *effectiveAccessProxy, permission string) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allRoles []platformclientv2.Domainorganizationrole
+
+	roles, resp, err := p.authorizationApi.GetAuthorizationRoles(pageSize, 1, "", nil, "", "", "", []string{permission}, nil, false, nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get page of roles with permission %s: %s", permission, err)
+	}
+	if roles.Entities == nil || len(*roles.Entities) == 0 {
+		return &allRoles, resp, nil
+	}
+	allRoles = append(allRoles, *roles.Entities...)
+
+	for pageNum := 2; pageNum <= *roles.PageCount; pageNum++ {
+		roles, resp, err := p.authorizationApi.GetAuthorizationRoles(pageSize, pageNum, "", nil, "", "", "", []string{permission}, nil, false, nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get page of roles with permission %s: %s", permission, err)
+		}
+		if roles.Entities == nil || len(*roles.Entities) == 0 {
+			break
+		}
+		allRoles = append(allRoles, *roles.Entities...)
+	}
+	return &allRoles, resp, nil
+}
+
+// getRoleSubjectGrantsFn is an implementation function for getting the subjects a role is granted to
+func getRoleSubjectGrantsFn(ctx context.Context, p *effectiveAccessProxy, roleId string) (*[]platformclientv2.Subjectdivisiongrants, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allSubjects []platformclientv2.Subjectdivisiongrants
+
+	subjects, resp, err := p.authorizationApi.GetAuthorizationRoleSubjectgrants(roleId, pageSize, 1, "", nil, "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get page of subject grants for role %s: %s", roleId, err)
+	}
+	if subjects.Entities == nil || len(*subjects.Entities) == 0 {
+		return &allSubjects, resp, nil
+	}
+	allSubjects = append(allSubjects, *subjects.Entities...)
+
+	for pageNum := 2; pageNum <= *subjects.PageCount; pageNum++ {
+		subjects, resp, err := p.authorizationApi.GetAuthorizationRoleSubjectgrants(roleId, pageSize, pageNum, "", nil, "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get page of subject grants for role %s: %s", roleId, err)
+		}
+		if subjects.Entities == nil || len(*subjects.Entities) == 0 {
+			break
+		}
+		allSubjects = append(allSubjects, *subjects.Entities...)
+	}
+	return &allSubjects, resp, nil
+}
+
+// expandPermissionPoliciesFn is an implementation function for expanding permission policies against the permissions available to the org
+func expandPermissionPoliciesFn(ctx context.Context, p *effectiveAccessProxy, policies []authRole.PermissionPolicy) ([]authRole.EffectivePermission, *platformclientv2.APIResponse, error) {
+	return authRole.ExpandPermissionPolicies(ctx, p.clientConfig, policies)
+}
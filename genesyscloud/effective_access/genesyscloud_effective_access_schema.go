@@ -0,0 +1,181 @@
+package effective_access
+
+import (
+	"regexp"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_effective_access_schema.go holds the registration code and the data source schema for the effective access data source.
+*/
+const resourceName = "genesyscloud_effective_access"
+
+// allDivisions is the division ID of grants that apply to all current and future divisions
+const allDivisions = "*"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(resourceName, DataSourceEffectiveAccess())
+}
+
+var (
+	subjectSelectors = []string{"user_id", "group_id", "object"}
+
+	grantResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description: "Role ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"role_name": {
+				Description: "Role name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"division_id": {
+				Description: "Division the role is granted in, or '*' for all divisions.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"division_name": {
+				Description: "Division name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"group_id": {
+				Description: "Group the grant is inherited from. Empty for roles granted directly to the subject.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	resolvedPermissionResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"permission": {
+				Description: "Permission in the form domain:entity_name:action. e.g. 'routing:queue:edit'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"division_ids": {
+				Description: "Divisions the permission is granted in. This is ['*'] when it is granted in all divisions or the permission is not division aware.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"role_ids": {
+				Description: "Roles that grant the permission.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"conditional": {
+				Description: "Whether every role that grants the permission only grants it when the conditions of its policy are met.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+
+	objectSubjectResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subject_id": {
+				Description: "ID of the user or group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subject_name": {
+				Description: "Name of the user or group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subject_type": {
+				Description: "Type of the subject as reported by Genesys Cloud.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"role_id": {
+				Description: "Role that grants the permission.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"division_id": {
+				Description: "Division the role is granted in, or '*' for all divisions.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"conditional": {
+				Description: "Whether the role only grants the permission when the conditions of its policy are met.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+)
+
+// DataSourceEffectiveAccess registers the genesyscloud_effective_access data source
+func DataSourceEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the effective access in Genesys Cloud. For a user or group it resolves the permissions granted by its roles, including the roles a user inherits from its groups, in each division. For an object it lists the users and groups that hold a permission in the division of the object.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceEffectiveAccessRead),
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description:  "User to resolve the effective permissions of.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: subjectSelectors,
+			},
+			"group_id": {
+				Description:  "Group to resolve the effective permissions of.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: subjectSelectors,
+			},
+			"object": {
+				Description:  "Object to find the users and groups with access to.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: subjectSelectors,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission": {
+							Description:  "Permission on the object in the form domain:entity_name:action. e.g. 'routing:queue:edit'",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^:*]+:[^:*]+:[^:*]+$`), "must be in the form domain:entity_name:action without wildcards"),
+						},
+						"division_id": {
+							Description: "Division of the object.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"grants": {
+				Description: "Roles granted to the user or group, sorted by role and division. Empty for an object.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        grantResource,
+			},
+			"permissions": {
+				Description: "Permissions granted to the user or group, sorted by permission. Empty for an object.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        resolvedPermissionResource,
+			},
+			"subjects": {
+				Description: "Users and groups that hold the permission of the object in its division, sorted by subject and role. Empty for a user or group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        objectSubjectResource,
+			},
+		},
+	}
+}
@@ -0,0 +1,229 @@
+package effective_access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_effective_access_utils.go file resolves the grants of a user or group, and the subjects of a role, into
+effective permissions per division.
+*/
+
+// subjectGrant is a role granted to a subject in a division, either directly or through a group
+type subjectGrant struct {
+	roleId       string
+	roleName     string
+	divisionId   string
+	divisionName string
+	groupId      string
+}
+
+// resolvedPermission is a permission granted to a subject by one or more grants
+type resolvedPermission struct {
+	permission  string
+	divisionIds []string
+	roleIds     []string
+	conditional bool
+}
+
+// objectSubject is a user or group that holds a permission on an object through a role
+type objectSubject struct {
+	subjectId   string
+	subjectName string
+	subjectType string
+	roleId      string
+	divisionId  string
+	conditional bool
+}
+
+// buildSubjectGrants converts the grants of a subject. Grants with a subject ID other than the subject are inherited from a group.
+func buildSubjectGrants(subjectId string, grants []platformclientv2.Authzgrant) []subjectGrant {
+	var result []subjectGrant
+	for _, grant := range grants {
+		if grant.Role == nil || grant.Role.Id == nil || grant.Division == nil || grant.Division.Id == nil {
+			continue
+		}
+		sg := subjectGrant{
+			roleId:       *grant.Role.Id,
+			roleName:     util.StringValue(grant.Role.Name),
+			divisionId:   *grant.Division.Id,
+			divisionName: util.StringValue(grant.Division.Name),
+		}
+		if grant.SubjectId != nil && *grant.SubjectId != subjectId {
+			sg.groupId = *grant.SubjectId
+		}
+		result = append(result, sg)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].roleId != result[j].roleId {
+			return result[i].roleId < result[j].roleId
+		}
+		if result[i].divisionId != result[j].divisionId {
+			return result[i].divisionId < result[j].divisionId
+		}
+		return result[i].groupId < result[j].groupId
+	})
+	return result
+}
+
+// getRolePermissions expands the permission policies of each role into the permissions it grants
+func getRolePermissions(ctx context.Context, proxy *effectiveAccessProxy, roles []platformclientv2.Domainorganizationrole) (map[string][]authRole.EffectivePermission, *platformclientv2.APIResponse, error) {
+	rolePermissions := make(map[string][]authRole.EffectivePermission)
+	for _, role := range roles {
+		if role.Id == nil {
+			continue
+		}
+		var policies []authRole.PermissionPolicy
+		if role.PermissionPolicies != nil {
+			policies = authRole.BuildPermissionPolicies(*role.PermissionPolicies)
+		}
+		permissions, resp, err := proxy.expandPermissionPolicies(ctx, policies)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to expand the permissions of role %s: %s", *role.Id, err)
+		}
+		rolePermissions[*role.Id] = permissions
+	}
+	return rolePermissions, nil, nil
+}
+
+// resolvePermissions combines the permissions of every grant. A permission that is not division aware, or that is
+// granted in all divisions, is reported with the division '*'.
+func resolvePermissions(grants []subjectGrant, rolePermissions map[string][]authRole.EffectivePermission) []resolvedPermission {
+	type accumulator struct {
+		divisions   map[string]bool
+		roles       map[string]bool
+		conditional bool
+	}
+	permissions := make(map[string]*accumulator)
+
+	for _, grant := range grants {
+		for _, permission := range rolePermissions[grant.roleId] {
+			key := permission.String()
+			acc, ok := permissions[key]
+			if !ok {
+				acc = &accumulator{divisions: make(map[string]bool), roles: make(map[string]bool), conditional: true}
+				permissions[key] = acc
+			}
+			divisionId := grant.divisionId
+			if !permission.DivisionAware {
+				divisionId = allDivisions
+			}
+			acc.divisions[divisionId] = true
+			acc.roles[grant.roleId] = true
+			acc.conditional = acc.conditional && permission.Conditional
+		}
+	}
+
+	var result []resolvedPermission
+	for _, key := range stringmap.SortedKeys(permissions) {
+		acc := permissions[key]
+		divisionIds := stringmap.SortedKeys(acc.divisions)
+		if acc.divisions[allDivisions] {
+			divisionIds = []string{allDivisions}
+		}
+		result = append(result, resolvedPermission{
+			permission:  key,
+			divisionIds: divisionIds,
+			roleIds:     stringmap.SortedKeys(acc.roles),
+			conditional: acc.conditional,
+		})
+	}
+	return result
+}
+
+// resolveObjectSubjects finds the subjects of each role that hold the permission in the division of an object
+func resolveObjectSubjects(permission string, divisionId string, roleSubjects map[string][]platformclientv2.Subjectdivisiongrants, rolePermissions map[string][]authRole.EffectivePermission) []objectSubject {
+	var result []objectSubject
+	for _, roleId := range stringmap.SortedKeys(roleSubjects) {
+		var granted *authRole.EffectivePermission
+		for _, rolePermission := range rolePermissions[roleId] {
+			if rolePermission.String() == permission {
+				rolePermission := rolePermission
+				granted = &rolePermission
+				break
+			}
+		}
+		if granted == nil {
+			continue
+		}
+
+		for _, subject := range roleSubjects[roleId] {
+			if subject.Id == nil || subject.Divisions == nil {
+				continue
+			}
+			for _, division := range *subject.Divisions {
+				if division.Id == nil {
+					continue
+				}
+				if granted.DivisionAware && *division.Id != divisionId && *division.Id != allDivisions {
+					continue
+				}
+				result = append(result, objectSubject{
+					subjectId:   *subject.Id,
+					subjectName: util.StringValue(subject.Name),
+					subjectType: util.StringValue(subject.VarType),
+					roleId:      roleId,
+					divisionId:  *division.Id,
+					conditional: granted.Conditional,
+				})
+				break
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].subjectId != result[j].subjectId {
+			return result[i].subjectId < result[j].subjectId
+		}
+		return result[i].roleId < result[j].roleId
+	})
+	return result
+}
+
+func flattenSubjectGrants(grants []subjectGrant) []interface{} {
+	grantList := make([]interface{}, 0, len(grants))
+	for _, grant := range grants {
+		grantList = append(grantList, map[string]interface{}{
+			"role_id":       grant.roleId,
+			"role_name":     grant.roleName,
+			"division_id":   grant.divisionId,
+			"division_name": grant.divisionName,
+			"group_id":      grant.groupId,
+		})
+	}
+	return grantList
+}
+
+func flattenResolvedPermissions(permissions []resolvedPermission) []interface{} {
+	permissionList := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionList = append(permissionList, map[string]interface{}{
+			"permission":   permission.permission,
+			"division_ids": permission.divisionIds,
+			"role_ids":     permission.roleIds,
+			"conditional":  permission.conditional,
+		})
+	}
+	return permissionList
+}
+
+func flattenObjectSubjects(subjects []objectSubject) []interface{} {
+	subjectList := make([]interface{}, 0, len(subjects))
+	for _, subject := range subjects {
+		subjectList = append(subjectList, map[string]interface{}{
+			"subject_id":   subject.subjectId,
+			"subject_name": subject.subjectName,
+			"subject_type": subject.subjectType,
+			"role_id":      subject.roleId,
+			"division_id":  subject.divisionId,
+			"conditional":  subject.conditional,
+		})
+	}
+	return subjectList
+}
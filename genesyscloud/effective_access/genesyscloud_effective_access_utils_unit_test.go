@@ -0,0 +1,120 @@
+package effective_access
+
+import (
+	"context"
+	"net/http"
+	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestGrant(subjectId string, roleId string, divisionId string) platformclientv2.Authzgrant {
+	roleName := roleId + " name"
+	divisionName := divisionId + " name"
+	return platformclientv2.Authzgrant{
+		SubjectId: &subjectId,
+		Role:      &platformclientv2.Authzgrantrole{Id: &roleId, Name: &roleName},
+		Division:  &platformclientv2.Authzdivision{Id: &divisionId, Name: &divisionName},
+	}
+}
+
+func buildTestRolePermissions() map[string][]authRole.EffectivePermission {
+	return map[string][]authRole.EffectivePermission{
+		"queue-editor": {
+			{Domain: "routing", EntityName: "queue", Action: "edit", DivisionAware: true},
+			{Domain: "routing", EntityName: "queue", Action: "view", DivisionAware: true},
+		},
+		"queue-viewer": {
+			{Domain: "routing", EntityName: "queue", Action: "view", DivisionAware: true, Conditional: true},
+			{Domain: "analytics", EntityName: "conversationDetail", Action: "view", Conditional: true},
+		},
+	}
+}
+
+func TestUnitBuildSubjectGrants(t *testing.T) {
+	grants := buildSubjectGrants("user-1", []platformclientv2.Authzgrant{
+		buildTestGrant("group-1", "queue-viewer", "division-2"),
+		buildTestGrant("user-1", "queue-editor", "division-1"),
+		{SubjectId: nil},
+	})
+
+	assert.Equal(t, []subjectGrant{
+		{roleId: "queue-editor", roleName: "queue-editor name", divisionId: "division-1", divisionName: "division-1 name"},
+		{roleId: "queue-viewer", roleName: "queue-viewer name", divisionId: "division-2", divisionName: "division-2 name", groupId: "group-1"},
+	}, grants)
+}
+
+func TestUnitResolvePermissions(t *testing.T) {
+	grants := []subjectGrant{
+		{roleId: "queue-editor", divisionId: "division-1"},
+		{roleId: "queue-viewer", divisionId: "division-2", groupId: "group-1"},
+		{roleId: "queue-viewer", divisionId: "division-1"},
+	}
+
+	assert.Equal(t, []resolvedPermission{
+		{permission: "analytics:conversationDetail:view", divisionIds: []string{"*"}, roleIds: []string{"queue-viewer"}, conditional: true},
+		{permission: "routing:queue:edit", divisionIds: []string{"division-1"}, roleIds: []string{"queue-editor"}, conditional: false},
+		{permission: "routing:queue:view", divisionIds: []string{"division-1", "division-2"}, roleIds: []string{"queue-editor", "queue-viewer"}, conditional: false},
+	}, resolvePermissions(grants, buildTestRolePermissions()))
+
+	// A grant in all divisions covers every division
+	grants = append(grants, subjectGrant{roleId: "queue-editor", divisionId: "*"})
+	permissions := resolvePermissions(grants, buildTestRolePermissions())
+	assert.Equal(t, []string{"*"}, permissions[1].divisionIds)
+}
+
+func TestUnitResolveObjectSubjects(t *testing.T) {
+	user := "PC_USER"
+	group := "PC_GROUP"
+	buildSubject := func(id string, subjectType *string, divisionIds ...string) platformclientv2.Subjectdivisiongrants {
+		var divisions []platformclientv2.Division
+		for _, divisionId := range divisionIds {
+			divisionId := divisionId
+			divisions = append(divisions, platformclientv2.Division{Id: &divisionId})
+		}
+		return platformclientv2.Subjectdivisiongrants{Id: &id, VarType: subjectType, Divisions: &divisions}
+	}
+
+	roleSubjects := map[string][]platformclientv2.Subjectdivisiongrants{
+		"queue-editor": {
+			buildSubject("user-1", &user, "division-2", "division-1"),
+			buildSubject("user-2", &user, "division-2"),
+			buildSubject("group-1", &group, "*"),
+		},
+		"queue-viewer": {buildSubject("user-3", &user, "division-1")},
+	}
+
+	subjects := resolveObjectSubjects("routing:queue:edit", "division-1", roleSubjects, buildTestRolePermissions())
+	assert.Equal(t, []objectSubject{
+		{subjectId: "group-1", subjectType: group, roleId: "queue-editor", divisionId: "*"},
+		{subjectId: "user-1", subjectType: user, roleId: "queue-editor", divisionId: "division-1"},
+	}, subjects)
+
+	// Permissions that are not division aware are held in every division
+	subjects = resolveObjectSubjects("analytics:conversationDetail:view", "division-9", roleSubjects, buildTestRolePermissions())
+	assert.Equal(t, []objectSubject{
+		{subjectId: "user-3", subjectType: user, roleId: "queue-viewer", divisionId: "division-1", conditional: true},
+	}, subjects)
+}
+
+func TestUnitGetRolePermissions(t *testing.T) {
+	roleId := "queue-editor"
+	domain := "routing"
+	entityName := "*"
+	actions := []string{"edit"}
+
+	proxy := &effectiveAccessProxy{}
+	proxy.expandPermissionPoliciesAttr = func(ctx context.Context, p *effectiveAccessProxy, policies []authRole.PermissionPolicy) ([]authRole.EffectivePermission, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, []authRole.PermissionPolicy{{Domain: domain, EntityName: entityName, Actions: actions}}, policies)
+		return []authRole.EffectivePermission{{Domain: domain, EntityName: "queue", Action: "edit"}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	rolePermissions, _, err := getRolePermissions(context.Background(), proxy, []platformclientv2.Domainorganizationrole{{
+		Id:                 &roleId,
+		PermissionPolicies: &[]platformclientv2.Domainpermissionpolicy{{Domain: &domain, EntityName: &entityName, ActionSet: &actions}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "routing:queue:edit", rolePermissions[roleId][0].String())
+}
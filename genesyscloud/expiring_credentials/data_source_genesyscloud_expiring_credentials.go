@@ -0,0 +1,111 @@
+package expiring_credentials
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+   The data_source_genesyscloud_expiring_credentials.go contains the data source implementation
+   for the expiring credentials data source.
+*/
+
+// dataSourceExpiringCredentialsRead scans the resources of each requested type for certificates expiring soon
+func dataSourceExpiringCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	withinDays := d.Get("within_days").(int)
+	resourceTypes := defaultResourceTypes
+	if types, ok := d.GetOk("resource_types"); ok {
+		resourceTypes = *lists.SetToStringList(types.(*schema.Set))
+	}
+	sort.Strings(resourceTypes)
+
+	exporters := resourceExporter.GetResourceExporters()
+	resources, _ := registrar.GetResources()
+
+	var credentials []expiringCredential
+	for _, resourceType := range resourceTypes {
+		exporter, res := exporters[resourceType], resources[resourceType]
+		if exporter == nil || exporter.GetResourcesFunc == nil || res == nil {
+			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Unable to scan resource type %s", resourceType), fmt.Errorf("%s is not an exportable resource type", resourceType))
+		}
+		found, diagErr := scanResourceType(ctx, resourceType, res, exporter, meta)
+		if diagErr != nil {
+			return diagErr
+		}
+		credentials = append(credentials, found...)
+	}
+
+	now := time.Now()
+	expiring := filterExpiringCredentials(credentials, withinDays, now)
+	log.Printf("Found %d certificates expiring within %d days out of %d scanned", len(expiring), withinDays, len(credentials))
+
+	d.SetId(fmt.Sprintf("%s/%d", strings.Join(resourceTypes, ","), withinDays))
+	_ = d.Set("items", flattenExpiringCredentials(expiring, now))
+	return nil
+}
+
+// scanResourceType lists the resources of a type with its exporter and scans the state of each one for certificates
+func scanResourceType(ctx context.Context, resourceType string, res *schema.Resource, exporter *resourceExporter.ResourceExporter, meta interface{}) ([]expiringCredential, diag.Diagnostics) {
+	log.Printf("Scanning %s for certificates", resourceType)
+	resourceMetas, diagErr := exporter.GetResourcesFunc(ctx)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	ids := make([]string, 0, len(resourceMetas))
+	for id := range resourceMetas {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var credentials []expiringCredential
+	for _, id := range ids {
+		resourceMeta := resourceMetas[id]
+		attributes, diagErr := readResourceAttributes(ctx, res, resourceMeta.IdPrefix+id, meta)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		credentials = append(credentials, scanAttributes(resourceType, id, resourceMeta.Name, attributes)...)
+	}
+	return credentials, nil
+}
+
+// readResourceAttributes reads the state of a resource the same way the exporter does. Nil is returned for
+// resources that no longer exist.
+func readResourceAttributes(ctx context.Context, res *schema.Resource, id string, meta interface{}) (map[string]string, diag.Diagnostics) {
+	instanceState := &terraform.InstanceState{ID: id}
+	if res.Importer != nil && res.Importer.StateContext != nil {
+		resourceDataArr, err := res.Importer.StateContext(ctx, res.Data(instanceState), meta)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if len(resourceDataArr) > 0 {
+			instanceState = resourceDataArr[0].State()
+		}
+	}
+
+	state, diagErr := res.RefreshWithoutUpgrade(ctx, instanceState, meta)
+	if diagErr != nil {
+		if strings.Contains(fmt.Sprintf("%v", diagErr), "API Error: 404") ||
+			strings.Contains(fmt.Sprintf("%v", diagErr), "API Error: 410") {
+			return nil, nil
+		}
+		return nil, diagErr
+	}
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+	return state.Attributes, nil
+}
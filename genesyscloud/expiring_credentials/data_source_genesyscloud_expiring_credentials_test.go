@@ -0,0 +1,58 @@
+package expiring_credentials
+
+import (
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExpiringCredentials(t *testing.T) {
+	var (
+		idpName        = "Test okta " + uuid.NewString()
+		dataSourceName = "expiring"
+	)
+
+	idpConfig := fmt.Sprintf(`resource "genesyscloud_idp_okta" "okta" {
+		name         = "%s"
+		certificates = [%q]
+		issuer_uri   = "https://test.com/1"
+		target_uri   = "https://test.com/2"
+	}
+	`, idpName, util.TestCert1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The test certificate expires in 2122
+				Config: idpConfig + generateExpiringCredentialsDataSource(dataSourceName, 40000, "genesyscloud_idp_okta.okta"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_expiring_credentials."+dataSourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr("data.genesyscloud_expiring_credentials."+dataSourceName, "items.0.resource_type", "genesyscloud_idp_okta"),
+					resource.TestCheckResourceAttr("data.genesyscloud_expiring_credentials."+dataSourceName, "items.0.attribute", "certificates.0"),
+					resource.TestCheckResourceAttr("data.genesyscloud_expiring_credentials."+dataSourceName, "items.0.expired", "false"),
+				),
+			},
+			{
+				Config: idpConfig + generateExpiringCredentialsDataSource(dataSourceName, 30, "genesyscloud_idp_okta.okta"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_expiring_credentials."+dataSourceName, "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func generateExpiringCredentialsDataSource(dataSourceName string, withinDays int, dependsOn string) string {
+	return fmt.Sprintf(`data "genesyscloud_expiring_credentials" "%s" {
+		within_days    = %d
+		resource_types = ["genesyscloud_idp_okta"]
+		depends_on     = [%s]
+	}
+	`, dataSourceName, withinDays, dependsOn)
+}
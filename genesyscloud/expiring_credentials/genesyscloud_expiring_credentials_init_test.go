@@ -0,0 +1,75 @@
+package expiring_credentials
+
+import (
+	"sync"
+	idpOkta "terraform-provider-genesyscloud/genesyscloud/idp_okta"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_expiring_credentials_init_test.go file is used to initialize the data sources and resources
+   used in testing the expiring credentials data source.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+// providerExporters holds a map of all registered exporters
+var providerExporters map[string]*resourceExporter.ResourceExporter
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources["genesyscloud_idp_okta"] = idpOkta.ResourceIdpOkta()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[resourceName] = DataSourceExpiringCredentials()
+}
+
+// registerTestExporters registers the exporters of the resource types scanned in the tests
+func (r *registerTestInstance) registerTestExporters() {
+	providerExporters["genesyscloud_idp_okta"] = idpOkta.IdpOktaExporter()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+	providerExporters = make(map[string]*resourceExporter.ResourceExporter)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+	regInstance.registerTestExporters()
+	registrar.SetResources(providerResources, providerDataSources)
+	resourceExporter.SetRegisterExporter(providerExporters)
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the expiring_credentials package
+	initTestResources()
+
+	// Run the test suite for the expiring_credentials package
+	m.Run()
+}
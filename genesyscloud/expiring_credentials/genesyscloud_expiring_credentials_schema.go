@@ -0,0 +1,120 @@
+package expiring_credentials
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_expiring_credentials_schema.go holds the registration code and the data source schema for the expiring credentials data source.
+*/
+const resourceName = "genesyscloud_expiring_credentials"
+
+// defaultResourceTypes are the resource types scanned when resource_types is not set
+var defaultResourceTypes = []string{
+	"genesyscloud_idp_adfs",
+	"genesyscloud_idp_generic",
+	"genesyscloud_idp_gsuite",
+	"genesyscloud_idp_okta",
+	"genesyscloud_idp_onelogin",
+	"genesyscloud_idp_ping",
+	"genesyscloud_idp_salesforce",
+	"genesyscloud_integration_credential",
+	"genesyscloud_oauth_client",
+	"genesyscloud_telephony_providers_edges_trunkbasesettings",
+}
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(resourceName, DataSourceExpiringCredentials())
+}
+
+var expiringCredentialResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"resource_type": {
+			Description: "Type of the resource holding the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_id": {
+			Description: "ID of the resource holding the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_name": {
+			Description: "Name of the resource holding the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"attribute": {
+			Description: "Attribute of the resource the certificate was found in, e.g. `certificates.0`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subject": {
+			Description: "Subject of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"issuer": {
+			Description: "Issuer of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"serial_number": {
+			Description: "Serial number of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"not_after": {
+			Description: "Expiry time of the certificate in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"days_remaining": {
+			Description: "Whole days until the certificate expires. Negative for expired certificates.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"expired": {
+			Description: "True if the certificate has already expired.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceExpiringCredentials registers the genesyscloud_expiring_credentials data source
+func DataSourceExpiringCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source listing the X.509 certificates stored in Genesys Cloud that expire within a number of days. " +
+			"The resources of each type are listed with the export logic and every attribute read back from Genesys Cloud is scanned for PEM or base64 DER encoded certificates, including certificates embedded in JSON properties. " +
+			"Secrets that Genesys Cloud does not return, such as the fields of integration credentials, cannot be checked. " +
+			"The result is intended for use in Terraform `check` blocks.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceExpiringCredentialsRead),
+		Schema: map[string]*schema.Schema{
+			"within_days": {
+				Description:  "Return certificates expiring within this number of days. Expired certificates are always returned.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"resource_types": {
+				Description: "Resource types to scan. Any exportable resource type may be set. Defaults to the IdP, integration credential, OAuth client and trunk base settings resource types.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Description: "Certificates expiring within `within_days`, soonest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        expiringCredentialResource,
+			},
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package expiring_credentials
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	pemCertificatePattern = regexp.MustCompile(`-----BEGIN CERTIFICATE-----[\s\S]+?-----END CERTIFICATE-----`)
+	// Base64 DER encoded certificates start with the encoding of their outer ASN.1 sequence
+	derCertificatePattern = regexp.MustCompile(`MII[A-Za-z0-9+/]{100,}={0,2}`)
+)
+
+// expiringCredential is a certificate found in an attribute of a resource
+type expiringCredential struct {
+	resourceType string
+	resourceId   string
+	resourceName string
+	attribute    string
+	certificate  *x509.Certificate
+}
+
+// findCertificates returns the X.509 certificates found in an attribute value. The certificates may be PEM encoded,
+// base64 DER encoded or embedded in a JSON document.
+func findCertificates(value string) []*x509.Certificate {
+	if !strings.Contains(value, "-----BEGIN CERTIFICATE-----") && !strings.Contains(value, "MII") {
+		return nil
+	}
+	// JSON documents escape the line breaks of PEM certificates
+	value = strings.ReplaceAll(value, `\n`, "\n")
+
+	var certificates []*x509.Certificate
+	seen := make(map[string]bool)
+	add := func(der []byte) {
+		if seen[string(der)] {
+			return
+		}
+		seen[string(der)] = true
+		if certificate, err := x509.ParseCertificate(der); err == nil {
+			certificates = append(certificates, certificate)
+		}
+	}
+
+	for _, match := range pemCertificatePattern.FindAllString(value, -1) {
+		if block, _ := pem.Decode([]byte(match)); block != nil {
+			add(block.Bytes)
+		}
+	}
+	for _, match := range derCertificatePattern.FindAllString(pemCertificatePattern.ReplaceAllString(value, ""), -1) {
+		if der, err := base64.StdEncoding.DecodeString(match); err == nil {
+			add(der)
+		}
+	}
+	return certificates
+}
+
+// scanAttributes returns the certificates found in the flattened state attributes of a resource
+func scanAttributes(resourceType, resourceId, resourceName string, attributes map[string]string) []expiringCredential {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var credentials []expiringCredential
+	for _, key := range keys {
+		for _, certificate := range findCertificates(attributes[key]) {
+			credentials = append(credentials, expiringCredential{
+				resourceType: resourceType,
+				resourceId:   resourceId,
+				resourceName: resourceName,
+				attribute:    key,
+				certificate:  certificate,
+			})
+		}
+	}
+	return credentials
+}
+
+// filterExpiringCredentials keeps the certificates expiring within withinDays of now, soonest first
+func filterExpiringCredentials(credentials []expiringCredential, withinDays int, now time.Time) []expiringCredential {
+	deadline := now.AddDate(0, 0, withinDays)
+	var expiring []expiringCredential
+	for _, credential := range credentials {
+		if credential.certificate.NotAfter.Before(deadline) {
+			expiring = append(expiring, credential)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].certificate.NotAfter.Before(expiring[j].certificate.NotAfter)
+	})
+	return expiring
+}
+
+func flattenExpiringCredentials(credentials []expiringCredential, now time.Time) []interface{} {
+	items := make([]interface{}, 0, len(credentials))
+	for _, credential := range credentials {
+		notAfter := credential.certificate.NotAfter
+		items = append(items, map[string]interface{}{
+			"resource_type":  credential.resourceType,
+			"resource_id":    credential.resourceId,
+			"resource_name":  credential.resourceName,
+			"attribute":      credential.attribute,
+			"subject":        credential.certificate.Subject.String(),
+			"issuer":         credential.certificate.Issuer.String(),
+			"serial_number":  credential.certificate.SerialNumber.String(),
+			"not_after":      notAfter.UTC().Format(time.RFC3339),
+			"days_remaining": int(math.Floor(notAfter.Sub(now).Hours() / 24)),
+			"expired":        !now.Before(notAfter),
+		})
+	}
+	return items
+}
@@ -0,0 +1,160 @@
+package expiring_credentials
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateCertificate returns the DER encoding of a self-signed certificate expiring at notAfter
+func generateCertificate(t *testing.T, commonName string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return der
+}
+
+func toPem(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestUnitFindCertificates(t *testing.T) {
+	now := time.Now()
+	pemCert := generateCertificate(t, "pem", now.AddDate(1, 0, 0))
+	derCert := generateCertificate(t, "der", now.AddDate(1, 0, 0))
+
+	commonNames := func(certificates []*x509.Certificate) []string {
+		var names []string
+		for _, certificate := range certificates {
+			names = append(names, certificate.Subject.CommonName)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"pem"}, commonNames(findCertificates(toPem(pemCert))))
+	assert.Equal(t, []string{"der"}, commonNames(findCertificates(base64.StdEncoding.EncodeToString(derCert))))
+
+	// Certificates embedded in JSON properties have escaped line breaks
+	properties := `{"certificate":"` + strings.ReplaceAll(toPem(pemCert), "\n", `\n`) + `","chain":["` + base64.StdEncoding.EncodeToString(derCert) + `"]}`
+	assert.Equal(t, []string{"pem", "der"}, commonNames(findCertificates(properties)))
+
+	// The same certificate is only reported once per attribute
+	assert.Len(t, findCertificates(toPem(pemCert)+toPem(pemCert)), 1)
+
+	assert.Empty(t, findCertificates("https://example.com"))
+	assert.Empty(t, findCertificates("MII"+strings.Repeat("A", 200)))
+}
+
+func TestUnitFilterExpiringCredentials(t *testing.T) {
+	now := time.Now()
+	attributes := map[string]string{
+		"id":             "idp-1",
+		"certificates.#": "3",
+		"certificates.0": base64.StdEncoding.EncodeToString(generateCertificate(t, "later", now.AddDate(0, 0, 90))),
+		"certificates.1": base64.StdEncoding.EncodeToString(generateCertificate(t, "soon", now.AddDate(0, 0, 10).Add(time.Hour))),
+		"certificates.2": toPem(generateCertificate(t, "expired", now.AddDate(0, 0, -2).Add(-time.Hour))),
+	}
+
+	credentials := scanAttributes("genesyscloud_idp_okta", "idp-1", "Okta", attributes)
+	assert.Len(t, credentials, 3)
+
+	items := flattenExpiringCredentials(filterExpiringCredentials(credentials, 30, now), now)
+	assert.Len(t, items, 2)
+
+	expired := items[0].(map[string]interface{})
+	assert.Equal(t, "CN=expired", expired["subject"])
+	assert.Equal(t, "certificates.2", expired["attribute"])
+	assert.Equal(t, -3, expired["days_remaining"])
+	assert.Equal(t, true, expired["expired"])
+
+	soon := items[1].(map[string]interface{})
+	assert.Equal(t, "CN=soon", soon["subject"])
+	assert.Equal(t, "genesyscloud_idp_okta", soon["resource_type"])
+	assert.Equal(t, "idp-1", soon["resource_id"])
+	assert.Equal(t, "Okta", soon["resource_name"])
+	assert.Equal(t, "42", soon["serial_number"])
+	assert.Equal(t, 10, soon["days_remaining"])
+	assert.Equal(t, false, soon["expired"])
+
+	// Expired certificates are returned even when within_days is 0
+	assert.Len(t, filterExpiringCredentials(credentials, 0, now), 1)
+}
+
+func TestUnitDataSourceExpiringCredentialsRead(t *testing.T) {
+	const testType = "genesyscloud_test_certificate_holder"
+	now := time.Now()
+	certificates := map[string]string{
+		"holder-1": toPem(generateCertificate(t, "holder-1", now.AddDate(0, 0, 5))),
+		"holder-2": toPem(generateCertificate(t, "holder-2", now.AddDate(1, 0, 0))),
+	}
+
+	providerResources[testType] = &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			certificate, ok := certificates[d.Id()]
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			_ = d.Set("certificate", certificate)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"certificate": {Type: schema.TypeString, Computed: true},
+		},
+	}
+	providerExporters[testType] = &resourceExporter.ResourceExporter{
+		GetResourcesFunc: func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+			return resourceExporter.ResourceIDMetaMap{
+				"holder-1": {Name: "Holder 1"},
+				"holder-2": {Name: "Holder 2"},
+				"deleted":  {Name: "Deleted"},
+			}, nil
+		},
+	}
+	defer delete(providerResources, testType)
+	defer delete(providerExporters, testType)
+
+	dataSource := DataSourceExpiringCredentials()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"within_days":    30,
+		"resource_types": []interface{}{testType},
+	})
+
+	diagErr := dataSourceExpiringCredentialsRead(context.Background(), d, nil)
+	assert.False(t, diagErr.HasError(), diagErr)
+
+	items := d.Get("items").([]interface{})
+	assert.Len(t, items, 1)
+	item := items[0].(map[string]interface{})
+	assert.Equal(t, "holder-1", item["resource_id"])
+	assert.Equal(t, "Holder 1", item["resource_name"])
+	assert.Equal(t, "certificate", item["attribute"])
+	assert.Equal(t, "CN=holder-1", item["subject"])
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"resource_types": []interface{}{"genesyscloud_not_a_resource"},
+	})
+	diagErr = dataSourceExpiringCredentialsRead(context.Background(), d, nil)
+	assert.True(t, diagErr.HasError())
+}
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -114,7 +115,7 @@ func updateIdpAdfs(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("Updated idp adfs")
-	return readIdpAdfs(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpAdfs(ctx, d, meta)...)
 }
 
 // deleteIdpAdfs is used by the idp_adfs resource to delete an idp adfs from Genesys cloud
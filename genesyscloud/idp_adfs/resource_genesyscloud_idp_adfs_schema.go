@@ -8,6 +8,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 )
 
 /*
@@ -35,6 +36,7 @@ func ResourceIdpAdfs() *schema.Resource {
 		ReadContext:   provider.ReadWithPooledClient(readIdpAdfs),
 		UpdateContext: provider.UpdateWithPooledClient(updateIdpAdfs),
 		DeleteContext: provider.DeleteWithPooledClient(deleteIdpAdfs),
+		CustomizeDiff: saml.CustomizeIdpDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -57,17 +59,20 @@ func ResourceIdpAdfs() *schema.Resource {
 			},
 			`issuer_uri`: {
 				Description: `Issuer URI provided by ADFS.`,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`target_uri`: {
 				Description: `Target URI provided by ADFS.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_uri`: {
 				Description: `Provided by ADFS on app creation`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_binding`: {
@@ -81,11 +86,15 @@ func ResourceIdpAdfs() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`certificates`: {
-				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation.`,
-				Required:    true,
+				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation. Required when neither metadata_xml nor metadata_url is set. The plan fails if a certificate has expired when the IdP is created or its certificates or metadata change.`,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			`metadata_xml`:                    saml.MetadataXmlSchema(),
+			`metadata_url`:                    saml.MetadataUrlSchema(),
+			`certificate_expiry_warning_days`: saml.CertificateExpiryWarningDaysSchema(),
 		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -121,7 +122,7 @@ func updateIdpGeneric(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("Updated idp generic")
-	return readIdpGeneric(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpGeneric(ctx, d, meta)...)
 }
 
 // deleteIdpGeneric is used by the idp_generic resource to delete an idp generic from Genesys cloud
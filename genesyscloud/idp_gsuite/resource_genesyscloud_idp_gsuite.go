@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -118,7 +119,7 @@ func updateIdpGsuite(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("Updated idp gsuite")
-	return readIdpGsuite(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpGsuite(ctx, d, meta)...)
 }
 
 // deleteIdpGsuite is used by the idp_gsuite resource to delete an idp gsuite from Genesys cloud
@@ -9,6 +9,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 )
 
 /*
@@ -36,6 +37,7 @@ func ResourceIdpGsuite() *schema.Resource {
 		ReadContext:   provider.ReadWithPooledClient(readIdpGsuite),
 		UpdateContext: provider.UpdateWithPooledClient(updateIdpGsuite),
 		DeleteContext: provider.DeleteWithPooledClient(deleteIdpGsuite),
+		CustomizeDiff: saml.CustomizeIdpDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,17 +60,20 @@ func ResourceIdpGsuite() *schema.Resource {
 			},
 			`issuer_uri`: {
 				Description: `Issuer URI provided by GSuite.`,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`target_uri`: {
 				Description: `Target URI provided by GSuite.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_uri`: {
 				Description: `Provided on app creation.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_binding`: {
@@ -84,11 +89,15 @@ func ResourceIdpGsuite() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`certificates`: {
-				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation.`,
-				Required:    true,
+				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation. Required when neither metadata_xml nor metadata_url is set. The plan fails if a certificate has expired when the IdP is created or its certificates or metadata change.`,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			`metadata_xml`:                    saml.MetadataXmlSchema(),
+			`metadata_url`:                    saml.MetadataUrlSchema(),
+			`certificate_expiry_warning_days`: saml.CertificateExpiryWarningDaysSchema(),
 		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
@@ -117,7 +118,7 @@ func updateIdpOkta(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("Updated idp okta")
-	return readIdpOkta(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpOkta(ctx, d, meta)...)
 }
 
 // deleteIdpOkta is used by the idp_okta resource to delete an idp okta from Genesys cloud
@@ -9,6 +9,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 )
 
 /*
@@ -36,6 +37,7 @@ func ResourceIdpOkta() *schema.Resource {
 		ReadContext:   provider.ReadWithPooledClient(readIdpOkta),
 		UpdateContext: provider.UpdateWithPooledClient(updateIdpOkta),
 		DeleteContext: provider.DeleteWithPooledClient(deleteIdpOkta),
+		CustomizeDiff: saml.CustomizeIdpDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -57,17 +59,20 @@ func ResourceIdpOkta() *schema.Resource {
 			},
 			`issuer_uri`: {
 				Description: `Issuer URI provided by Okta.`,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`target_uri`: {
 				Description: `Target URI provided by Okta.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_uri`: {
 				Description: `Provided by Okta on app creation.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_binding`: {
@@ -83,12 +88,16 @@ func ResourceIdpOkta() *schema.Resource {
 				Computed:    true,
 			},
 			`certificates`: {
-				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation.`,
-				Required:    true,
+				Description: `PEM or DER encoded public X.509 certificates for SAML signature validation. Required when neither metadata_xml nor metadata_url is set. The plan fails if a certificate has expired when the IdP is created or its certificates or metadata change.`,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				MinItems:    1,
 			},
+			`metadata_xml`:                    saml.MetadataXmlSchema(),
+			`metadata_url`:                    saml.MetadataUrlSchema(),
+			`certificate_expiry_warning_days`: saml.CertificateExpiryWarningDaysSchema(),
 		},
 	}
 }
@@ -158,6 +158,49 @@ func TestAccResourceIdpOkta(t *testing.T) {
 	})
 }
 
+func TestAccResourceIdpOktaMetadata(t *testing.T) {
+	var (
+		name1       = "Test okta " + uuid.NewString()
+		issuerURI   = "http://www.okta.com/" + uuid.NewString()
+		targetURI   = "https://test.com/sso"
+		sloURI      = "https://test.com/slo"
+		metadataXml = fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, issuerURI, util.TestCert1, sloURI, targetURI)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Issuer, endpoints and certificates are read from the metadata
+				Config: fmt.Sprintf(`resource "genesyscloud_idp_okta" "okta" {
+		name = "%s"
+		metadata_xml = <<-EOT
+%s
+EOT
+	}
+	`, name1, metadataXml),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "issuer_uri", issuerURI),
+					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "target_uri", targetURI),
+					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "slo_uri", sloURI),
+					resource.TestCheckResourceAttr("genesyscloud_idp_okta.okta", "certificates.#", "1"),
+					util.ValidateStringInArray("genesyscloud_idp_okta.okta", "certificates", util.TestCert1),
+				),
+			},
+		},
+		CheckDestroy: testVerifyIdpOktaDestroyed,
+	})
+}
+
 func generateIdpOktaResource(
 	name string,
 	certs string,
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -117,7 +118,7 @@ func updateIdpOnelogin(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	log.Printf("Updated idp onelogin")
-	return readIdpOnelogin(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpOnelogin(ctx, d, meta)...)
 }
 
 // deleteIdpOnelogin is used by the idp_onelogin resource to delete an idp onelogin from Genesys cloud
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -117,7 +118,7 @@ func updateIdpPing(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("Updated idp ping")
-	return readIdpPing(ctx, d, meta)
+	return append(saml.CertificateExpiryWarnings(d), readIdpPing(ctx, d, meta)...)
 }
 
 // deleteIdpPing is used by the idp_ping resource to delete an idp ping from Genesys cloud
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/saml"
 )
 
 /*
@@ -35,6 +36,7 @@ func ResourceIdpSalesforce() *schema.Resource {
 		ReadContext:   provider.ReadWithPooledClient(readIdpSalesforce),
 		UpdateContext: provider.UpdateWithPooledClient(updateIdpSalesforce),
 		DeleteContext: provider.DeleteWithPooledClient(deleteIdpSalesforce),
+		CustomizeDiff: saml.CustomizeIdpDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -50,24 +52,28 @@ func ResourceIdpSalesforce() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"certificates": {
-				Description: "PEM or DER encoded public X.509 certificates for SAML signature validation.",
+				Description: "PEM or DER encoded public X.509 certificates for SAML signature validation. Required when neither metadata_xml nor metadata_url is set. The plan fails if a certificate has expired when the IdP is created or its certificates or metadata change.",
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"issuer_uri": {
 				Description: "Issuer URI provided by Salesforce.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"target_uri": {
 				Description: "Target URI provided by Salesforce.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			`slo_uri`: {
 				Description: `Provided on app creation.`,
 				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
 			},
 			`slo_binding`: {
@@ -86,6 +92,9 @@ func ResourceIdpSalesforce() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			`metadata_xml`:                    saml.MetadataXmlSchema(),
+			`metadata_url`:                    saml.MetadataUrlSchema(),
+			`certificate_expiry_warning_days`: saml.CertificateExpiryWarningDaysSchema(),
 		},
 	}
 }
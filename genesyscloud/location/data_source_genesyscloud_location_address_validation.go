@@ -0,0 +1,35 @@
+package location
+
+import (
+	"context"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceLocationAddressValidationRead validates the address and the emergency number, with the address validation endpoint
+// of the provider if one is configured
+func dataSourceLocationAddressValidationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	settings := m.(*provider.ProviderMeta).LocationValidation
+
+	address := buildLocationAddress(d.Get("address").([]interface{}))
+	if address == nil {
+		return diag.Errorf("address is required")
+	}
+	emergencyNumber := d.Get("emergency_number").(string)
+
+	messages, normalized := validateLocation(ctx, *address, emergencyNumber, settings)
+	if messages == nil {
+		messages = []string{}
+	}
+	log.Printf("Validated location address %s, %s, %s with %d findings", normalized.Street1, normalized.City, normalized.Country, len(messages))
+
+	d.SetId(strings.Join([]string{normalized.Country, normalized.ZipCode, normalized.Street1, emergencyNumber}, "|"))
+	_ = d.Set("valid", len(messages) == 0)
+	_ = d.Set("messages", messages)
+	_ = d.Set("normalized_address", flattenLocationValidationAddress(normalized))
+	return nil
+}
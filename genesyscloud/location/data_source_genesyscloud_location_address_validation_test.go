@@ -0,0 +1,56 @@
+package location
+
+import (
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLocationAddressValidation(t *testing.T) {
+	var (
+		validationData     = "address-validation"
+		fullValidationName = "data." + addressValidationDataSourceName + "." + validationData
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: generateLocationAddressValidationDataSource(
+					validationData,
+					GenerateLocationAddress("7601 Interactive Way", "Indianapolis", "IN", "US", "46278"),
+					"+13172222222",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullValidationName, "valid", "true"),
+					resource.TestCheckResourceAttr(fullValidationName, "messages.#", "0"),
+				),
+			},
+			{
+				// The emergency number is a UK number and the postal code is not normalized
+				Config: generateLocationAddressValidationDataSource(
+					validationData,
+					GenerateLocationAddress("7601 Interactive Way", "Indianapolis", "IN", "US", "462789999"),
+					"+442071234567",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullValidationName, "valid", "false"),
+					resource.TestCheckResourceAttr(fullValidationName, "normalized_address.0.zip_code", "46278-9999"),
+					resource.TestCheckTypeSetElemAttr(fullValidationName, "messages.*", "emergency_number.number +442071234567 has country code +44, but the address is in US (+1)"),
+				),
+			},
+		},
+	})
+}
+
+func generateLocationAddressValidationDataSource(resourceID string, address string, emergencyNumber string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		%s
+		emergency_number = "%s"
+	}
+	`, addressValidationDataSourceName, resourceID, address, emergencyNumber)
+}
@@ -37,6 +37,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceLocation()
+	providerDataSources[addressValidationDataSourceName] = DataSourceLocationAddressValidation()
 }
 
 // initTestResources initializes all test resources and data sources.
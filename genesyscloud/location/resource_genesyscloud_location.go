@@ -54,6 +54,9 @@ func createLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 		create.Notes = &notes
 	}
 
+	// Validation findings do not prevent the location from being created
+	diags := locationValidationWarnings(ctx, d, meta)
+
 	log.Printf("Creating location %s", name)
 	location, resp, err := proxy.createLocation(ctx, &create)
 	if err != nil {
@@ -63,7 +66,7 @@ func createLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 	d.SetId(*location.Id)
 
 	log.Printf("Created location %s %s", name, *location.Id)
-	return readLocation(ctx, d, meta)
+	return append(diags, readLocation(ctx, d, meta)...)
 }
 
 func readLocation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -105,6 +108,9 @@ func updateLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	log.Printf("Updating location %s", name)
 
+	// The changes are validated before the update, and validation findings do not prevent the location from being updated
+	diags := locationValidationWarnings(ctx, d, meta)
+
 	if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current location version
 		location, resp, getErr := proxy.getLocationById(ctx, d.Id(), nil)
@@ -146,7 +152,7 @@ func updateLocation(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("Updated location %s %s", name, d.Id())
-	return readLocation(ctx, d, meta)
+	return append(diags, readLocation(ctx, d, meta)...)
 }
 
 func deleteLocation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
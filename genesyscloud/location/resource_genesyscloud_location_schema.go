@@ -13,15 +13,20 @@ import (
 // SetRegistrar registers all of the resources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceLocation())
+	l.RegisterDataSource(addressValidationDataSourceName, DataSourceLocationAddressValidation())
 	l.RegisterResource(resourceName, ResourceLocation())
 	l.RegisterExporter(resourceName, LocationExporter())
 }
 
 const resourceName = "genesyscloud_location"
+const addressValidationDataSourceName = "genesyscloud_location_address_validation"
 
 func ResourceLocation() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud Location",
+		Description: "Genesys Cloud Location. If the `location_validation` block of the provider is set, the address and emergency number are validated before every create, " +
+			"and before every update that changes them, which calls the address validation endpoint if one is configured. " +
+			"Findings are only reported as warnings during apply and do not prevent the location from being created or updated. " +
+			"To report findings when planning, validate the address with the `genesyscloud_location_address_validation` data source in a `check` block.",
 
 		CreateContext: provider.CreateWithPooledClient(createLocation),
 		ReadContext:   provider.ReadWithPooledClient(readLocation),
@@ -130,6 +135,82 @@ func DataSourceLocation() *schema.Resource {
 	}
 }
 
+// DataSourceLocationAddressValidation registers the genesyscloud_location_address_validation data source
+func DataSourceLocationAddressValidation() *schema.Resource {
+	address := *ResourceLocation().Schema["address"]
+	address.Description = "Address to validate."
+	address.Optional = false
+	address.Required = true
+
+	normalizedAddress := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Description: "Location city.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"country": {
+				Description: "Country abbreviation.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "Location state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"street1": {
+				Description: "Street address 1.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"street2": {
+				Description: "Street address 2.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"zip_code": {
+				Description: "Location zip code.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source validating a location address and emergency number. The address is normalized and checked against the address rules of its country, " +
+			"and sent to the address validation endpoint if one is configured in the `location_validation` block of the provider. " +
+			"The emergency number must be a valid E.164 number with the country code of the country of the address. " +
+			"Use it in a `check` block to report invalid locations as warnings when planning.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceLocationAddressValidationRead),
+		Schema: map[string]*schema.Schema{
+			"address": &address,
+			"emergency_number": {
+				Description: "Emergency phone number to validate against the country of the address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"valid": {
+				Description: "True if the address and the emergency number passed validation.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"messages": {
+				Description: "Validation findings. Empty if the address and the emergency number are valid.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"normalized_address": {
+				Description: "The address as it is normally written in its country, or as returned by the address validation endpoint.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        normalizedAddress,
+			},
+		},
+	}
+}
+
 func LocationExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllLocations),
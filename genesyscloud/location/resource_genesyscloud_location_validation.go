@@ -0,0 +1,300 @@
+package location
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nyaruka/phonenumbers"
+)
+
+/*
+   The resource_genesyscloud_location_validation.go file validates the address and the emergency number of a location. Addresses
+   are normalized and checked against the address rules of their country, and can be sent to the address validation endpoint
+   configured in the location_validation block of the provider. Emergency numbers must be valid numbers of the country of the address.
+*/
+
+// locationValidationTimeout is the timeout of address validation requests when the provider does not configure one
+const locationValidationTimeout = 10 * time.Second
+
+// locationAddress is the address of a location, in the format sent to and returned by the address validation endpoint
+type locationAddress struct {
+	Street1 string `json:"street1"`
+	Street2 string `json:"street2,omitempty"`
+	City    string `json:"city"`
+	State   string `json:"state,omitempty"`
+	ZipCode string `json:"zipCode"`
+	Country string `json:"country"`
+}
+
+// addressValidationResponse is the response of the address validation endpoint. Address is the normalized address, if the
+// endpoint normalizes addresses.
+type addressValidationResponse struct {
+	Valid    bool             `json:"valid"`
+	Messages []string         `json:"messages"`
+	Address  *locationAddress `json:"address"`
+}
+
+// countryAddressRules are the address rules of a country. Postal codes are normalized to upper case, and the separator is
+// inserted before the last suffixLength characters of postal codes that have one of the compact lengths.
+type countryAddressRules struct {
+	postalCodePattern *regexp.Regexp
+	separator         string
+	suffixLength      int
+	compactLengths    []int
+	stateRequired     bool
+	statePattern      *regexp.Regexp
+}
+
+var addressRulesByCountry = map[string]countryAddressRules{
+	"US": {postalCodePattern: regexp.MustCompile(`^\d{5}(-\d{4})?$`), separator: "-", suffixLength: 4, compactLengths: []int{9}, stateRequired: true, statePattern: regexp.MustCompile(`^[A-Z]{2}$`)},
+	"CA": {postalCodePattern: regexp.MustCompile(`^[A-Z]\d[A-Z] \d[A-Z]\d$`), separator: " ", suffixLength: 3, compactLengths: []int{6}, stateRequired: true, statePattern: regexp.MustCompile(`^[A-Z]{2}$`)},
+	"GB": {postalCodePattern: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? \d[A-Z]{2}$`), separator: " ", suffixLength: 3, compactLengths: []int{5, 6, 7}},
+	"IE": {postalCodePattern: regexp.MustCompile(`^[A-Z]\d[\dW] [A-Z\d]{4}$`), separator: " ", suffixLength: 4, compactLengths: []int{7}},
+	"NL": {postalCodePattern: regexp.MustCompile(`^\d{4} [A-Z]{2}$`), separator: " ", suffixLength: 2, compactLengths: []int{6}},
+	"AU": {postalCodePattern: regexp.MustCompile(`^\d{4}$`), stateRequired: true, statePattern: regexp.MustCompile(`^[A-Z]{2,3}$`)},
+	"DE": {postalCodePattern: regexp.MustCompile(`^\d{5}$`)},
+	"FR": {postalCodePattern: regexp.MustCompile(`^\d{5}$`)},
+	"ES": {postalCodePattern: regexp.MustCompile(`^\d{5}$`)},
+	"IT": {postalCodePattern: regexp.MustCompile(`^\d{5}$`)},
+	"MX": {postalCodePattern: regexp.MustCompile(`^\d{5}$`)},
+	"IN": {postalCodePattern: regexp.MustCompile(`^\d{6}$`)},
+	"JP": {postalCodePattern: regexp.MustCompile(`^\d{3}-\d{4}$`), separator: "-", suffixLength: 4, compactLengths: []int{7}},
+	"BR": {postalCodePattern: regexp.MustCompile(`^\d{5}-\d{3}$`), separator: "-", suffixLength: 3, compactLengths: []int{8}},
+}
+
+// locationValidationWarnings validates the address and the emergency number of the location when location validation is
+// enabled in the provider, and they are new or changed
+func locationValidationWarnings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, ok := meta.(*provider.ProviderMeta)
+	if !ok || providerMeta.LocationValidation == nil {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("address", "emergency_number") {
+		return nil
+	}
+
+	address := buildLocationAddress(d.Get("address").([]interface{}))
+	if address == nil {
+		return nil
+	}
+	var emergencyNumber string
+	if numbers := d.Get("emergency_number").([]interface{}); len(numbers) > 0 && numbers[0] != nil {
+		emergencyNumber = numbers[0].(map[string]interface{})["number"].(string)
+	}
+
+	messages, _ := validateLocation(ctx, *address, emergencyNumber, providerMeta.LocationValidation)
+	var diags diag.Diagnostics
+	for _, message := range messages {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Location %s failed validation", d.Get("name").(string)),
+			Detail:   message,
+		})
+	}
+	return diags
+}
+
+// validateLocation returns the validation findings of the address and the emergency number of a location, and the normalized
+// address. No findings means the location is valid.
+func validateLocation(ctx context.Context, address locationAddress, emergencyNumber string, settings *provider.LocationValidation) ([]string, locationAddress) {
+	normalized := normalizeLocationAddress(address)
+	messages := validateLocationAddress(address, normalized)
+	if emergencyNumber != "" {
+		messages = append(messages, validateEmergencyNumber(emergencyNumber, normalized.Country)...)
+	}
+
+	if settings != nil && settings.AddressValidationEndpoint != "" {
+		response, err := requestAddressValidation(ctx, settings, normalized)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("the address could not be validated by %s: %v", settings.AddressValidationEndpoint, err))
+			return messages, normalized
+		}
+		if !response.Valid {
+			if len(response.Messages) == 0 {
+				messages = append(messages, fmt.Sprintf("the address was rejected by %s", settings.AddressValidationEndpoint))
+			}
+			messages = append(messages, response.Messages...)
+		}
+		if response.Address != nil {
+			messages = append(messages, describeAddressDifferences(normalized, *response.Address, "the address validation endpoint writes address.%s %q as %q")...)
+			normalized = *response.Address
+		}
+	}
+	return messages, normalized
+}
+
+// normalizeLocationAddress trims and collapses the whitespace of every field and writes the country in upper case. In countries
+// with address rules, state codes and postal codes are written in upper case and postal codes are formatted as they are written
+// in the country.
+func normalizeLocationAddress(address locationAddress) locationAddress {
+	normalized := locationAddress{
+		Street1: collapseWhitespace(address.Street1),
+		Street2: collapseWhitespace(address.Street2),
+		City:    collapseWhitespace(address.City),
+		State:   collapseWhitespace(address.State),
+		ZipCode: collapseWhitespace(address.ZipCode),
+		Country: strings.ToUpper(collapseWhitespace(address.Country)),
+	}
+
+	rules, ok := addressRulesByCountry[normalized.Country]
+	if !ok {
+		return normalized
+	}
+	normalized.ZipCode = strings.ToUpper(normalized.ZipCode)
+	if state := strings.ToUpper(normalized.State); rules.statePattern != nil && rules.statePattern.MatchString(state) {
+		normalized.State = state
+	}
+	if rules.separator != "" {
+		compact := strings.NewReplacer(" ", "", "-", "").Replace(normalized.ZipCode)
+		for _, length := range rules.compactLengths {
+			if len(compact) == length {
+				normalized.ZipCode = compact[:length-rules.suffixLength] + rules.separator + compact[length-rules.suffixLength:]
+				break
+			}
+		}
+	}
+	return normalized
+}
+
+// validateLocationAddress checks the normalized address against the address rules of its country, and reports the fields that
+// are not written in their normalized form
+func validateLocationAddress(address locationAddress, normalized locationAddress) []string {
+	var messages []string
+	if !phonenumbers.GetSupportedRegions()[normalized.Country] {
+		return append(messages, fmt.Sprintf("address.country %q is not an ISO 3166-1 alpha-2 country code", address.Country))
+	}
+	messages = append(messages, describeAddressDifferences(address, normalized, "address.%s %q is normally written %q")...)
+
+	rules, ok := addressRulesByCountry[normalized.Country]
+	if !ok {
+		return messages
+	}
+	if rules.postalCodePattern != nil && !rules.postalCodePattern.MatchString(normalized.ZipCode) {
+		messages = append(messages, fmt.Sprintf("address.zip_code %q is not a valid postal code in %s", address.ZipCode, normalized.Country))
+	}
+	if rules.stateRequired && normalized.State == "" {
+		messages = append(messages, fmt.Sprintf("address.state is required for addresses in %s", normalized.Country))
+	} else if rules.statePattern != nil && normalized.State != "" && !rules.statePattern.MatchString(normalized.State) {
+		messages = append(messages, fmt.Sprintf("address.state %q is not a state or province code of %s", address.State, normalized.Country))
+	}
+	return messages
+}
+
+// validateEmergencyNumber checks that the emergency number is a valid E.164 number with the country code of the country of the address
+func validateEmergencyNumber(number string, country string) []string {
+	parsed, err := phonenumbers.Parse(number, country)
+	if err != nil {
+		return []string{fmt.Sprintf("emergency_number.number %s is not a valid phone number: %v", number, err)}
+	}
+
+	var messages []string
+	if formatted := phonenumbers.Format(parsed, phonenumbers.E164); formatted != number {
+		messages = append(messages, fmt.Sprintf("emergency_number.number %s is not in E.164 format, expected %s", number, formatted))
+	}
+	if !phonenumbers.IsValidNumber(parsed) {
+		messages = append(messages, fmt.Sprintf("emergency_number.number %s is not a valid phone number", number))
+	}
+	if countryCode := phonenumbers.GetCountryCodeForRegion(country); countryCode != 0 && int(parsed.GetCountryCode()) != countryCode {
+		messages = append(messages, fmt.Sprintf("emergency_number.number %s has country code +%d, but the address is in %s (+%d)", number, parsed.GetCountryCode(), country, countryCode))
+	}
+	return messages
+}
+
+// requestAddressValidation sends the address to the address validation endpoint in a POST request
+func requestAddressValidation(ctx context.Context, settings *provider.LocationValidation, address locationAddress) (*addressValidationResponse, error) {
+	timeout := settings.Timeout
+	if timeout <= 0 {
+		timeout = locationValidationTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(address)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, settings.AddressValidationEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("returned status %d", resp.StatusCode)
+	}
+
+	var response addressValidationResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&response); err != nil {
+		return nil, fmt.Errorf("returned an invalid response: %w", err)
+	}
+	return &response, nil
+}
+
+// describeAddressDifferences lists the fields of the address that are written differently in the normalized address, with
+// a format that takes the attribute, the value and the normalized value
+func describeAddressDifferences(address locationAddress, normalized locationAddress, format string) []string {
+	var messages []string
+	for _, field := range []struct {
+		attribute string
+		value     string
+		expected  string
+	}{
+		{"street1", address.Street1, normalized.Street1},
+		{"street2", address.Street2, normalized.Street2},
+		{"city", address.City, normalized.City},
+		{"state", address.State, normalized.State},
+		{"zip_code", address.ZipCode, normalized.ZipCode},
+		{"country", address.Country, normalized.Country},
+	} {
+		if field.value != field.expected {
+			messages = append(messages, fmt.Sprintf(format, field.attribute, field.value, field.expected))
+		}
+	}
+	return messages
+}
+
+func buildLocationAddress(addresses []interface{}) *locationAddress {
+	if len(addresses) == 0 || addresses[0] == nil {
+		return nil
+	}
+	addressMap := addresses[0].(map[string]interface{})
+	return &locationAddress{
+		Street1: addressMap["street1"].(string),
+		Street2: addressMap["street2"].(string),
+		City:    addressMap["city"].(string),
+		State:   addressMap["state"].(string),
+		ZipCode: addressMap["zip_code"].(string),
+		Country: addressMap["country"].(string),
+	}
+}
+
+func flattenLocationValidationAddress(address locationAddress) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"street1":  address.Street1,
+		"street2":  address.Street2,
+		"city":     address.City,
+		"state":    address.State,
+		"zip_code": address.ZipCode,
+		"country":  address.Country,
+	}}
+}
+
+func collapseWhitespace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
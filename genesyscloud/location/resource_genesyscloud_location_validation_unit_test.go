@@ -0,0 +1,161 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNormalizeLocationAddress(t *testing.T) {
+	normalized := normalizeLocationAddress(locationAddress{
+		Street1: "  7601   Interactive Way ",
+		City:    "Indianapolis",
+		State:   "in",
+		ZipCode: "462789999",
+		Country: "us",
+	})
+	assert.Equal(t, locationAddress{
+		Street1: "7601 Interactive Way",
+		City:    "Indianapolis",
+		State:   "IN",
+		ZipCode: "46278-9999",
+		Country: "US",
+	}, normalized)
+
+	assert.Equal(t, "K1A 0B1", normalizeLocationAddress(locationAddress{ZipCode: "k1a0b1", Country: "CA"}).ZipCode)
+	assert.Equal(t, "SW1A 1AA", normalizeLocationAddress(locationAddress{ZipCode: "sw1a1aa", Country: "GB"}).ZipCode)
+	assert.Equal(t, "10115", normalizeLocationAddress(locationAddress{ZipCode: "10115", Country: "DE"}).ZipCode)
+}
+
+func TestUnitValidateLocationAddress(t *testing.T) {
+	validate := func(address locationAddress) []string {
+		return validateLocationAddress(address, normalizeLocationAddress(address))
+	}
+
+	assert.Empty(t, validate(locationAddress{Street1: "7601 Interactive Way", City: "Indianapolis", State: "IN", ZipCode: "46278", Country: "US"}))
+	assert.Equal(t, []string{
+		`address.zip_code "4627" is not a valid postal code in US`,
+		"address.state is required for addresses in US",
+	}, validate(locationAddress{Street1: "7601 Interactive Way", City: "Indianapolis", ZipCode: "4627", Country: "US"}))
+	assert.Equal(t, []string{
+		`address.state "in" is normally written "IN"`,
+		`address.country "us" is normally written "US"`,
+	}, validate(locationAddress{Street1: "7601 Interactive Way", City: "Indianapolis", State: "in", ZipCode: "46278", Country: "us"}))
+	assert.Equal(t, []string{
+		`address.state "Indiana" is not a state or province code of US`,
+	}, validate(locationAddress{Street1: "7601 Interactive Way", City: "Indianapolis", State: "Indiana", ZipCode: "46278", Country: "US"}))
+	assert.Equal(t, []string{
+		`address.country "USA" is not an ISO 3166-1 alpha-2 country code`,
+	}, validate(locationAddress{Street1: "7601 Interactive Way", City: "Indianapolis", State: "IN", ZipCode: "46278", Country: "USA"}))
+
+	// Countries without address rules are only normalized
+	assert.Empty(t, validate(locationAddress{Street1: "Calle 1", City: "Santiago", ZipCode: "anything", Country: "CL"}))
+}
+
+func TestUnitValidateEmergencyNumber(t *testing.T) {
+	assert.Empty(t, validateEmergencyNumber("+13172222222", "US"))
+	assert.Equal(t, []string{
+		"emergency_number.number +442071234567 has country code +44, but the address is in US (+1)",
+	}, validateEmergencyNumber("+442071234567", "US"))
+	assert.Equal(t, []string{
+		"emergency_number.number 3172222222 is not in E.164 format, expected +13172222222",
+	}, validateEmergencyNumber("3172222222", "US"))
+	assert.Equal(t, []string{
+		"emergency_number.number +1555 is not a valid phone number",
+	}, validateEmergencyNumber("+1555", "US"))
+}
+
+func TestUnitValidateLocationWithEndpoint(t *testing.T) {
+	var received locationAddress
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		response := addressValidationResponse{Valid: true, Address: &received}
+		if received.Street1 == "1 Nowhere Road" {
+			response = addressValidationResponse{Valid: false, Messages: []string{"street not found"}}
+		} else {
+			address := received
+			address.Street1 = "7601 INTERACTIVE WAY"
+			response.Address = &address
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+	settings := &provider.LocationValidation{AddressValidationEndpoint: server.URL, Timeout: time.Second}
+
+	address := locationAddress{Street1: "7601 Interactive  Way", City: "Indianapolis", State: "IN", ZipCode: "46278", Country: "US"}
+	messages, normalized := validateLocation(context.Background(), address, "+13172222222", settings)
+	// The normalized address is sent to the endpoint
+	assert.Equal(t, "7601 Interactive Way", received.Street1)
+	assert.Equal(t, []string{
+		`address.street1 "7601 Interactive  Way" is normally written "7601 Interactive Way"`,
+		`the address validation endpoint writes address.street1 "7601 Interactive Way" as "7601 INTERACTIVE WAY"`,
+	}, messages)
+	assert.Equal(t, "7601 INTERACTIVE WAY", normalized.Street1)
+
+	address.Street1 = "1 Nowhere Road"
+	messages, _ = validateLocation(context.Background(), address, "", settings)
+	assert.Equal(t, []string{"street not found"}, messages)
+
+	server.Close()
+	messages, _ = validateLocation(context.Background(), address, "", settings)
+	assert.Len(t, messages, 1)
+	assert.Contains(t, messages[0], "the address could not be validated by "+server.URL)
+}
+
+func TestUnitLocationValidationWarnings(t *testing.T) {
+	meta := &provider.ProviderMeta{LocationValidation: &provider.LocationValidation{}}
+	d := schema.TestResourceDataRaw(t, ResourceLocation().Schema, map[string]interface{}{
+		"name": "Headquarters",
+		"address": []interface{}{map[string]interface{}{
+			"street1":  "7601 Interactive Way",
+			"city":     "Indianapolis",
+			"state":    "IN",
+			"zip_code": "46278",
+			"country":  "US",
+		}},
+		"emergency_number": []interface{}{map[string]interface{}{
+			"number": "+442071234567",
+		}},
+	})
+
+	diags := locationValidationWarnings(context.Background(), d, meta)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Location Headquarters failed validation", diags[0].Summary)
+	assert.Equal(t, "emergency_number.number +442071234567 has country code +44, but the address is in US (+1)", diags[0].Detail)
+
+	// Location validation is disabled without the location_validation block of the provider
+	assert.Empty(t, locationValidationWarnings(context.Background(), d, &provider.ProviderMeta{}))
+}
+
+func TestUnitDataSourceLocationAddressValidationRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceLocationAddressValidation().Schema, map[string]interface{}{
+		"address": []interface{}{map[string]interface{}{
+			"street1":  "33 Main Street",
+			"city":     "Ottawa",
+			"state":    "on",
+			"zip_code": "k1a0b1",
+			"country":  "CA",
+		}},
+		"emergency_number": "+16135550123",
+	})
+
+	diags := dataSourceLocationAddressValidationRead(context.Background(), d, &provider.ProviderMeta{})
+	assert.Nil(t, diags)
+	assert.Equal(t, false, d.Get("valid"))
+	assert.Equal(t, []interface{}{
+		`address.state "on" is normally written "ON"`,
+		`address.zip_code "k1a0b1" is normally written "K1A 0B1"`,
+	}, d.Get("messages"))
+	assert.Equal(t, "K1A 0B1", d.Get("normalized_address.0.zip_code"))
+	assert.Equal(t, "ON", d.Get("normalized_address.0.state"))
+}
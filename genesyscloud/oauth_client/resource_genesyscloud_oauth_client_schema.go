@@ -39,7 +39,7 @@ var (
 
 func ResourceOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud OAuth Clients. See this page for detailed configuration information: https://help.mypurecloud.com/articles/create-an-oauth-client/",
+		Description: "Genesys Cloud OAuth Clients. See this page for detailed configuration information: https://help.mypurecloud.com/articles/create-an-oauth-client/ The client secret cannot be rotated by this resource. Genesys Cloud revokes the previous secret as soon as a new one is generated, so a rotation without an authentication outage is not possible. To replace a secret, create a second client, move applications to it and then remove the first one.",
 
 		CreateContext: provider.CreateWithPooledClient(createOAuthClient),
 		ReadContext:   provider.ReadWithPooledClient(readOAuthClient),
@@ -2,6 +2,7 @@ package organization_authentication_settings
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 
@@ -18,6 +19,9 @@ resource_genesycloud_organization_authentication_settings_schema.go holds four f
 */
 const resourceName = "genesyscloud_organization_authentication_settings"
 
+// defaultEgressIpLookupUrl returns the public IP address of the caller as plain text
+const defaultEgressIpLookupUrl = "https://checkip.amazonaws.com"
+
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterResource(resourceName, ResourceOrganizationAuthenticationSettings())
@@ -27,44 +31,52 @@ func SetRegistrar(l registrar.Registrar) {
 var passwordRequirements = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		`minimum_length`: {
-			Description: "The minimum character length for passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum character length for passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_digits`: {
-			Description: "The minimum number of numerals (0-9) that must be included in passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum number of numerals (0-9) that must be included in passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_letters`: {
-			Description: "The minimum number of characters required for passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum number of characters required for passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_upper`: {
-			Description: "The minimum number of upper case letters that must be included in passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum number of upper case letters that must be included in passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_lower`: {
-			Description: "The minimum number of lower case letters that must be included in passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum number of lower case letters that must be included in passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_specials`: {
-			Description: "The minimum number of special characters that must be included in passwords",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "The minimum number of special characters that must be included in passwords",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`minimum_age_seconds`: {
-			Description: "Minimum age of the password (in seconds) before it can be changed",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "Minimum age of the password (in seconds) before it can be changed",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		`expiration_days`: {
-			Description: "Length of time (in days) before a password must be changed",
-			Optional:    true,
-			Type:        schema.TypeInt,
+			Description:  "Length of time (in days) before a password must be changed",
+			Optional:     true,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 	},
 }
@@ -82,6 +94,7 @@ func ResourceOrganizationAuthenticationSettings() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeOrgAuthSettingsDiff,
 		Schema: map[string]*schema.Schema{
 			`multifactor_authentication_required`: {
 				Description: `Indicates whether multi-factor authentication is required.`,
@@ -100,11 +113,39 @@ func ResourceOrganizationAuthenticationSettings() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			`ip_address_allowlist`: {
-				Description: `The list of IP addresses that will be allowed to authenticate with Genesys Cloud. Warning: Changing these will result in only allowing specified ip Addresses to log in and will invalidate credentials with a different ip address`,
+				Description: `The list of IP address ranges in CIDR notation, e.g. '203.0.113.0/24', that will be allowed to authenticate with Genesys Cloud. A single IP address, e.g. '203.0.113.7', is sent as the range of that address alone, and a range with host bits set, e.g. '203.0.113.10/24', as the range it belongs to. Warning: Changing these will result in only allowing specified ip Addresses to log in and will invalidate credentials with a different ip address. The plan fails if the list would exclude the public IP address Terraform is running from, as returned by 'egress_ip_lookup_url', unless 'force' is set.`,
 				Optional:    true,
 				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validateIpAddressCidr,
+					DiffSuppressFunc: suppressEquivalentCidrs,
+				},
+			},
+			`ip_address_allowlist_added`: {
+				Description: `IP address ranges added to ip_address_allowlist by the most recent change.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`ip_address_allowlist_removed`: {
+				Description: `IP address ranges removed from ip_address_allowlist by the most recent change.`,
+				Computed:    true,
+				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			`egress_ip_lookup_url`: {
+				Description: `URL returning the public IP address Terraform connects to Genesys Cloud from as plain text. The URL is requested every time Terraform plans a change to ip_address_allowlist, to check that the list does not lock out Terraform itself.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+				Default:     defaultEgressIpLookupUrl,
+			},
+			`force`: {
+				Description: `Apply an ip_address_allowlist that excludes the public IP address returned by egress_ip_lookup_url, or whose exclusion could not be checked. Terraform will no longer be able to manage the organization from that address.`,
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+			},
 			`password_requirements`: {
 				Description: `The password requirements for the organization.`,
 				Optional:    true,
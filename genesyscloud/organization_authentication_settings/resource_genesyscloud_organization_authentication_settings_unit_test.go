@@ -2,13 +2,17 @@ package organization_authentication_settings
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
 	"github.com/stretchr/testify/assert"
 )
@@ -137,3 +141,100 @@ func buildOrgAuthSettingsDataMap(tMultifactorAuthRequired bool, tDomainAllowList
 	}
 	return resourceDataMap
 }
+
+func TestUnitValidateIpAddressCidr(t *testing.T) {
+	for _, valid := range []string{"203.0.113.0/24", "198.51.100.7/32", "2001:db8::/32", "203.0.113.10/24", "198.51.100.7", "2001:db8::1"} {
+		_, errs := validateIpAddressCidr(valid, "ip_address_allowlist.0")
+		assert.Empty(t, errs, valid)
+	}
+
+	_, errs := validateIpAddressCidr("300.0.0.0/8", "ip_address_allowlist.0")
+	assert.ErrorContains(t, errs[0], "is neither a valid CIDR range nor an IP address")
+}
+
+func TestUnitBuildIpAddressAllowlist(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceOrganizationAuthenticationSettings().Schema, map[string]interface{}{
+		"ip_address_allowlist": []interface{}{"203.0.113.10/24", "198.51.100.7/32", "2001:db8::1/32", "198.51.100.8", "2001:db8::1"},
+	})
+
+	// Ranges with host bits set are sent as the range they belong to, and single addresses as the range of that address
+	assert.Equal(t, []string{"203.0.113.0/24", "198.51.100.7/32", "2001:db8::/32", "198.51.100.8/32", "2001:db8::1/128"}, *buildIpAddressAllowlist(d))
+}
+
+func TestUnitValidatePasswordRequirements(t *testing.T) {
+	requirements := func(length, letters, upper, lower, digits, specials int) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"minimum_length":   length,
+			"minimum_letters":  letters,
+			"minimum_upper":    upper,
+			"minimum_lower":    lower,
+			"minimum_digits":   digits,
+			"minimum_specials": specials,
+		}}
+	}
+
+	assert.NoError(t, validatePasswordRequirements(nil))
+	assert.NoError(t, validatePasswordRequirements(requirements(8, 2, 1, 1, 2, 1)))
+	assert.NoError(t, validatePasswordRequirements(requirements(0, 20, 0, 0, 0, 0)))
+	assert.EqualError(t, validatePasswordRequirements(requirements(8, 2, 3, 3, 2, 1)),
+		"password_requirements.minimum_length is 8 but the letter, digit and special character requirements add up to 9 characters")
+}
+
+func TestUnitOrgAuthSettingsIpAllowlistDiff(t *testing.T) {
+	egressIpLookup = func(ctx context.Context, url string) (net.IP, error) {
+		// The lockout check is on by default
+		assert.Equal(t, defaultEgressIpLookupUrl, url)
+		return net.ParseIP("198.51.100.7"), nil
+	}
+	defer func() { egressIpLookup = lookupEgressIp }()
+
+	orgAuthSettingsResource := ResourceOrganizationAuthenticationSettings()
+	state := &terraform.InstanceState{
+		ID: "Settings",
+		Attributes: map[string]string{
+			"id":                     "Settings",
+			"egress_ip_lookup_url":   defaultEgressIpLookupUrl,
+			"force":                  "false",
+			"ip_address_allowlist.#": "2",
+			"ip_address_allowlist.0": "198.51.100.0/24",
+			"ip_address_allowlist.1": "192.0.2.0/24",
+		},
+	}
+	planAllowlist := func(force bool, cidrs ...string) (*terraform.InstanceDiff, error) {
+		return orgAuthSettingsResource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"force":                force,
+			"ip_address_allowlist": lists.StringListToInterfaceList(cidrs),
+		}), nil)
+	}
+
+	diff, err := planAllowlist(false, "198.51.100.0/24", "203.0.113.0/24")
+	assert.NoError(t, err)
+	assert.Equal(t, "203.0.113.0/24", diff.Attributes["ip_address_allowlist_added.0"].New)
+	assert.Equal(t, "1", diff.Attributes["ip_address_allowlist_removed.#"].New)
+	assert.Equal(t, "192.0.2.0/24", diff.Attributes["ip_address_allowlist_removed.0"].New)
+
+	_, err = planAllowlist(false, "203.0.113.0/24")
+	assert.ErrorContains(t, err, "ip_address_allowlist does not include 198.51.100.7")
+
+	// A single IP address allows that address
+	_, err = planAllowlist(false, "203.0.113.0/24", "198.51.100.7")
+	assert.NoError(t, err)
+
+	// A range with host bits set is the same range as the one the API returns
+	diff, err = planAllowlist(false, "198.51.100.10/24", "192.0.2.0/24")
+	assert.NoError(t, err)
+	assert.Nil(t, diff.Attributes["ip_address_allowlist.0"])
+
+	_, err = planAllowlist(true, "203.0.113.0/24")
+	assert.NoError(t, err)
+
+	// An empty allowlist does not restrict any address
+	_, err = planAllowlist(false)
+	assert.NoError(t, err)
+
+	egressIpLookup = func(ctx context.Context, url string) (net.IP, error) {
+		return nil, fmt.Errorf("lookup failed")
+	}
+	_, err = planAllowlist(false, "203.0.113.0/24")
+	assert.ErrorContains(t, err, "set force to apply it anyway: lookup failed")
+}
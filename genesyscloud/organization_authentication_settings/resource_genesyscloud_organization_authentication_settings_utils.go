@@ -1,8 +1,17 @@
 package organization_authentication_settings
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
@@ -20,10 +29,23 @@ func getOrganizationAuthenticationSettingsFromResourceData(d *schema.ResourceDat
 		MultifactorAuthenticationRequired: platformclientv2.Bool(d.Get("multifactor_authentication_required").(bool)),
 		DomainAllowlistEnabled:            platformclientv2.Bool(d.Get("domain_allowlist_enabled").(bool)),
 		DomainAllowlist:                   lists.BuildSdkStringListFromInterfaceArray(d, "domain_allowlist"),
-		IpAddressAllowlist:                lists.BuildSdkStringListFromInterfaceArray(d, "ip_address_allowlist"),
+		IpAddressAllowlist:                buildIpAddressAllowlist(d),
 	}
 }
 
+// buildIpAddressAllowlist maps the ip_address_allowlist into the canonical CIDR ranges sent to Genesys Cloud
+func buildIpAddressAllowlist(d *schema.ResourceData) *[]string {
+	cidrs := lists.BuildSdkStringListFromInterfaceArray(d, "ip_address_allowlist")
+	if cidrs == nil {
+		return nil
+	}
+	normalized := make([]string, len(*cidrs))
+	for i, cidr := range *cidrs {
+		normalized[i] = normalizeCidr(cidr)
+	}
+	return &normalized
+}
+
 // buildPasswordRequirements maps an []interface{} into a Genesys Cloud *[]platformclientv2.Passwordrequirements
 func buildPasswordRequirements(d *schema.ResourceData, key string) *platformclientv2.Passwordrequirements {
 	if d.Get(key) != nil {
@@ -69,3 +91,181 @@ func flattenPasswordRequirements(passwordRequirements *platformclientv2.Password
 
 	return []interface{}{pReqInterface}
 }
+
+// egressIpLookup returns the public IP address Terraform connects to Genesys Cloud from. It is a variable so that tests can stub it out.
+var egressIpLookup = lookupEgressIp
+
+// lookupEgressIp calls a service that returns the public IP address of the caller as plain text
+func lookupEgressIp(ctx context.Context, url string) (net.IP, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return nil, fmt.Errorf("%s did not return an IP address", url)
+	}
+	return ip, nil
+}
+
+// validateIpAddressCidr checks that an ip_address_allowlist entry is a range in CIDR notation or a single IP address.
+// Host bits may be set, the entry is normalized to the range it belongs to.
+func validateIpAddressCidr(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if net.ParseIP(v) != nil {
+		return nil, nil
+	}
+	if _, _, err := net.ParseCIDR(v); err != nil {
+		return nil, []error{fmt.Errorf("%s: %q is neither a valid CIDR range nor an IP address: %v", k, v, err)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentCidrs suppresses the diff between an ip_address_allowlist entry and the canonical range the API returns for it
+func suppressEquivalentCidrs(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeCidr(old) == normalizeCidr(new)
+}
+
+// normalizeCidr returns the canonical form of a CIDR range so that equivalent ranges compare equal. A single IP address
+// is the range of that address alone, /32 for IPv4 and /128 for IPv6.
+func normalizeCidr(cidr string) string {
+	if ip := net.ParseIP(cidr); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32"
+		}
+		return ip.String() + "/128"
+	}
+	if _, network, err := net.ParseCIDR(cidr); err == nil {
+		return network.String()
+	}
+	return cidr
+}
+
+// diffCidrs returns the ranges of newCidrs missing from oldCidrs and the ranges of oldCidrs missing from newCidrs
+func diffCidrs(oldCidrs, newCidrs []string) (added []string, removed []string) {
+	contains := func(cidrs []string, cidr string) bool {
+		for _, c := range cidrs {
+			if normalizeCidr(c) == normalizeCidr(cidr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	added, removed = []string{}, []string{}
+	for _, cidr := range newCidrs {
+		if !contains(oldCidrs, cidr) {
+			added = append(added, cidr)
+		}
+	}
+	for _, cidr := range oldCidrs {
+		if !contains(newCidrs, cidr) {
+			removed = append(removed, cidr)
+		}
+	}
+	return added, removed
+}
+
+// allowlistIncludes reports whether the ip address allowlist lets the IP address authenticate. An empty allowlist allows every address.
+func allowlistIncludes(cidrs []string, ip net.IP) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(normalizeCidr(cidr)); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePasswordRequirements checks that the character requirements of a password fit within its minimum length
+func validatePasswordRequirements(passwordRequirements []interface{}) error {
+	if len(passwordRequirements) == 0 || passwordRequirements[0] == nil {
+		return nil
+	}
+	requirements := passwordRequirements[0].(map[string]interface{})
+	minimumLength, _ := requirements["minimum_length"].(int)
+	if minimumLength == 0 {
+		return nil
+	}
+
+	minimumLetters, _ := requirements["minimum_letters"].(int)
+	minimumUpper, _ := requirements["minimum_upper"].(int)
+	minimumLower, _ := requirements["minimum_lower"].(int)
+	minimumDigits, _ := requirements["minimum_digits"].(int)
+	minimumSpecials, _ := requirements["minimum_specials"].(int)
+
+	if minimumUpper+minimumLower > minimumLetters {
+		minimumLetters = minimumUpper + minimumLower
+	}
+	if required := minimumLetters + minimumDigits + minimumSpecials; required > minimumLength {
+		return fmt.Errorf("password_requirements.minimum_length is %d but the letter, digit and special character requirements add up to %d characters", minimumLength, required)
+	}
+	return nil
+}
+
+// customizeOrgAuthSettingsDiff validates the password requirements, lists the ranges added to and removed from the
+// ip address allowlist and refuses an allowlist that would exclude the IP address Terraform is running from
+func customizeOrgAuthSettingsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validatePasswordRequirements(diff.Get("password_requirements").([]interface{})); err != nil {
+		return err
+	}
+	if !diff.HasChange("ip_address_allowlist") || !diff.NewValueKnown("ip_address_allowlist") {
+		return nil
+	}
+
+	oldValue, newValue := diff.GetChange("ip_address_allowlist")
+	oldCidrs := lists.InterfaceListToStrings(oldValue.([]interface{}))
+	newCidrs := lists.InterfaceListToStrings(newValue.([]interface{}))
+
+	// The settings always exist, so on create compare against the allowlist currently set in the organization
+	if providerMeta, ok := meta.(*provider.ProviderMeta); ok && diff.Id() == "" {
+		orgAuthSettings, _, err := getOrgAuthSettingsProxy(providerMeta.ClientConfig).getOrgAuthSettings(ctx)
+		if err != nil {
+			log.Printf("Unable to read the current ip address allowlist: %v", err)
+		} else if orgAuthSettings.IpAddressAllowlist != nil {
+			oldCidrs = *orgAuthSettings.IpAddressAllowlist
+		}
+	}
+
+	added, removed := diffCidrs(oldCidrs, newCidrs)
+	if err := diff.SetNew("ip_address_allowlist_added", lists.StringListToInterfaceList(added)); err != nil {
+		return err
+	}
+	if err := diff.SetNew("ip_address_allowlist_removed", lists.StringListToInterfaceList(removed)); err != nil {
+		return err
+	}
+
+	if diff.Get("force").(bool) || len(newCidrs) == 0 {
+		return nil
+	}
+	egressIp, err := egressIpLookup(ctx, diff.Get("egress_ip_lookup_url").(string))
+	if err != nil {
+		return fmt.Errorf("unable to check that ip_address_allowlist includes the IP address Terraform is running from, set force to apply it anyway: %v", err)
+	}
+	if !allowlistIncludes(newCidrs, egressIp) {
+		return fmt.Errorf("ip_address_allowlist does not include %s, the IP address Terraform is running from. Applying it would prevent Terraform from authenticating with Genesys Cloud. Add a range including %s or set force to apply it anyway", egressIp, egressIp)
+	}
+	return nil
+}
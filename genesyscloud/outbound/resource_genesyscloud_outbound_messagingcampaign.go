@@ -8,6 +8,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -63,9 +64,10 @@ var (
 				Type:        schema.TypeString,
 			},
 			`sender_sms_phone_number`: {
-				Description: `A phone number provisioned for SMS communications in E.164 format. E.g. +13175555555 or +34234234234`,
-				Required:    true,
-				Type:        schema.TypeString,
+				Description:      `A phone number provisioned for SMS communications in E.164 format. E.g. +13175555555 or +34234234234`,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validators.ValidatePhoneNumber,
 			},
 			`content_template_id`: {
 				Description: `The content template used to formulate the message to send to the contact. Either message_column or content_template_id is required.`,
@@ -0,0 +1,63 @@
+package outbound_campaign
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_outbound_campaign_stats.go contains the data source implementation
+   for reading the live statistics of an outbound campaign.
+*/
+
+// dataSourceOutboundCampaignStatsRead retrieves the status, progress and statistics of a campaign
+func dataSourceOutboundCampaignStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := newOutboundCampaignProxy(sdkConfig)
+
+	campaignId := d.Get("campaign_id").(string)
+
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		campaign, resp, err := proxy.getOutboundCampaignById(ctx, campaignId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(statsDataSourceName, fmt.Sprintf("No campaign found with id %s", campaignId), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(statsDataSourceName, fmt.Sprintf("Error reading campaign %s | error: %s", campaignId, err), resp))
+		}
+
+		progress, resp, err := proxy.getOutboundCampaignProgress(ctx, campaignId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(statsDataSourceName, fmt.Sprintf("Error reading progress of campaign %s | error: %s", campaignId, err), resp))
+		}
+
+		stats, resp, err := proxy.getOutboundCampaignStats(ctx, campaignId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(statsDataSourceName, fmt.Sprintf("Error reading stats of campaign %s | error: %s", campaignId, err), resp))
+		}
+
+		d.SetId(campaignId)
+		resourcedata.SetNillableValue(d, "campaign_status", campaign.CampaignStatus)
+		_ = d.Set("campaign_errors", flattenCampaignErrors(campaign.Errors))
+		resourcedata.SetNillableValue(d, "progress_percentage", progress.Percentage)
+		resourcedata.SetNillableValue(d, "contacts_called", progress.NumberOfContactsCalled)
+		resourcedata.SetNillableValue(d, "total_contacts", progress.TotalNumberOfContacts)
+		if stats.ContactRate != nil {
+			resourcedata.SetNillableValue(d, "attempts", stats.ContactRate.Attempts)
+			resourcedata.SetNillableValue(d, "connects", stats.ContactRate.Connects)
+			resourcedata.SetNillableValue(d, "connect_ratio", stats.ContactRate.ConnectRatio)
+		}
+		resourcedata.SetNillableValue(d, "idle_agents", stats.IdleAgents)
+		resourcedata.SetNillableValue(d, "outstanding_calls", stats.OutstandingCalls)
+		resourcedata.SetNillableValue(d, "scheduled_calls", stats.ScheduledCalls)
+		return nil
+	})
+}
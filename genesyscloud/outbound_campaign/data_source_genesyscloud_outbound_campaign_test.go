@@ -54,10 +54,16 @@ func TestAccDataSourceOutboundCampaign(t *testing.T) {
 					dataSourceId,
 					campaignName,
 					"genesyscloud_outbound_campaign."+resourceId,
+				) + generateOutboundCampaignStatsDataSource(
+					dataSourceId,
+					"genesyscloud_outbound_campaign."+resourceId+".id",
 				),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_outbound_campaign."+dataSourceId, "id",
 						"genesyscloud_outbound_campaign."+resourceId, "id"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_outbound_campaign_stats."+dataSourceId, "id",
+						"genesyscloud_outbound_campaign."+resourceId, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_outbound_campaign_stats."+dataSourceId, "campaign_status", "off"),
 				),
 			},
 		},
@@ -72,3 +78,11 @@ data "genesyscloud_outbound_campaign" "%s" {
 }
 `, id, name, dependsOn)
 }
+
+func generateOutboundCampaignStatsDataSource(id string, campaignId string) string {
+	return fmt.Sprintf(`
+data "genesyscloud_outbound_campaign_stats" "%s" {
+	campaign_id = %s
+}
+`, id, campaignId)
+}
@@ -71,6 +71,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceOutboundCampaign()
+	providerDataSources[statsDataSourceName] = DataSourceOutboundCampaignStats()
 	providerDataSources["genesyscloud_auth_division_home"] = gcloud.DataSourceAuthDivisionHome()
 }
 
@@ -23,6 +23,13 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundCampaignProxy
 
+// A campaign goes through 'stopping' while its outstanding calls complete, which can take a few minutes.
+// turnOffCampaign polls the campaign status every campaignStatusPollInterval until campaignStopTimeout passes.
+var (
+	campaignStopTimeout        = 5 * time.Minute
+	campaignStatusPollInterval = 5 * time.Second
+)
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error)
@@ -30,6 +37,8 @@ type getOutboundCampaignIdByNameFunc func(ctx context.Context, p *outboundCampai
 type getOutboundCampaignByIdFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (campaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error)
 type updateOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type deleteOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (response *platformclientv2.APIResponse, err error)
+type getOutboundCampaignProgressFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignprogress, *platformclientv2.APIResponse, error)
+type getOutboundCampaignStatsFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error)
 
 // outboundCampaignProxy contains all of the methods that call genesys cloud APIs.
 type outboundCampaignProxy struct {
@@ -41,6 +50,8 @@ type outboundCampaignProxy struct {
 	getOutboundCampaignByIdAttr     getOutboundCampaignByIdFunc
 	updateOutboundCampaignAttr      updateOutboundCampaignFunc
 	deleteOutboundCampaignAttr      deleteOutboundCampaignFunc
+	getOutboundCampaignProgressAttr getOutboundCampaignProgressFunc
+	getOutboundCampaignStatsAttr    getOutboundCampaignStatsFunc
 	campaignCache                   rc.CacheInterface[platformclientv2.Campaign]
 }
 
@@ -57,6 +68,8 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 		getOutboundCampaignByIdAttr:     getOutboundCampaignByIdFn,
 		updateOutboundCampaignAttr:      updateOutboundCampaignFn,
 		deleteOutboundCampaignAttr:      deleteOutboundCampaignFn,
+		getOutboundCampaignProgressAttr: getOutboundCampaignProgressFn,
+		getOutboundCampaignStatsAttr:    getOutboundCampaignStatsFn,
 		campaignCache:                   campaignCache,
 	}
 }
@@ -103,6 +116,16 @@ func (p *outboundCampaignProxy) deleteOutboundCampaign(ctx context.Context, id s
 	return p.deleteOutboundCampaignAttr(ctx, p, id)
 }
 
+// getOutboundCampaignProgress returns the dialing progress of a Genesys Cloud outbound campaign
+func (p *outboundCampaignProxy) getOutboundCampaignProgress(ctx context.Context, id string) (*platformclientv2.Campaignprogress, *platformclientv2.APIResponse, error) {
+	return p.getOutboundCampaignProgressAttr(ctx, p, id)
+}
+
+// getOutboundCampaignStats returns the live statistics of a Genesys Cloud outbound campaign
+func (p *outboundCampaignProxy) getOutboundCampaignStats(ctx context.Context, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error) {
+	return p.getOutboundCampaignStatsAttr(ctx, p, id)
+}
+
 // turnOffCampaign sets a campaign's campaign_status to 'off' before confirming the update using retry logic and get calls
 func (p *outboundCampaignProxy) turnOffCampaign(ctx context.Context, campaignId string) diag.Diagnostics {
 	log.Printf("Reading Outbound Campaign %s", campaignId)
@@ -118,20 +141,21 @@ func (p *outboundCampaignProxy) turnOffCampaign(ctx context.Context, campaignId
 	}
 	log.Printf("Updated campaign '%s'", *outboundCampaign.Name)
 
-	return util.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+	// util.WithRetries starts over when it times out, so a campaign stuck in 'stopping' would be polled forever
+	return diag.FromErr(retry.RetryContext(ctx, campaignStopTimeout, func() *retry.RetryError {
 		log.Printf("Reading Outbound Campaign %s to ensure campaign_status is 'off'", campaignId)
 		outboundCampaign, resp, getErr := p.getOutboundCampaignById(ctx, campaignId)
 		if getErr != nil {
 			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read Outbound Campaign %s | error: %s", campaignId, getErr), resp))
 		}
 		log.Printf("Read Outbound Campaign %s", campaignId)
-		if *outboundCampaign.CampaignStatus == "on" {
-			time.Sleep(5 * time.Second)
+		if *outboundCampaign.CampaignStatus == "on" || *outboundCampaign.CampaignStatus == "stopping" {
+			time.Sleep(campaignStatusPollInterval)
 			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("campaign %s campaign_status is still %s", campaignId, *outboundCampaign.CampaignStatus), resp))
 		}
 		// Success
 		return nil
-	})
+	}))
 }
 
 // createOutboundCampaignFn is an implementation function for creating a Genesys Cloud outbound campaign
@@ -231,3 +255,21 @@ func deleteOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy, id st
 	rc.DeleteCacheItem(p.campaignCache, id)
 	return resp, nil
 }
+
+// getOutboundCampaignProgressFn is an implementation of the function to get the progress of a Genesys Cloud outbound campaign
+func getOutboundCampaignProgressFn(_ context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignprogress, *platformclientv2.APIResponse, error) {
+	progress, resp, err := p.outboundApi.GetOutboundCampaignProgress(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve progress of campaign %s: %s", id, err)
+	}
+	return progress, resp, nil
+}
+
+// getOutboundCampaignStatsFn is an implementation of the function to get the statistics of a Genesys Cloud outbound campaign
+func getOutboundCampaignStatsFn(_ context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error) {
+	stats, resp, err := p.outboundApi.GetOutboundCampaignStats(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve stats of campaign %s: %s", id, err)
+	}
+	return stats, resp, nil
+}
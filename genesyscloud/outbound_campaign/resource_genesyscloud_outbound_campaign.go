@@ -149,6 +149,10 @@ func readOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta inte
 		resourcedata.SetNillableReference(d, "division_id", campaign.Division)
 		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "dynamic_contact_queueing_settings", campaign.DynamicContactQueueingSettings, flattenSettings)
 		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "dynamic_line_balancing_settings", campaign.DynamicLineBalancingSettings, flattenLineBalancingSettings)
+		_ = d.Set("campaign_errors", flattenCampaignErrors(campaign.Errors))
+		if d.Get("track_progress").(bool) {
+			readOutboundCampaignProgress(ctx, d, proxy)
+		}
 
 		log.Printf("Read Outbound Campaign %s %s", d.Id(), *campaign.Name)
 		return cc.CheckState(d)
@@ -161,6 +165,21 @@ func updateOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta in
 	proxy := getOutboundCampaignProxy(clientConfig)
 	campaignStatus := d.Get("campaign_status").(string)
 
+	// Some fields can only be changed while the campaign is off. Stop the campaign first;
+	// the campaign_status update below turns it back on if it is still meant to be running.
+	if d.HasChanges(fieldsRequiringCampaignOff...) {
+		currentCampaign, resp, err := proxy.getOutboundCampaignById(ctx, d.Id())
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read campaign %s error: %s", d.Id(), err), resp)
+		}
+		if *currentCampaign.CampaignStatus == "on" || *currentCampaign.CampaignStatus == "stopping" {
+			log.Printf("Turning off Outbound Campaign %s before updating fields that require it to be off", d.Id())
+			if diagErr := proxy.turnOffCampaign(ctx, d.Id()); diagErr != nil {
+				return diagErr
+			}
+		}
+	}
+
 	campaign := getOutboundCampaignFromResourceData(d)
 
 	log.Printf("Updating Outbound Campaign %s", *campaign.Name)
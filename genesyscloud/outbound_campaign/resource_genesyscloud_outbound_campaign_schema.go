@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 )
 
 /*
@@ -18,11 +19,13 @@ resource_genesycloud_outbound_campaign_schema.go holds four functions within it:
 4.  The resource exporter configuration for the outbound_campaign exporter.
 */
 const resourceName = "genesyscloud_outbound_campaign"
+const statsDataSourceName = "genesyscloud_outbound_campaign_stats"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceOutboundCampaign())
 	regInstance.RegisterDataSource(resourceName, DataSourceOutboundCampaign())
+	regInstance.RegisterDataSource(statsDataSourceName, DataSourceOutboundCampaignStats())
 	regInstance.RegisterExporter(resourceName, OutboundCampaignExporter())
 }
 
@@ -87,7 +90,7 @@ func ResourceOutboundCampaign() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`campaign_status`: {
-				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). If this value is changed alongside other changes to the resource, a subsequent update will occur immediately afterwards to set the campaign status. This is due to behavioral requirements in the Genesys Cloud API.`,
+				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). If this value is changed alongside other changes to the resource, a subsequent update will occur immediately afterwards to set the campaign status. This is due to behavioral requirements in the Genesys Cloud API. When a field that can only be changed while the Campaign is off is updated on a running Campaign, the Campaign is stopped, updated and then turned back on. The update fails if the Campaign does not stop within 5 minutes.`,
 				Optional:     true,
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -135,9 +138,10 @@ func ResourceOutboundCampaign() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`caller_address`: {
-				Description: `The caller id phone number to be displayed on the outbound call.`,
-				Required:    true,
-				Type:        schema.TypeString,
+				Description:      `The caller id phone number to be displayed on the outbound call. Phone number must be in an E.164 number format.`,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validators.ValidatePhoneNumber,
 			},
 			`outbound_line_count`: {
 				Description: `The number of outbound lines to be concurrently dialed. Only applicable to non-preview campaigns; only required for agentless.`,
@@ -239,6 +243,33 @@ func ResourceOutboundCampaign() *schema.Resource {
 					},
 				},
 			},
+			`track_progress`: {
+				Description: `Whether the progress of the Campaign is read into progress_percentage, contacts_called and total_contacts. Reading the progress takes an additional API call on every refresh.`,
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+			},
+			`progress_percentage`: {
+				Description: `Percentage of the contacts processed by the Campaign. Only set if track_progress is true.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			`contacts_called`: {
+				Description: `Number of contacts called by the Campaign. Only set if track_progress is true.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			`total_contacts`: {
+				Description: `Total number of contacts in the Campaign. Only set if track_progress is true.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			`campaign_errors`: {
+				Description: `The current error conditions of the Campaign. An empty list indicates a healthy Campaign.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -309,3 +340,74 @@ func DataSourceOutboundCampaign() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceOutboundCampaignStats registers the genesyscloud_outbound_campaign_stats data source
+func DataSourceOutboundCampaignStats() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud outbound campaign stats data source. Reads the live status, progress and statistics of an outbound campaign, e.g. to gate a pipeline on a campaign's progress.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceOutboundCampaignStatsRead),
+		Schema: map[string]*schema.Schema{
+			"campaign_id": {
+				Description: `ID of the outbound campaign.`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"campaign_status": {
+				Description: `The current status of the campaign.`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"campaign_errors": {
+				Description: `The current error conditions of the campaign. An empty list indicates a healthy campaign.`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"progress_percentage": {
+				Description: `Percentage of the contacts processed by the campaign.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"contacts_called": {
+				Description: `Number of contacts called by the campaign.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"total_contacts": {
+				Description: `Total number of contacts in the campaign.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"attempts": {
+				Description: `Number of call attempts made.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"connects": {
+				Description: `Number of calls with a live voice detected.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"connect_ratio": {
+				Description: `Ratio of connects to attempts.`,
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"idle_agents": {
+				Description: `Number of available agents not currently being utilized.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"outstanding_calls": {
+				Description: `Number of campaign calls currently ongoing.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"scheduled_calls": {
+				Description: `Number of campaign calls currently scheduled.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package outbound_campaign
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// campaignStub simulates the campaign_status transitions of a Genesys Cloud outbound campaign. A running campaign
+// that is turned off is 'stopping' for stoppingReads reads before it is 'off'.
+type campaignStub struct {
+	t             *testing.T
+	id            string
+	name          string
+	status        string
+	stoppingReads int
+	contactListId string
+	calls         []string
+}
+
+func (s *campaignStub) proxy() *outboundCampaignProxy {
+	return &outboundCampaignProxy{
+		getOutboundCampaignByIdAttr: func(ctx context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+			assert.Equal(s.t, s.id, id)
+			if s.status == "stopping" {
+				if s.stoppingReads == 0 {
+					s.status = "off"
+				} else {
+					s.stoppingReads--
+				}
+			}
+			return s.campaign(), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		updateOutboundCampaignAttr: func(ctx context.Context, p *outboundCampaignProxy, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+			assert.Equal(s.t, s.id, id)
+			switch {
+			case *campaign.CampaignStatus == "off" && s.status == "on":
+				s.calls = append(s.calls, "stop")
+				s.status = "stopping"
+			case *campaign.CampaignStatus == "on":
+				s.calls = append(s.calls, "start")
+				s.status = "on"
+			case s.status != "off":
+				return nil, &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("campaign %s is %s", id, s.status)
+			default:
+				s.calls = append(s.calls, "update")
+				s.contactListId = *campaign.ContactList.Id
+			}
+			return s.campaign(), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		getOutboundCampaignProgressAttr: func(ctx context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignprogress, *platformclientv2.APIResponse, error) {
+			s.calls = append(s.calls, "progress")
+			percentage := 40
+			return &platformclientv2.Campaignprogress{Percentage: &percentage}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+}
+
+func (s *campaignStub) campaign() *platformclientv2.Campaign {
+	return &platformclientv2.Campaign{
+		Id:             &s.id,
+		Name:           &s.name,
+		DialingMode:    platformclientv2.String("agentless"),
+		CampaignStatus: platformclientv2.String(s.status),
+		ContactList:    &platformclientv2.Domainentityref{Id: platformclientv2.String(s.contactListId)},
+	}
+}
+
+func buildCampaignResourceData(t *testing.T, stub *campaignStub, contactListId string, trackProgress bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceOutboundCampaign().Schema, map[string]interface{}{
+		"name":            stub.name,
+		"dialing_mode":    "agentless",
+		"contact_list_id": contactListId,
+		"campaign_status": "on",
+		"track_progress":  trackProgress,
+	})
+	d.SetId(stub.id)
+	return d
+}
+
+func TestUnitUpdateOutboundCampaignStopsRunningCampaign(t *testing.T) {
+	stub := &campaignStub{t: t, id: uuid.NewString(), name: "Unit Test Campaign", status: "on", stoppingReads: 2, contactListId: uuid.NewString()}
+	internalProxy = stub.proxy()
+	defer func() { internalProxy = nil }()
+
+	pollInterval := campaignStatusPollInterval
+	campaignStatusPollInterval = time.Millisecond
+	defer func() { campaignStatusPollInterval = pollInterval }()
+
+	newContactListId := uuid.NewString()
+	d := buildCampaignResourceData(t, stub, newContactListId, false)
+
+	diags := updateOutboundCampaign(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), "%v", diags)
+	// The contact list can only be changed while the campaign is off, so the campaign is stopped, updated and started again
+	assert.Equal(t, []string{"stop", "update", "start"}, stub.calls)
+	assert.Equal(t, newContactListId, stub.contactListId)
+	assert.Equal(t, "on", d.Get("campaign_status"))
+}
+
+func TestUnitUpdateOutboundCampaignStopTimeout(t *testing.T) {
+	// The campaign never leaves 'stopping'
+	stub := &campaignStub{t: t, id: uuid.NewString(), name: "Unit Test Campaign", status: "on", stoppingReads: 1 << 30, contactListId: uuid.NewString()}
+	internalProxy = stub.proxy()
+	defer func() { internalProxy = nil }()
+
+	stopTimeout, pollInterval := campaignStopTimeout, campaignStatusPollInterval
+	campaignStopTimeout, campaignStatusPollInterval = 100*time.Millisecond, time.Millisecond
+	defer func() { campaignStopTimeout, campaignStatusPollInterval = stopTimeout, pollInterval }()
+
+	d := buildCampaignResourceData(t, stub, uuid.NewString(), false)
+
+	diags := updateOutboundCampaign(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "campaign_status is still stopping")
+	// The campaign is not updated or restarted once it fails to stop
+	assert.Equal(t, []string{"stop"}, stub.calls)
+}
+
+func TestUnitReadOutboundCampaignProgress(t *testing.T) {
+	stub := &campaignStub{t: t, id: uuid.NewString(), name: "Unit Test Campaign", status: "on", contactListId: uuid.NewString()}
+	internalProxy = stub.proxy()
+	defer func() { internalProxy = nil }()
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// The progress is only read when track_progress is set
+	d := buildCampaignResourceData(t, stub, stub.contactListId, false)
+	assert.False(t, readOutboundCampaign(context.Background(), d, meta).HasError())
+	assert.Empty(t, stub.calls)
+
+	// The consistency checker tracks resources by ID, so the second read is of another campaign
+	stub.id = uuid.NewString()
+	d = buildCampaignResourceData(t, stub, stub.contactListId, true)
+	assert.False(t, readOutboundCampaign(context.Background(), d, meta).HasError())
+	assert.Equal(t, []string{"progress"}, stub.calls)
+	assert.Equal(t, 40, d.Get("progress_percentage"))
+}
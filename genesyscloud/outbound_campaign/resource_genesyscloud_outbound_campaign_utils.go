@@ -102,6 +102,52 @@ func updateOutboundCampaignStatus(ctx context.Context, campaignId string, proxy
 	return nil
 }
 
+// fieldsRequiringCampaignOff are the fields that Genesys Cloud refuses to change while a campaign is running
+var fieldsRequiringCampaignOff = []string{
+	"contact_list_id",
+	"queue_id",
+	"dialing_mode",
+	"script_id",
+	"edge_group_id",
+	"site_id",
+	"phone_columns",
+	"contact_list_filter_ids",
+	"call_analysis_response_set_id",
+}
+
+// readOutboundCampaignProgress sets the computed progress attributes. Progress is informational, so failing to read it does not fail the read.
+func readOutboundCampaignProgress(ctx context.Context, d *schema.ResourceData, proxy *outboundCampaignProxy) {
+	progress, resp, err := proxy.getOutboundCampaignProgress(ctx, d.Id())
+	if err != nil {
+		log.Printf("Failed to read progress of Outbound Campaign %s: %v %v", d.Id(), err, resp)
+		return
+	}
+	resourcedata.SetNillableValue(d, "progress_percentage", progress.Percentage)
+	resourcedata.SetNillableValue(d, "contacts_called", progress.NumberOfContactsCalled)
+	resourcedata.SetNillableValue(d, "total_contacts", progress.TotalNumberOfContacts)
+}
+
+func flattenCampaignErrors(campaignErrors *[]platformclientv2.Resterrordetail) []interface{} {
+	if campaignErrors == nil {
+		return nil
+	}
+	var errorList []interface{}
+	for _, campaignError := range *campaignErrors {
+		message := ""
+		if campaignError.VarError != nil {
+			message = *campaignError.VarError
+		}
+		if campaignError.Details != nil && *campaignError.Details != "" {
+			if message != "" {
+				message += ": "
+			}
+			message += *campaignError.Details
+		}
+		errorList = append(errorList, message)
+	}
+	return errorList
+}
+
 func buildPhoneColumns(phonecolumns []interface{}) *[]platformclientv2.Phonecolumn {
 	if len(phonecolumns) == 0 {
 		return nil
@@ -8,6 +8,7 @@ import (
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 )
 
 /*
@@ -384,9 +385,10 @@ var (
 	setSmsPhoneNumberActionSettingsResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			`sender_sms_phone_number`: {
-				Description: `The string address for the sms phone number.`,
-				Required:    true,
-				Type:        schema.TypeString,
+				Description:      `The string address for the sms phone number. Phone number must be in an E.164 number format.`,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validators.ValidatePhoneNumber,
 			},
 		},
 	}
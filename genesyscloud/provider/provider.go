@@ -50,7 +50,7 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 		*/
 		copiedResources := make(map[string]*schema.Resource)
 		for k, v := range providerResources {
-			copiedResources[k] = v
+			copiedResources[k] = withPolicy(k, v)
 		}
 
 		copiedDataSources := make(map[string]*schema.Resource)
@@ -162,24 +162,33 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 						},
 					},
 				},
+				"policy":              policySchema(),
+				"location_validation": locationValidationSchema(),
 			},
 			ResourcesMap:         copiedResources,
 			DataSourcesMap:       copiedDataSources,
-			ConfigureContextFunc: configure(version),
+			ConfigureContextFunc: configure(version, copiedResources),
 		}
 	}
 }
 
 type ProviderMeta struct {
-	Version      string
-	ClientConfig *platformclientv2.Configuration
-	Domain       string
-	Organization *platformclientv2.Organization
+	Version            string
+	ClientConfig       *platformclientv2.Configuration
+	Domain             string
+	Organization       *platformclientv2.Organization
+	Policy             *Policy
+	LocationValidation *LocationValidation
 }
 
-func configure(version string) schema.ConfigureContextFunc {
+func configure(version string, resources map[string]*schema.Resource) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
+		policy, err := buildPolicy(data, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		err = InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
 		if err != nil {
 			return nil, err
 		}
@@ -193,10 +202,12 @@ func configure(version string) schema.ConfigureContextFunc {
 		orgDefaultCountryCode = *currentOrg.DefaultCountryCode
 
 		return &ProviderMeta{
-			Version:      version,
-			ClientConfig: defaultConfig,
-			Domain:       getRegionDomain(data.Get("aws_region").(string)),
-			Organization: currentOrg,
+			Version:            version,
+			ClientConfig:       defaultConfig,
+			Domain:             getRegionDomain(data.Get("aws_region").(string)),
+			Organization:       currentOrg,
+			Policy:             policy,
+			LocationValidation: buildLocationValidation(data),
 		}, nil
 	}
 }
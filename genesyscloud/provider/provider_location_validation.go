@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+provider_location_validation.go implements the location_validation block of the provider. The block enables the validation
+of the addresses and emergency numbers of genesyscloud_location resources, and can configure an HTTP endpoint the addresses
+are sent to for validation. Validation findings are reported as warnings, as locations that fail validation may still be valid
+for Genesys Cloud.
+*/
+
+// LocationValidation holds the settings of the location_validation block of the provider
+type LocationValidation struct {
+	AddressValidationEndpoint string
+	Timeout                   time.Duration
+}
+
+func locationValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Validates the addresses and emergency numbers of `genesyscloud_location` resources when they are created or changed, and reports the findings as warnings.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_validation_endpoint": {
+					Description:  "URL of an HTTP endpoint that validates addresses. Each address is sent in a POST request. If not set, addresses are only checked against the address rules of their country.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+				"timeout_seconds": {
+					Description:  "Timeout of the requests to the address validation endpoint.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// buildLocationValidation reads the location_validation block of the provider. Location validation is disabled if the block is not set.
+func buildLocationValidation(data *schema.ResourceData) *LocationValidation {
+	locationValidations := data.Get("location_validation").([]interface{})
+	if len(locationValidations) == 0 {
+		return nil
+	}
+
+	locationValidation := &LocationValidation{Timeout: 10 * time.Second}
+	if locationValidationMap, ok := locationValidations[0].(map[string]interface{}); ok {
+		locationValidation.AddressValidationEndpoint = locationValidationMap["address_validation_endpoint"].(string)
+		locationValidation.Timeout = time.Duration(locationValidationMap["timeout_seconds"].(int)) * time.Second
+	}
+	return locationValidation
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitBuildLocationValidation(t *testing.T) {
+	providerSchema := map[string]*schema.Schema{"location_validation": locationValidationSchema()}
+
+	data := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{})
+	if locationValidation := buildLocationValidation(data); locationValidation != nil {
+		t.Fatalf("expected location validation to be disabled, got %v", locationValidation)
+	}
+
+	data = schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"location_validation": []interface{}{map[string]interface{}{}},
+	})
+	locationValidation := buildLocationValidation(data)
+	if locationValidation == nil || locationValidation.AddressValidationEndpoint != "" || locationValidation.Timeout != 10*time.Second {
+		t.Fatalf("unexpected location validation %v", locationValidation)
+	}
+
+	data = schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"location_validation": []interface{}{map[string]interface{}{
+			"address_validation_endpoint": "https://addresses.example.com/validate",
+			"timeout_seconds":             3,
+		}},
+	})
+	locationValidation = buildLocationValidation(data)
+	if locationValidation == nil || locationValidation.AddressValidationEndpoint != "https://addresses.example.com/validate" || locationValidation.Timeout != 3*time.Second {
+		t.Fatalf("unexpected location validation %v", locationValidation)
+	}
+}
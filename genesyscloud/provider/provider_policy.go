@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+provider_policy.go implements the provider level policy block. The policy declares regular expressions that attributes of
+the resources registered with the provider must match, so that naming conventions are enforced at plan time instead of in
+code review. Rules with the error severity fail the plan. Rules with the warning severity cannot be reported at plan time
+by the plugin SDK, so they are only reported as warnings when the resource is created or updated during apply. The
+plugin SDK does not pass the resource address to the provider, so violations name the resource type but not the
+resource.
+*/
+
+const (
+	policySeverityError   = "error"
+	policySeverityWarning = "warning"
+)
+
+// Policy holds the rules declared in the policy block of the provider
+type Policy struct {
+	Rules []PolicyRule
+}
+
+// PolicyRule requires an attribute of a resource type to match a regular expression
+type PolicyRule struct {
+	ResourceType string
+	Attribute    string
+	Pattern      *regexp.Regexp
+	Severity     string
+	Message      string
+}
+
+// policyViolation is an attribute value that does not match a rule
+type policyViolation struct {
+	rule  PolicyRule
+	value string
+}
+
+func policySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Naming and tagging rules checked against the resources managed by the provider. Rules are checked when a resource is created and when the attribute they apply to changes, so existing resources are not checked until the attribute changes. Violations name the resource type and the attribute value but not the resource address.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule": {
+					Description: "Requires an attribute to match a regular expression.",
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_type": {
+								Description: "Resource type the rule applies to, e.g. `genesyscloud_routing_queue`. If not set, the rule applies to every resource type with the attribute.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"attribute": {
+								Description: "Attribute to check, e.g. `name`, `description` or `division_id`. Nested attributes are addressed with their full path, e.g. `media_settings_call.0.alerting_timeout_sec`.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"pattern": {
+								Description:  "Regular expression the attribute must match. Empty and unset values are checked as an empty string.",
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							"severity": {
+								Description:  "`error` fails the plan. `warning` is not reported by the plan, only as a warning when the resource is created or updated during apply.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      policySeverityError,
+								ValidateFunc: validation.StringInSlice([]string{policySeverityError, policySeverityWarning}, false),
+							},
+							"message": {
+								Description: "Explanation of the rule added to the error or warning.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPolicy reads the policy block of the provider and checks that its rules refer to registered resources and attributes
+func buildPolicy(data *schema.ResourceData, resources map[string]*schema.Resource) (*Policy, diag.Diagnostics) {
+	policy := &Policy{}
+	policies := data.Get("policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return policy, nil
+	}
+
+	for i, ruleConfig := range policies[0].(map[string]interface{})["rule"].([]interface{}) {
+		ruleMap := ruleConfig.(map[string]interface{})
+		rule := PolicyRule{
+			ResourceType: ruleMap["resource_type"].(string),
+			Attribute:    ruleMap["attribute"].(string),
+			Severity:     ruleMap["severity"].(string),
+			Message:      ruleMap["message"].(string),
+		}
+
+		pattern, err := regexp.Compile(ruleMap["pattern"].(string))
+		if err != nil {
+			return nil, diag.Errorf("policy rule %d has an invalid pattern: %v", i, err)
+		}
+		rule.Pattern = pattern
+
+		if rule.ResourceType != "" {
+			resource, ok := resources[rule.ResourceType]
+			if !ok {
+				return nil, diag.Errorf("policy rule %d applies to %s, which is not a resource type of this provider", i, rule.ResourceType)
+			}
+			if !hasAttribute(resource, rule.Attribute) {
+				return nil, diag.Errorf("policy rule %d checks attribute %s, which %s does not have", i, rule.Attribute, rule.ResourceType)
+			}
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	return policy, nil
+}
+
+// hasAttribute reports whether the attribute path exists in the resource schema, skipping list and set indexes
+func hasAttribute(resource *schema.Resource, attribute string) bool {
+	schemaMap := resource.Schema
+	parts := strings.Split(attribute, ".")
+	for i := 0; i < len(parts); i++ {
+		attrSchema, ok := schemaMap[parts[i]]
+		if !ok {
+			return false
+		}
+		if i == len(parts)-1 {
+			return true
+		}
+		nested, ok := attrSchema.Elem.(*schema.Resource)
+		if !ok {
+			// Only an index of a list of primitives may follow
+			return i == len(parts)-2
+		}
+		schemaMap = nested.Schema
+		// Skip the index of the list or set
+		i++
+	}
+	return false
+}
+
+// violations checks the attribute values of a resource against the rules of the given severity. getValue returns the value
+// of an attribute and whether it should be checked.
+func (p *Policy) violations(resourceType string, resource *schema.Resource, severity string, getValue func(attribute string) (interface{}, bool)) []policyViolation {
+	if p == nil {
+		return nil
+	}
+	var violations []policyViolation
+	for _, rule := range p.Rules {
+		if rule.Severity != severity || (rule.ResourceType != "" && rule.ResourceType != resourceType) || !hasAttribute(resource, rule.Attribute) {
+			continue
+		}
+		value, check := getValue(rule.Attribute)
+		if !check {
+			continue
+		}
+		switch value.(type) {
+		case string, int, bool, float64, nil:
+		default:
+			// Only primitive values are checked
+			continue
+		}
+		text := ""
+		if value != nil {
+			text = fmt.Sprintf("%v", value)
+		}
+		if !rule.Pattern.MatchString(text) {
+			violations = append(violations, policyViolation{rule: rule, value: text})
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].rule.Attribute < violations[j].rule.Attribute
+	})
+	return violations
+}
+
+func (v policyViolation) describe(resourceType string) string {
+	description := fmt.Sprintf("%s %s %q does not match the policy pattern %q", resourceType, v.rule.Attribute, v.value, v.rule.Pattern.String())
+	if v.rule.Message != "" {
+		description += ": " + v.rule.Message
+	}
+	return description
+}
+
+// withPolicy returns a copy of the resource that checks the provider policy. Rules with the error severity are checked
+// when the plan is created, rules with the warning severity only when the resource is created or updated during apply,
+// as CustomizeDiff cannot return warnings. Existing resources are only checked when the attribute of a rule changes.
+// Neither the diff nor the resource data carry the resource address, so violations are described by resource type.
+func withPolicy(resourceType string, resource *schema.Resource) *schema.Resource {
+	wrapped := *resource
+
+	customizeDiff := resource.CustomizeDiff
+	wrapped.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		providerMeta, ok := meta.(*ProviderMeta)
+		if !ok {
+			return nil
+		}
+		violations := providerMeta.Policy.violations(resourceType, resource, policySeverityError, func(attribute string) (interface{}, bool) {
+			if !diff.NewValueKnown(attribute) || (diff.Id() != "" && !diff.HasChange(attribute)) {
+				return nil, false
+			}
+			return diff.Get(attribute), true
+		})
+		var messages []string
+		for _, violation := range violations {
+			messages = append(messages, violation.describe(resourceType))
+		}
+		if len(messages) > 0 {
+			return fmt.Errorf("%s", strings.Join(messages, "\n"))
+		}
+		return nil
+	}
+
+	warnings := func(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		providerMeta, ok := meta.(*ProviderMeta)
+		if !ok {
+			return nil
+		}
+		var diags diag.Diagnostics
+		violations := providerMeta.Policy.violations(resourceType, resource, policySeverityWarning, func(attribute string) (interface{}, bool) {
+			if !d.IsNewResource() && !d.HasChange(attribute) {
+				return nil, false
+			}
+			return d.Get(attribute), true
+		})
+		for _, violation := range violations {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resource does not follow the provider policy",
+				Detail:   violation.describe(resourceType),
+			})
+		}
+		return diags
+	}
+	wrapped.CreateContext = withPolicyWarnings(resource.CreateContext, warnings)
+	wrapped.CreateWithoutTimeout = withPolicyWarnings(resource.CreateWithoutTimeout, warnings)
+	wrapped.UpdateContext = withPolicyWarnings(resource.UpdateContext, warnings)
+	wrapped.UpdateWithoutTimeout = withPolicyWarnings(resource.UpdateWithoutTimeout, warnings)
+	return &wrapped
+}
+
+// withPolicyWarnings adds the policy warnings to the diagnostics of a create or update method
+func withPolicyWarnings[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](method F, warnings func(*schema.ResourceData, interface{}) diag.Diagnostics) F {
+	if method == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// The changes are checked before the method updates the resource data
+		diags := warnings(d, meta)
+		return append(diags, method(ctx, d, meta)...)
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("queue-1")
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"media_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alerting_timeout_sec": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUnitBuildPolicy(t *testing.T) {
+	resources := map[string]*schema.Resource{"genesyscloud_routing_queue": testPolicyResource()}
+	providerSchema := map[string]*schema.Schema{"policy": policySchema()}
+	buildRules := func(rules ...interface{}) (*Policy, diag.Diagnostics) {
+		data := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+			"policy": []interface{}{map[string]interface{}{"rule": rules}},
+		})
+		return buildPolicy(data, resources)
+	}
+
+	policy, diags := buildRules(map[string]interface{}{
+		"resource_type": "genesyscloud_routing_queue",
+		"attribute":     "media_settings.0.alerting_timeout_sec",
+		"pattern":       "^[0-9]+$",
+	})
+	if diags.HasError() || len(policy.Rules) != 1 || policy.Rules[0].Severity != policySeverityError {
+		t.Fatalf("unexpected policy %v: %v", policy, diags)
+	}
+
+	if _, diags = buildRules(map[string]interface{}{"resource_type": "genesyscloud_missing", "attribute": "name", "pattern": ".*"}); !diags.HasError() {
+		t.Errorf("expected an error for an unknown resource type")
+	}
+	if _, diags = buildRules(map[string]interface{}{"resource_type": "genesyscloud_routing_queue", "attribute": "names", "pattern": ".*"}); !diags.HasError() {
+		t.Errorf("expected an error for an unknown attribute")
+	}
+}
+
+func TestUnitPolicyCustomizeDiff(t *testing.T) {
+	resource := withPolicy("genesyscloud_routing_queue", testPolicyResource())
+	meta := &ProviderMeta{Policy: &Policy{Rules: []PolicyRule{
+		{Attribute: "name", Pattern: regexp.MustCompile(`^(SALES|SUPPORT)_`), Severity: policySeverityError, Message: "queue names start with the business unit"},
+		{ResourceType: "genesyscloud_user", Attribute: "name", Pattern: regexp.MustCompile(`^$`), Severity: policySeverityError},
+	}}}
+	plan := func(state *terraform.InstanceState, config map[string]interface{}) error {
+		_, err := resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+		return err
+	}
+
+	err := plan(&terraform.InstanceState{}, map[string]interface{}{"name": "Sales queue"})
+	expected := `genesyscloud_routing_queue name "Sales queue" does not match the policy pattern "^(SALES|SUPPORT)_": queue names start with the business unit`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+
+	if err = plan(&terraform.InstanceState{}, map[string]interface{}{"name": "SALES_queue"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Existing resources are only checked when the attribute changes
+	existing := &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{"id": "queue-1", "name": "Legacy queue"}}
+	if err = plan(existing, map[string]interface{}{"name": "Legacy queue", "description": "updated"}); err != nil {
+		t.Errorf("unexpected error for an unchanged name: %v", err)
+	}
+	if err = plan(existing, map[string]interface{}{"name": "Renamed queue"}); err == nil {
+		t.Errorf("expected an error for a changed name")
+	}
+}
+
+func TestUnitPolicyWarnings(t *testing.T) {
+	resource := withPolicy("genesyscloud_routing_queue", testPolicyResource())
+	meta := &ProviderMeta{Policy: &Policy{Rules: []PolicyRule{
+		{Attribute: "description", Pattern: regexp.MustCompile(`.+`), Severity: policySeverityWarning, Message: "describe the queue"},
+	}}}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": "SALES_queue"})
+	d.MarkNewResource()
+	diags := resource.CreateContext(context.Background(), d, meta)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected one warning, got %v", diags)
+	}
+	if d.Id() != "queue-1" {
+		t.Errorf("expected the create method to run")
+	}
+
+	// The plan is not affected by warnings
+	if _, err := resource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "SALES_queue"}), meta); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
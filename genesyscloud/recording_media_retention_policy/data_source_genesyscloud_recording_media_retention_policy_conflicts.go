@@ -0,0 +1,47 @@
+package recording_media_retention_policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_recording_media_retention_policy_conflicts.go contains the data source implementation
+   for the media retention policy conflicts data source.
+*/
+
+// dataSourceRecordingMediaRetentionPolicyConflictsRead analyzes the planned media retention policies, together with the policies
+// of the organization, for overlapping conditions and conflicting actions
+func dataSourceRecordingMediaRetentionPolicyConflictsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	pp := getPolicyProxy(sdkConfig)
+
+	policies, err := buildPlannedPolicies(ctx, d.Get("policies").([]interface{}), pp)
+	if err != nil {
+		return util.BuildDiagnosticError(conflictsDataSourceName, "Failed to build the planned media retention policies", err)
+	}
+
+	if d.Get("include_organization_policies").(bool) {
+		organizationPolicies, resp, err := pp.getAllPolicies(ctx)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(conflictsDataSourceName, fmt.Sprintf("Failed to get media retention policies | error: %s", err), resp)
+		}
+		policies = mergePolicies(*organizationPolicies, policies)
+	}
+
+	overlaps := findPolicyOverlaps(policies)
+	conflicts := flattenPolicyConflicts(overlaps)
+	log.Printf("Analyzed %d media retention policies, found %d overlapping pairs and %d conflicts", len(policies), len(overlaps), len(conflicts))
+
+	d.SetId(conflictsDataSourceName)
+	_ = d.Set("overlapping_policies", flattenPolicyOverlaps(overlaps))
+	_ = d.Set("conflicts", conflicts)
+	_ = d.Set("has_conflicts", len(conflicts) > 0)
+	return nil
+}
@@ -0,0 +1,124 @@
+package recording_media_retention_policy
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceRecordingMediaRetentionPolicyConflicts(t *testing.T) {
+	var (
+		policyResource    = "existing-policy"
+		conflictsResource = "conflicts"
+		policyName        = "terraform-policy-" + uuid.NewString()
+		plannedPolicyName = "terraform-planned-policy-" + uuid.NewString()
+		fullConflictsName = "data." + conflictsDataSourceName + "." + conflictsResource
+	)
+
+	policy := fmt.Sprintf(`resource "%s" "%s" {
+		name        = "%s"
+		order       = 0
+		description = "a media retention policy for chats in a date range"
+		enabled     = true
+		media_policies {
+			chat_policy {
+				actions {
+					retain_recording = true
+					delete_recording = false
+					always_delete    = false
+					retention_duration {
+						delete_retention {
+							days = 3
+						}
+					}
+				}
+				conditions {
+					date_ranges = ["2024-05-01T00:00:00.000Z/2024-06-01T00:00:00.000Z"]
+				}
+			}
+		}
+	}
+	`, resourceName, policyResource, policyName)
+
+	conflicts := func(dateRange string) string {
+		return fmt.Sprintf(`data "%s" "%s" {
+		policies {
+			name    = "%s"
+			order   = 1
+			enabled = true
+			media_policies {
+				chat_policy {
+					actions {
+						retain_recording = true
+						retention_duration {
+							delete_retention {
+								days = 10
+							}
+						}
+					}
+					conditions {
+						date_ranges = ["%s"]
+					}
+				}
+			}
+		}
+		depends_on = [%s.%s]
+	}
+	`, conflictsDataSourceName, conflictsResource, plannedPolicyName, dateRange, resourceName, policyResource)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The date ranges of the policies overlap and they delete chats after a different number of days
+				Config: policy + conflicts("2024-05-15T00:00:00.000Z/2024-07-01T00:00:00.000Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullConflictsName, "has_conflicts", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullConflictsName, "conflicts.*", map[string]string{
+						"media_type":    mediaTypeChat,
+						"policy_a_name": policyName,
+						"policy_b_name": plannedPolicyName,
+						"conflict_type": conflictTypeRetention,
+						"description":   fmt.Sprintf("policy %s deletes the recording after 3 days and policy %s after 10 days", policyName, plannedPolicyName),
+					}),
+				),
+			},
+			{
+				// The date ranges of the policies do not overlap
+				Config: policy + conflicts("2024-06-01T00:00:00.000Z/2024-07-01T00:00:00.000Z"),
+				Check:  testCheckPoliciesDoNotOverlap(fullConflictsName, policyName, plannedPolicyName),
+			},
+		},
+		CheckDestroy: testVerifyMediaRetentionPolicyDestroyed,
+	})
+}
+
+// testCheckPoliciesDoNotOverlap verifies the data source does not pair the policies. Other policies of the organization may
+// still overlap with either policy.
+func testCheckPoliciesDoNotOverlap(dataSourceName string, policyA string, policyB string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		dataSource, ok := state.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("failed to find data source %s in state", dataSourceName)
+		}
+		attributes := dataSource.Primary.Attributes
+
+		count, _ := strconv.Atoi(attributes["overlapping_policies.#"])
+		for i := 0; i < count; i++ {
+			nameA := attributes[fmt.Sprintf("overlapping_policies.%d.policy_a_name", i)]
+			nameB := attributes[fmt.Sprintf("overlapping_policies.%d.policy_b_name", i)]
+			if (nameA == policyA && nameB == policyB) || (nameA == policyB && nameB == policyA) {
+				return fmt.Errorf("expected policies %s and %s not to overlap", policyA, policyB)
+			}
+		}
+		return nil
+	}
+}
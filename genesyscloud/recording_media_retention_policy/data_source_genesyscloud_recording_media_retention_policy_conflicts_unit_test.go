@@ -0,0 +1,224 @@
+package recording_media_retention_policy
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitConditionsCanOverlap(t *testing.T) {
+	assert.True(t, conditionsCanOverlap(policyConditions{}, policyConditions{queueIds: []string{"queue-1"}}))
+	assert.True(t, conditionsCanOverlap(policyConditions{queueIds: []string{"queue-1", "queue-2"}}, policyConditions{queueIds: []string{"queue-2"}}))
+	assert.False(t, conditionsCanOverlap(policyConditions{queueIds: []string{"queue-1"}}, policyConditions{queueIds: []string{"queue-2"}}))
+	assert.False(t, conditionsCanOverlap(policyConditions{directions: []string{"INBOUND"}}, policyConditions{directions: []string{"OUTBOUND"}}))
+
+	assert.False(t, dateRangesCanOverlap(
+		[]string{"2024-01-01T00:00:00.000Z/2024-02-01T00:00:00.000Z"},
+		[]string{"2024-02-01T00:00:00.000Z/2024-03-01T00:00:00.000Z"}))
+	assert.True(t, dateRangesCanOverlap(
+		[]string{"2024-01-01T00:00:00.000Z/2024-02-15T00:00:00.000Z"},
+		[]string{"2024-02-01T00:00:00.000Z/2024-03-01T00:00:00.000Z"}))
+	assert.True(t, dateRangesCanOverlap([]string{"not a date range"}, []string{"2024-02-01T00:00:00.000Z/2024-03-01T00:00:00.000Z"}))
+
+	mornings := &platformclientv2.Timeallowed{
+		TimeZoneId: platformclientv2.String("Europe/Paris"),
+		TimeSlots:  &[]platformclientv2.Timeslot{{Day: platformclientv2.Int(1), StartTime: platformclientv2.String("08:00"), StopTime: platformclientv2.String("12:00")}},
+	}
+	afternoons := &platformclientv2.Timeallowed{
+		TimeZoneId: platformclientv2.String("Europe/Paris"),
+		TimeSlots:  &[]platformclientv2.Timeslot{{StartTime: platformclientv2.String("12:00"), StopTime: platformclientv2.String("18:00")}},
+	}
+	assert.False(t, timeAllowedCanOverlap(mornings, afternoons))
+	afternoons.TimeZoneId = platformclientv2.String("America/New_York")
+	assert.True(t, timeAllowedCanOverlap(mornings, afternoons))
+
+	under := &platformclientv2.Durationcondition{DurationMode: platformclientv2.String("Under"), DurationRange: platformclientv2.String("PT1M")}
+	over := &platformclientv2.Durationcondition{DurationMode: platformclientv2.String("Over"), DurationRange: platformclientv2.String("PT1M")}
+	between := &platformclientv2.Durationcondition{DurationMode: platformclientv2.String("Between"), DurationRange: platformclientv2.String("PT30S/PT5M")}
+	assert.False(t, durationsCanOverlap(under, over))
+	assert.True(t, durationsCanOverlap(under, between))
+	assert.True(t, durationsCanOverlap(over, between))
+}
+
+func TestUnitFindPolicyOverlaps(t *testing.T) {
+	callPolicy := func(id string, order int, queueIds []string, actions *platformclientv2.Policyactions) platformclientv2.Policy {
+		queues := make([]platformclientv2.Queue, 0, len(queueIds))
+		for _, queueId := range queueIds {
+			queues = append(queues, platformclientv2.Queue{Id: platformclientv2.String(queueId)})
+		}
+		return platformclientv2.Policy{
+			Id:      platformclientv2.String(id),
+			Name:    platformclientv2.String(id),
+			Order:   platformclientv2.Int(order),
+			Enabled: platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{CallPolicy: &platformclientv2.Callmediapolicy{
+				Conditions: &platformclientv2.Callmediapolicyconditions{ForQueues: &queues},
+				Actions:    actions,
+			}},
+		}
+	}
+	deleteAfter := func(days int) *platformclientv2.Policyactions {
+		return &platformclientv2.Policyactions{
+			RetainRecording:   platformclientv2.Bool(true),
+			RetentionDuration: &platformclientv2.Retentionduration{DeleteRetention: &platformclientv2.Deleteretention{Days: platformclientv2.Int(days)}},
+		}
+	}
+	evaluate := func(formId string) *platformclientv2.Policyactions {
+		return &platformclientv2.Policyactions{
+			AssignEvaluations: &[]platformclientv2.Evaluationassignment{{EvaluationForm: &platformclientv2.Evaluationform{Id: platformclientv2.String(formId)}}},
+			InitiateScreenRecording: &platformclientv2.Initiatescreenrecording{
+				RecordACW: platformclientv2.Bool(formId == "form-1"),
+			},
+		}
+	}
+
+	disabled := callPolicy("disabled", 0, nil, &platformclientv2.Policyactions{AlwaysDelete: platformclientv2.Bool(true)})
+	disabled.Enabled = platformclientv2.Bool(false)
+	policies := []platformclientv2.Policy{
+		callPolicy("sales-short", 2, []string{"sales"}, deleteAfter(30)),
+		callPolicy("sales-long", 1, []string{"sales", "support"}, deleteAfter(90)),
+		callPolicy("billing", 3, []string{"billing"}, deleteAfter(10)),
+		callPolicy("sales-eval-1", 4, []string{"sales"}, evaluate("form-1")),
+		callPolicy("sales-eval-2", 5, nil, evaluate("form-2")),
+		disabled,
+	}
+
+	overlaps := findPolicyOverlaps(policies)
+	pairs := make([]string, 0, len(overlaps))
+	for _, overlap := range overlaps {
+		assert.Equal(t, mediaTypeCall, overlap.mediaType)
+		pairs = append(pairs, *overlap.policyA.Name+"/"+*overlap.policyB.Name)
+	}
+	assert.Equal(t, []string{
+		"sales-long/sales-short",
+		"sales-long/sales-eval-1",
+		"sales-long/sales-eval-2",
+		"sales-short/sales-eval-1",
+		"sales-short/sales-eval-2",
+		"billing/sales-eval-2",
+		"sales-eval-1/sales-eval-2",
+	}, pairs)
+
+	conflicts := flattenPolicyConflicts(overlaps)
+	assert.Len(t, conflicts, 3)
+	assert.Equal(t, map[string]interface{}{
+		"media_type":    mediaTypeCall,
+		"policy_a_id":   "sales-long",
+		"policy_a_name": "sales-long",
+		"policy_b_id":   "sales-short",
+		"policy_b_name": "sales-short",
+		"conflict_type": conflictTypeRetention,
+		"description":   "policy sales-long deletes the recording after 90 days and policy sales-short after 30 days",
+	}, conflicts[0])
+	assert.Equal(t, conflictTypeEvaluation, conflicts[1].(map[string]interface{})["conflict_type"])
+	assert.Equal(t, "policy sales-eval-1 assigns evaluations with forms form-1 and policy sales-eval-2 with forms form-2", conflicts[1].(map[string]interface{})["description"])
+	assert.Equal(t, conflictTypeScreenRecording, conflicts[2].(map[string]interface{})["conflict_type"])
+	assert.Equal(t, "policies sales-eval-1 and sales-eval-2 initiate screen recordings with a different record_acw", conflicts[2].(map[string]interface{})["description"])
+}
+
+func TestUnitFindActionConflictsRetainAndDelete(t *testing.T) {
+	retain := &platformclientv2.Policyactions{
+		RetainRecording: platformclientv2.Bool(true),
+		RetentionDuration: &platformclientv2.Retentionduration{
+			ArchiveRetention: &platformclientv2.Archiveretention{Days: platformclientv2.Int(30), StorageMedium: platformclientv2.String("CLOUDARCHIVE")},
+		},
+	}
+	remove := &platformclientv2.Policyactions{
+		DeleteRecording: platformclientv2.Bool(true),
+		RetentionDuration: &platformclientv2.Retentionduration{
+			ArchiveRetention: &platformclientv2.Archiveretention{Days: platformclientv2.Int(60), StorageMedium: platformclientv2.String("CLOUDARCHIVE")},
+		},
+	}
+
+	conflicts := findActionConflicts("retain", retain, "remove", remove)
+	assert.Equal(t, []policyConflict{
+		{conflictType: conflictTypeRetention, description: "policy retain retains the recording and policy remove deletes it"},
+		{conflictType: conflictTypeRetention, description: "policy retain archives the recording to CLOUDARCHIVE after 30 days and policy remove archives it to CLOUDARCHIVE after 60 days"},
+	}, conflicts)
+	assert.Empty(t, findActionConflicts("retain", retain, "retain", retain))
+	assert.Empty(t, findActionConflicts("retain", retain, "none", nil))
+}
+
+func TestUnitMergePolicies(t *testing.T) {
+	organizationPolicies := []platformclientv2.Policy{
+		{Id: platformclientv2.String("policy-1"), Name: platformclientv2.String("Sales"), Order: platformclientv2.Int(1)},
+		{Id: platformclientv2.String("policy-2"), Name: platformclientv2.String("Support"), Order: platformclientv2.Int(2)},
+	}
+	plannedPolicies := []platformclientv2.Policy{
+		{Name: platformclientv2.String("Sales"), Order: platformclientv2.Int(5)},
+		{Name: platformclientv2.String("Billing"), Order: platformclientv2.Int(3)},
+	}
+
+	policies := mergePolicies(organizationPolicies, plannedPolicies)
+	assert.Len(t, policies, 3)
+	assert.Equal(t, "policy-1", util.StringValue(policies[0].Id))
+	assert.Equal(t, 5, *policies[0].Order)
+	assert.Nil(t, policies[1].Id)
+	assert.Equal(t, "policy-2", util.StringValue(policies[2].Id))
+}
+
+func TestUnitDataSourceRecordingMediaRetentionPolicyConflictsRead(t *testing.T) {
+	internalProxy = &policyProxy{}
+	defer func() { internalProxy = nil }()
+	internalProxy.getAllPoliciesAttr = func(ctx context.Context, p *policyProxy) (*[]platformclientv2.Policy, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Policy{{
+			Id:      platformclientv2.String("policy-1"),
+			Name:    platformclientv2.String("All chats"),
+			Order:   platformclientv2.Int(1),
+			Enabled: platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{ChatPolicy: &platformclientv2.Chatmediapolicy{
+				Actions: &platformclientv2.Policyactions{AlwaysDelete: platformclientv2.Bool(true)},
+			}},
+		}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	plannedPolicies := []interface{}{
+		map[string]interface{}{
+			"name":    "Support chats",
+			"order":   2,
+			"enabled": true,
+			"media_policies": []interface{}{map[string]interface{}{
+				"chat_policy": []interface{}{map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{
+						"for_queue_ids": []interface{}{"queue-1"},
+					}},
+					"actions": []interface{}{map[string]interface{}{
+						"retain_recording": true,
+						"retention_duration": []interface{}{map[string]interface{}{
+							"delete_retention": []interface{}{map[string]interface{}{"days": 30}},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceRecordingMediaRetentionPolicyConflicts().Schema, map[string]interface{}{
+		"policies": plannedPolicies,
+	})
+	diagErr := dataSourceRecordingMediaRetentionPolicyConflictsRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, conflictsDataSourceName, d.Id())
+	assert.Equal(t, 1, d.Get("overlapping_policies.#"))
+	assert.Equal(t, mediaTypeChat, d.Get("overlapping_policies.0.media_type"))
+	assert.Equal(t, "policy-1", d.Get("overlapping_policies.0.policy_a_id"))
+	assert.Equal(t, "", d.Get("overlapping_policies.0.policy_b_id"))
+	assert.Equal(t, true, d.Get("has_conflicts"))
+	assert.Equal(t, "policy All chats deletes the recording and policy Support chats retains it", d.Get("conflicts.0.description"))
+
+	// The planned policy alone has no overlaps
+	d = schema.TestResourceDataRaw(t, DataSourceRecordingMediaRetentionPolicyConflicts().Schema, map[string]interface{}{
+		"policies":                      plannedPolicies,
+		"include_organization_policies": false,
+	})
+	diagErr = dataSourceRecordingMediaRetentionPolicyConflictsRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 0, d.Get("overlapping_policies.#"))
+	assert.Equal(t, false, d.Get("has_conflicts"))
+}
@@ -0,0 +1,371 @@
+package recording_media_retention_policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_recording_media_retention_policy_conflicts_utils.go file pairs media retention policies whose
+   conditions can match the same interaction and reports the conflicting actions of each pair, for the media retention policy
+   conflicts data source.
+*/
+
+const (
+	conflictTypeRetention       = "retention"
+	conflictTypeEvaluation      = "evaluation"
+	conflictTypeScreenRecording = "screen_recording"
+)
+
+// policyOverlap is a pair of policies whose conditions can match the same interaction of a media type
+type policyOverlap struct {
+	mediaType string
+	policyA   platformclientv2.Policy
+	policyB   platformclientv2.Policy
+	conflicts []policyConflict
+}
+
+// policyConflict describes actions of two overlapping policies that conflict with each other
+type policyConflict struct {
+	conflictType string
+	description  string
+}
+
+// buildPlannedPolicies builds the policies described in the configuration of the data source with the build functions of
+// the media retention policy resource
+func buildPlannedPolicies(ctx context.Context, plannedPolicies []interface{}, pp *policyProxy) ([]platformclientv2.Policy, error) {
+	policyResource := &schema.Resource{Schema: ResourceMediaRetentionPolicy().Schema}
+	policies := make([]platformclientv2.Policy, 0, len(plannedPolicies))
+
+	for _, plannedPolicy := range plannedPolicies {
+		plannedPolicyMap, ok := plannedPolicy.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := plannedPolicyMap["name"].(string)
+		order := plannedPolicyMap["order"].(int)
+		enabled := plannedPolicyMap["enabled"].(bool)
+
+		policyData := policyResource.Data(nil)
+		for _, attribute := range []string{"media_policies", "conditions", "actions"} {
+			if err := policyData.Set(attribute, plannedPolicyMap[attribute]); err != nil {
+				return nil, fmt.Errorf("failed to read %s of policy %s: %w", attribute, name, err)
+			}
+		}
+
+		err, mediaPolicies := buildMediaPolicies(policyData, pp, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the media policies of policy %s: %w", name, err)
+		}
+		err, actions := buildPolicyActionsFromResource(policyData, pp, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the actions of policy %s: %w", name, err)
+		}
+
+		policies = append(policies, platformclientv2.Policy{
+			Name:          &name,
+			Order:         &order,
+			Enabled:       &enabled,
+			MediaPolicies: mediaPolicies,
+			Conditions:    buildConditions(policyData),
+			Actions:       actions,
+		})
+	}
+	return policies, nil
+}
+
+// mergePolicies combines the policies of the organization with the planned policies. A planned policy replaces the policy
+// of the organization with the same name, and keeps its ID.
+func mergePolicies(organizationPolicies []platformclientv2.Policy, plannedPolicies []platformclientv2.Policy) []platformclientv2.Policy {
+	organizationPolicyIds := make(map[string]*string, len(organizationPolicies))
+	for _, policy := range organizationPolicies {
+		organizationPolicyIds[util.StringValue(policy.Name)] = policy.Id
+	}
+
+	plannedPolicyNames := make(map[string]bool, len(plannedPolicies))
+	policies := make([]platformclientv2.Policy, 0, len(organizationPolicies)+len(plannedPolicies))
+	for _, policy := range plannedPolicies {
+		policy.Id = organizationPolicyIds[util.StringValue(policy.Name)]
+		plannedPolicyNames[util.StringValue(policy.Name)] = true
+		policies = append(policies, policy)
+	}
+	for _, policy := range organizationPolicies {
+		if !plannedPolicyNames[util.StringValue(policy.Name)] {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+// findPolicyOverlaps pairs the enabled policies whose conditions can match the same interaction, per media type. Conditions that
+// cannot be compared, such as time slots in different time zones, are treated as overlapping.
+func findPolicyOverlaps(policies []platformclientv2.Policy) []policyOverlap {
+	policies = sortPoliciesByOrder(policies)
+	overlaps := make([]policyOverlap, 0)
+
+	for _, mediaType := range []string{mediaTypeCall, mediaTypeChat, mediaTypeEmail, mediaTypeMessage} {
+		for i, policyA := range policies {
+			if !policyEnabled(policyA) {
+				continue
+			}
+			conditionsA, actionsA, ok := mediaPolicyFor(policyA, mediaType)
+			if !ok {
+				continue
+			}
+			for _, policyB := range policies[i+1:] {
+				if !policyEnabled(policyB) {
+					continue
+				}
+				conditionsB, actionsB, ok := mediaPolicyFor(policyB, mediaType)
+				if !ok || !conditionsCanOverlap(conditionsA, conditionsB) {
+					continue
+				}
+				overlaps = append(overlaps, policyOverlap{
+					mediaType: mediaType,
+					policyA:   policyA,
+					policyB:   policyB,
+					conflicts: findActionConflicts(util.StringValue(policyA.Name), actionsA, util.StringValue(policyB.Name), actionsB),
+				})
+			}
+		}
+	}
+	return overlaps
+}
+
+// conditionsCanOverlap returns false only if a condition of one policy excludes every interaction the same condition of the
+// other policy applies to
+func conditionsCanOverlap(a policyConditions, b policyConditions) bool {
+	return listsCanOverlap(a.queueIds, b.queueIds) &&
+		listsCanOverlap(a.userIds, b.userIds) &&
+		listsCanOverlap(a.wrapupCodeIds, b.wrapupCodeIds) &&
+		listsCanOverlap(a.languageIds, b.languageIds) &&
+		listsCanOverlap(a.teamIds, b.teamIds) &&
+		listsCanOverlap(a.directions, b.directions) &&
+		dateRangesCanOverlap(a.dateRanges, b.dateRanges) &&
+		timeAllowedCanOverlap(a.timeAllowed, b.timeAllowed) &&
+		durationsCanOverlap(a.duration, b.duration)
+}
+
+// listsCanOverlap returns true if either list is empty, as an empty list applies to every value, or if the lists share a value
+func listsCanOverlap(a []string, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, valueA := range a {
+		for _, valueB := range b {
+			if strings.EqualFold(valueA, valueB) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func dateRangesCanOverlap(a []string, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, dateRangeA := range a {
+		startA, endA, err := parseDateRange(dateRangeA)
+		if err != nil {
+			return true
+		}
+		for _, dateRangeB := range b {
+			startB, endB, err := parseDateRange(dateRangeB)
+			if err != nil {
+				return true
+			}
+			if startA.Before(endB) && startB.Before(endA) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// timeAllowedCanOverlap compares the time slots of two policies. Time slots in different time zones are not compared.
+func timeAllowedCanOverlap(a *platformclientv2.Timeallowed, b *platformclientv2.Timeallowed) bool {
+	if !timeAllowedActive(a) || !timeAllowedActive(b) || timeZoneId(a) != timeZoneId(b) {
+		return true
+	}
+	for _, slotA := range *a.TimeSlots {
+		startA, startErr := parseTimeOfDay(util.StringValue(slotA.StartTime), 0)
+		stopA, stopErr := parseTimeOfDay(util.StringValue(slotA.StopTime), 24*3600)
+		if startErr != nil || stopErr != nil {
+			return true
+		}
+		for _, slotB := range *b.TimeSlots {
+			if dayA, dayB := intValue(slotA.Day), intValue(slotB.Day); dayA != 0 && dayB != 0 && dayA != dayB {
+				continue
+			}
+			startB, startErr := parseTimeOfDay(util.StringValue(slotB.StartTime), 0)
+			stopB, stopErr := parseTimeOfDay(util.StringValue(slotB.StopTime), 24*3600)
+			if startErr != nil || stopErr != nil {
+				return true
+			}
+			if startA < stopB && startB < stopA {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func durationsCanOverlap(a *platformclientv2.Durationcondition, b *platformclientv2.Durationcondition) bool {
+	if a == nil || b == nil || util.StringValue(a.DurationRange) == "" || util.StringValue(b.DurationRange) == "" {
+		return true
+	}
+	boundsA, errA := parseDurationBounds(a)
+	boundsB, errB := parseDurationBounds(b)
+	if errA != nil || errB != nil {
+		return true
+	}
+	return boundsA.overlaps(boundsB)
+}
+
+// findActionConflicts compares the retention, evaluation assignment and screen recording actions of two overlapping policies
+func findActionConflicts(nameA string, a *platformclientv2.Policyactions, nameB string, b *platformclientv2.Policyactions) []policyConflict {
+	conflicts := make([]policyConflict, 0)
+	if a == nil || b == nil {
+		return conflicts
+	}
+	addConflict := func(conflictType string, format string, args ...interface{}) {
+		conflicts = append(conflicts, policyConflict{conflictType: conflictType, description: fmt.Sprintf(format, args...)})
+	}
+
+	if retainsRecording(a) && deletesRecording(b) {
+		addConflict(conflictTypeRetention, "policy %s retains the recording and policy %s deletes it", nameA, nameB)
+	}
+	if deletesRecording(a) && retainsRecording(b) {
+		addConflict(conflictTypeRetention, "policy %s deletes the recording and policy %s retains it", nameA, nameB)
+	}
+	archiveA, deleteA := retentionDuration(a.RetentionDuration)
+	archiveB, deleteB := retentionDuration(b.RetentionDuration)
+	if archiveRetentionDiffers(archiveA, archiveB) {
+		addConflict(conflictTypeRetention, "policy %s archives the recording %s and policy %s archives it %s",
+			nameA, describeArchiveRetention(archiveA), nameB, describeArchiveRetention(archiveB))
+	}
+	if deleteRetentionDiffers(deleteA, deleteB) {
+		addConflict(conflictTypeRetention, "policy %s deletes the recording after %d days and policy %s after %d days",
+			nameA, intValue(deleteA.Days), nameB, intValue(deleteB.Days))
+	}
+
+	if formsA, formsB := policyEvaluationFormIds(a), policyEvaluationFormIds(b); len(formsA) > 0 && len(formsB) > 0 && !sameValues(formsA, formsB) {
+		addConflict(conflictTypeEvaluation, "policy %s assigns evaluations with forms %s and policy %s with forms %s",
+			nameA, strings.Join(formsA, ", "), nameB, strings.Join(formsB, ", "))
+	}
+	if formsA, formsB := policyCalibrationFormIds(a), policyCalibrationFormIds(b); len(formsA) > 0 && len(formsB) > 0 && !sameValues(formsA, formsB) {
+		addConflict(conflictTypeEvaluation, "policy %s assigns calibrations with forms %s and policy %s with forms %s",
+			nameA, strings.Join(formsA, ", "), nameB, strings.Join(formsB, ", "))
+	}
+
+	if recordingA, recordingB := a.InitiateScreenRecording, b.InitiateScreenRecording; recordingA != nil && recordingB != nil {
+		var settings []string
+		if boolValue(recordingA.RecordACW) != boolValue(recordingB.RecordACW) {
+			settings = append(settings, "record_acw")
+		}
+		if archiveRetentionDiffers(recordingA.ArchiveRetention, recordingB.ArchiveRetention) {
+			settings = append(settings, "archive_retention")
+		}
+		if deleteRetentionDiffers(recordingA.DeleteRetention, recordingB.DeleteRetention) {
+			settings = append(settings, "delete_retention")
+		}
+		if len(settings) > 0 {
+			addConflict(conflictTypeScreenRecording, "policies %s and %s initiate screen recordings with a different %s",
+				nameA, nameB, strings.Join(settings, ", "))
+		}
+	}
+	return conflicts
+}
+
+func retainsRecording(actions *platformclientv2.Policyactions) bool {
+	return boolValue(actions.RetainRecording)
+}
+
+func deletesRecording(actions *platformclientv2.Policyactions) bool {
+	return boolValue(actions.DeleteRecording) || boolValue(actions.AlwaysDelete)
+}
+
+func retentionDuration(retention *platformclientv2.Retentionduration) (*platformclientv2.Archiveretention, *platformclientv2.Deleteretention) {
+	if retention == nil {
+		return nil, nil
+	}
+	return retention.ArchiveRetention, retention.DeleteRetention
+}
+
+// archiveRetentionDiffers returns true if both policies archive the recording, after a different number of days or to a
+// different storage medium
+func archiveRetentionDiffers(a *platformclientv2.Archiveretention, b *platformclientv2.Archiveretention) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return intValue(a.Days) != intValue(b.Days) || !strings.EqualFold(util.StringValue(a.StorageMedium), util.StringValue(b.StorageMedium))
+}
+
+// deleteRetentionDiffers returns true if both policies delete the recording after a different number of days
+func deleteRetentionDiffers(a *platformclientv2.Deleteretention, b *platformclientv2.Deleteretention) bool {
+	return a != nil && b != nil && intValue(a.Days) != intValue(b.Days)
+}
+
+func describeArchiveRetention(archive *platformclientv2.Archiveretention) string {
+	if storageMedium := util.StringValue(archive.StorageMedium); storageMedium != "" {
+		return fmt.Sprintf("to %s after %d days", storageMedium, intValue(archive.Days))
+	}
+	return fmt.Sprintf("after %d days", intValue(archive.Days))
+}
+
+// sameValues returns true if both lists hold the same values, in any order
+func sameValues(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenPolicyOverlaps(overlaps []policyOverlap) []interface{} {
+	flattened := make([]interface{}, 0, len(overlaps))
+	for _, overlap := range overlaps {
+		flattened = append(flattened, map[string]interface{}{
+			"media_type":    overlap.mediaType,
+			"policy_a_id":   util.StringValue(overlap.policyA.Id),
+			"policy_a_name": util.StringValue(overlap.policyA.Name),
+			"policy_b_id":   util.StringValue(overlap.policyB.Id),
+			"policy_b_name": util.StringValue(overlap.policyB.Name),
+			"conflicting":   len(overlap.conflicts) > 0,
+		})
+	}
+	return flattened
+}
+
+func flattenPolicyConflicts(overlaps []policyOverlap) []interface{} {
+	flattened := make([]interface{}, 0)
+	for _, overlap := range overlaps {
+		for _, conflict := range overlap.conflicts {
+			flattened = append(flattened, map[string]interface{}{
+				"media_type":    overlap.mediaType,
+				"policy_a_id":   util.StringValue(overlap.policyA.Id),
+				"policy_a_name": util.StringValue(overlap.policyA.Name),
+				"policy_b_id":   util.StringValue(overlap.policyB.Id),
+				"policy_b_name": util.StringValue(overlap.policyB.Name),
+				"conflict_type": conflict.conflictType,
+				"description":   conflict.description,
+			})
+		}
+	}
+	return flattened
+}
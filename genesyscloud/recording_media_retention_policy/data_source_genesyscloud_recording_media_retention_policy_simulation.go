@@ -0,0 +1,76 @@
+package recording_media_retention_policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_recording_media_retention_policy_simulation.go contains the data source implementation
+   for the media retention policy simulation data source.
+*/
+
+// dataSourceRecordingMediaRetentionPolicySimulationRead evaluates the media retention policies of the organization against the interaction
+func dataSourceRecordingMediaRetentionPolicySimulationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	pp := getPolicyProxy(sdkConfig)
+
+	interaction, err := buildSimulatedInteraction(d)
+	if err != nil {
+		return util.BuildDiagnosticError(simulationDataSourceName, "Failed to read the interaction", err)
+	}
+
+	policies, resp, err := pp.getAllPolicies(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(simulationDataSourceName, fmt.Sprintf("Failed to get media retention policies | error: %s", err), resp)
+	}
+
+	simulations := simulatePolicies(*policies, interaction)
+	matched := 0
+	for _, simulation := range simulations {
+		if simulation.matched {
+			matched++
+		}
+	}
+	log.Printf("Simulated %d media retention policies for a %s interaction at %s, %d matched", len(simulations), interaction.mediaType, interaction.timestamp.Format(time.RFC3339), matched)
+
+	d.SetId(fmt.Sprintf("%s|%s", interaction.mediaType, interaction.timestamp.Format(time.RFC3339)))
+	_ = d.Set("policies", flattenPolicySimulations(simulations))
+	_ = d.Set("matched_policies", flattenMatchedPolicyActions(simulations))
+	return nil
+}
+
+func buildSimulatedInteraction(d *schema.ResourceData) (simulatedInteraction, error) {
+	interaction := simulatedInteraction{
+		mediaType:    d.Get("media_type").(string),
+		queueId:      d.Get("queue_id").(string),
+		userId:       d.Get("user_id").(string),
+		wrapupCodeId: d.Get("wrapup_code_id").(string),
+		languageId:   d.Get("language_id").(string),
+		teamId:       d.Get("team_id").(string),
+		direction:    d.Get("direction").(string),
+		timestamp:    time.Now().UTC(),
+	}
+
+	// A duration of 0 seconds is a valid duration. More info about using deprecated GetOkExists: https://github.com/hashicorp/terraform-plugin-sdk/issues/817
+	if durationSeconds, ok := d.GetOkExists("duration_seconds"); ok {
+		duration := time.Duration(durationSeconds.(int)) * time.Second
+		interaction.duration = &duration
+	}
+
+	if timestamp := d.Get("timestamp").(string); timestamp != "" {
+		parsed, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return interaction, fmt.Errorf("invalid timestamp %s: %w", timestamp, err)
+		}
+		interaction.timestamp = parsed
+	}
+	return interaction, nil
+}
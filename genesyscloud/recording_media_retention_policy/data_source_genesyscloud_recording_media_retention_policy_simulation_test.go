@@ -0,0 +1,88 @@
+package recording_media_retention_policy
+
+import (
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRecordingMediaRetentionPolicySimulation(t *testing.T) {
+	var (
+		policyResource     = "simulated-policy"
+		simulationResource = "simulation"
+		policyName         = "terraform-policy-" + uuid.NewString()
+		fullSimulationName = "data." + simulationDataSourceName + "." + simulationResource
+	)
+
+	policy := fmt.Sprintf(`resource "%s" "%s" {
+		name        = "%s"
+		order       = 0
+		description = "a media retention policy for chats in a date range"
+		enabled     = true
+		media_policies {
+			chat_policy {
+				actions {
+					retain_recording = true
+					delete_recording = false
+					always_delete    = false
+					retention_duration {
+						delete_retention {
+							days = 3
+						}
+					}
+				}
+				conditions {
+					date_ranges = ["2024-05-01T00:00:00.000Z/2024-06-01T00:00:00.000Z"]
+				}
+			}
+		}
+	}
+	`, resourceName, policyResource, policyName)
+
+	simulation := func(timestamp string) string {
+		return fmt.Sprintf(`data "%s" "%s" {
+		media_type = "chat"
+		timestamp  = "%s"
+		depends_on = [%s.%s]
+	}
+	`, simulationDataSourceName, simulationResource, timestamp, resourceName, policyResource)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The chat is inside the date range of the policy
+				Config: policy + simulation("2024-05-15T10:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(fullSimulationName, "policies.*", map[string]string{
+						"name":    policyName,
+						"matched": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(fullSimulationName, "matched_policies.*", map[string]string{
+						"name":                  policyName,
+						"retain_recording":      "true",
+						"delete_retention_days": "3",
+					}),
+				),
+			},
+			{
+				// The chat is outside the date range of the policy
+				Config: policy + simulation("2024-07-15T10:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(fullSimulationName, "policies.*", map[string]string{
+						"name":      policyName,
+						"matched":   "false",
+						"reasons.0": "timestamp 2024-07-15T10:00:00Z is outside the date ranges of the policy",
+					}),
+				),
+			},
+		},
+		CheckDestroy: testVerifyMediaRetentionPolicyDestroyed,
+	})
+}
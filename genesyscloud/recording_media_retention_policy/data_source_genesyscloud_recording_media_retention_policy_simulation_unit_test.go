@@ -0,0 +1,200 @@
+package recording_media_retention_policy
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEvaluatePolicyConditions(t *testing.T) {
+	// Wednesday 1 May 2024, 14:30 in Paris
+	timestamp := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	minute := time.Minute
+	interaction := simulatedInteraction{
+		mediaType: mediaTypeCall,
+		queueId:   "queue-1",
+		direction: "INBOUND",
+		duration:  &minute,
+		timestamp: timestamp,
+	}
+
+	conditions := policyConditions{
+		queueIds:   []string{"queue-1", "queue-2"},
+		directions: []string{"INBOUND"},
+		dateRanges: []string{"2024-04-01T00:00:00.000Z/2024-06-01T00:00:00.000Z"},
+		timeAllowed: &platformclientv2.Timeallowed{
+			TimeZoneId: platformclientv2.String("Europe/Paris"),
+			TimeSlots: &[]platformclientv2.Timeslot{
+				{Day: platformclientv2.Int(3), StartTime: platformclientv2.String("09:00:00.000"), StopTime: platformclientv2.String("17:00:00.000")},
+			},
+		},
+		duration: &platformclientv2.Durationcondition{
+			DurationMode:  platformclientv2.String("Between"),
+			DurationRange: platformclientv2.String("PT30S/PT5M"),
+		},
+	}
+	assert.Empty(t, evaluatePolicyConditions(conditions, interaction))
+
+	interaction.queueId = ""
+	interaction.direction = "OUTBOUND"
+	interaction.timestamp = time.Date(2024, 5, 1, 16, 0, 0, 0, time.UTC)
+	tenSeconds := 10 * time.Second
+	interaction.duration = &tenSeconds
+	assert.Equal(t, []string{
+		"the policy applies to specific queues and no queue_id is set",
+		"direction OUTBOUND is not one of the directions of the policy (INBOUND)",
+		"timestamp 2024-05-01T16:00:00Z (Wednesday 18:00:00 in Europe/Paris) is outside the time slots of the policy",
+		"duration 10s is outside the duration range PT30S/PT5M of the policy",
+	}, evaluatePolicyConditions(conditions, interaction))
+
+	interaction = simulatedInteraction{mediaType: mediaTypeCall, timestamp: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, []string{
+		"the policy applies to specific queues and no queue_id is set",
+		"the policy applies to specific directions and no direction is set",
+		"timestamp 2024-07-01T00:00:00Z is outside the date ranges of the policy",
+		"timestamp 2024-07-01T00:00:00Z (Monday 02:00:00 in Europe/Paris) is outside the time slots of the policy",
+		"the policy applies to interactions with a duration of PT30S/PT5M and no duration_seconds is set",
+	}, evaluatePolicyConditions(conditions, interaction))
+}
+
+func TestUnitEvaluateDurationCondition(t *testing.T) {
+	duration := 2 * time.Minute
+	over := &platformclientv2.Durationcondition{DurationMode: platformclientv2.String("Over"), DurationRange: platformclientv2.String("P0DT0H1M0S")}
+	under := &platformclientv2.Durationcondition{DurationMode: platformclientv2.String("Under"), DurationRange: platformclientv2.String("PT1M")}
+	assert.Empty(t, evaluateDurationCondition(over, &duration))
+	assert.Equal(t, "duration 2m0s is outside the duration range PT1M of the policy", evaluateDurationCondition(under, &duration))
+
+	invalid := &platformclientv2.Durationcondition{DurationRange: platformclientv2.String("two minutes")}
+	assert.Equal(t, "duration range two minutes of the policy could not be evaluated", evaluateDurationCondition(invalid, &duration))
+
+	parsed, err := parseIsoDuration("P1DT2H3M4.5S")
+	assert.NoError(t, err)
+	assert.Equal(t, 26*time.Hour+3*time.Minute+4500*time.Millisecond, parsed)
+	_, err = parseIsoDuration("PT")
+	assert.Error(t, err)
+}
+
+func TestUnitSimulatePolicies(t *testing.T) {
+	policies := []platformclientv2.Policy{
+		{
+			Id:      platformclientv2.String("policy-2"),
+			Name:    platformclientv2.String("Sales calls"),
+			Order:   platformclientv2.Int(2),
+			Enabled: platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{CallPolicy: &platformclientv2.Callmediapolicy{
+				Conditions: &platformclientv2.Callmediapolicyconditions{ForQueues: &[]platformclientv2.Queue{{Id: platformclientv2.String("queue-1")}}},
+				Actions: &platformclientv2.Policyactions{
+					RetainRecording: platformclientv2.Bool(true),
+					RetentionDuration: &platformclientv2.Retentionduration{
+						ArchiveRetention: &platformclientv2.Archiveretention{Days: platformclientv2.Int(30), StorageMedium: platformclientv2.String("CLOUDARCHIVE")},
+						DeleteRetention:  &platformclientv2.Deleteretention{Days: platformclientv2.Int(365)},
+					},
+					AssignEvaluations: &[]platformclientv2.Evaluationassignment{
+						{EvaluationForm: &platformclientv2.Evaluationform{Id: platformclientv2.String("form-1")}},
+						{EvaluationForm: &platformclientv2.Evaluationform{Id: platformclientv2.String("form-1")}},
+					},
+					AssignSurveys: &[]platformclientv2.Surveyassignment{{SurveyForm: &platformclientv2.Publishedsurveyformreference{Name: platformclientv2.String("NPS")}}},
+				},
+			}},
+		},
+		{
+			Id:            platformclientv2.String("policy-1"),
+			Name:          platformclientv2.String("All chats"),
+			Order:         platformclientv2.Int(1),
+			Enabled:       platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{ChatPolicy: &platformclientv2.Chatmediapolicy{}},
+		},
+		{
+			Id:      platformclientv2.String("policy-3"),
+			Name:    platformclientv2.String("Legacy"),
+			Enabled: platformclientv2.Bool(false),
+		},
+		{
+			Id:         platformclientv2.String("policy-4"),
+			Name:       platformclientv2.String("Delete short calls"),
+			Enabled:    platformclientv2.Bool(true),
+			Conditions: &platformclientv2.Policyconditions{MediaTypes: &[]string{"CALL"}},
+			Actions:    &platformclientv2.Policyactions{DeleteRecording: platformclientv2.Bool(true)},
+		},
+	}
+
+	simulations := simulatePolicies(policies, simulatedInteraction{mediaType: mediaTypeCall, queueId: "queue-1", timestamp: time.Now()})
+	flattened := flattenPolicySimulations(simulations)
+	assert.Len(t, flattened, 4)
+	assert.Equal(t, map[string]interface{}{
+		"policy_id": "policy-1",
+		"name":      "All chats",
+		"order":     1,
+		"enabled":   true,
+		"matched":   false,
+		"reasons":   []string{"the policy has no call media policy"},
+	}, flattened[0])
+	assert.Equal(t, "policy-2", flattened[1].(map[string]interface{})["policy_id"])
+	assert.Equal(t, []string{"the policy is disabled"}, flattened[3].(map[string]interface{})["reasons"])
+
+	matched := flattenMatchedPolicyActions(simulations)
+	assert.Len(t, matched, 2)
+	assert.Equal(t, map[string]interface{}{
+		"policy_id":              "policy-2",
+		"name":                   "Sales calls",
+		"retain_recording":       true,
+		"delete_recording":       false,
+		"always_delete":          false,
+		"archive_retention_days": 30,
+		"archive_storage_medium": "CLOUDARCHIVE",
+		"delete_retention_days":  365,
+		"evaluation_form_ids":    []string{"form-1"},
+		"calibration_form_ids":   []string{},
+		"survey_form_names":      []string{"NPS"},
+		"screen_recording":       false,
+		"media_transcription":    false,
+		"integration_export_id":  "",
+	}, matched[0])
+	// Policies without media policies are evaluated with their top level conditions
+	assert.Equal(t, "policy-4", matched[1].(map[string]interface{})["policy_id"])
+	assert.Equal(t, true, matched[1].(map[string]interface{})["delete_recording"])
+}
+
+func TestUnitDataSourceRecordingMediaRetentionPolicySimulationRead(t *testing.T) {
+	internalProxy = &policyProxy{}
+	defer func() { internalProxy = nil }()
+	internalProxy.getAllPoliciesAttr = func(ctx context.Context, p *policyProxy) (*[]platformclientv2.Policy, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Policy{{
+			Id:      platformclientv2.String("policy-1"),
+			Name:    platformclientv2.String("Long chats"),
+			Enabled: platformclientv2.Bool(true),
+			MediaPolicies: &platformclientv2.Mediapolicies{ChatPolicy: &platformclientv2.Chatmediapolicy{
+				Conditions: &platformclientv2.Chatmediapolicyconditions{Duration: &platformclientv2.Durationcondition{
+					DurationMode:  platformclientv2.String("Over"),
+					DurationRange: platformclientv2.String("PT10M"),
+				}},
+			}},
+		}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceRecordingMediaRetentionPolicySimulation().Schema, map[string]interface{}{
+		"media_type":       mediaTypeChat,
+		"duration_seconds": 900,
+		"timestamp":        "2024-05-01T12:30:00Z",
+	})
+	diagErr := dataSourceRecordingMediaRetentionPolicySimulationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "chat|2024-05-01T12:30:00Z", d.Id())
+	assert.Equal(t, true, d.Get("policies.0.matched"))
+	assert.Equal(t, "policy-1", d.Get("matched_policies.0.policy_id"))
+
+	// Without a duration the duration condition is not met
+	d = schema.TestResourceDataRaw(t, DataSourceRecordingMediaRetentionPolicySimulation().Schema, map[string]interface{}{
+		"media_type": mediaTypeChat,
+	})
+	diagErr = dataSourceRecordingMediaRetentionPolicySimulationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, false, d.Get("policies.0.matched"))
+	assert.Equal(t, 0, d.Get("matched_policies.#"))
+}
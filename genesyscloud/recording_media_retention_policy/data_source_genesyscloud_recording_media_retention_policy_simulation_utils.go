@@ -0,0 +1,681 @@
+package recording_media_retention_policy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_recording_media_retention_policy_simulation_utils.go file evaluates the conditions of
+   media retention policies against a described interaction, for the media retention policy simulation data source.
+*/
+
+const (
+	mediaTypeCall    = "call"
+	mediaTypeChat    = "chat"
+	mediaTypeEmail   = "email"
+	mediaTypeMessage = "message"
+)
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// simulatedInteraction describes the interaction the media retention policies are evaluated against
+type simulatedInteraction struct {
+	mediaType    string
+	queueId      string
+	userId       string
+	wrapupCodeId string
+	languageId   string
+	teamId       string
+	direction    string
+	duration     *time.Duration
+	timestamp    time.Time
+}
+
+// policyConditions holds the conditions of a media policy. Conditions that do not apply to a media type are left empty.
+type policyConditions struct {
+	userIds       []string
+	queueIds      []string
+	wrapupCodeIds []string
+	languageIds   []string
+	teamIds       []string
+	dateRanges    []string
+	directions    []string
+	timeAllowed   *platformclientv2.Timeallowed
+	duration      *platformclientv2.Durationcondition
+}
+
+// policySimulation is the result of evaluating a media retention policy against an interaction
+type policySimulation struct {
+	policy  platformclientv2.Policy
+	matched bool
+	reasons []string
+	actions *platformclientv2.Policyactions
+}
+
+// simulatePolicies evaluates every policy against the interaction, ordered by policy order and then by name
+func simulatePolicies(policies []platformclientv2.Policy, interaction simulatedInteraction) []policySimulation {
+	policies = sortPoliciesByOrder(policies)
+	simulations := make([]policySimulation, 0, len(policies))
+	for _, policy := range policies {
+		simulations = append(simulations, simulatePolicy(policy, interaction))
+	}
+	return simulations
+}
+
+// sortPoliciesByOrder returns a copy of the policies ordered by policy order and then by name
+func sortPoliciesByOrder(policies []platformclientv2.Policy) []platformclientv2.Policy {
+	policies = append([]platformclientv2.Policy(nil), policies...)
+	sort.SliceStable(policies, func(i, j int) bool {
+		if orderI, orderJ := policyOrder(policies[i]), policyOrder(policies[j]); orderI != orderJ {
+			return orderI < orderJ
+		}
+		return util.StringValue(policies[i].Name) < util.StringValue(policies[j].Name)
+	})
+	return policies
+}
+
+func simulatePolicy(policy platformclientv2.Policy, interaction simulatedInteraction) policySimulation {
+	simulation := policySimulation{policy: policy}
+	if !policyEnabled(policy) {
+		simulation.reasons = []string{"the policy is disabled"}
+		return simulation
+	}
+
+	conditions, actions, ok := mediaPolicyFor(policy, interaction.mediaType)
+	if !ok {
+		simulation.reasons = []string{fmt.Sprintf("the policy has no %s media policy", interaction.mediaType)}
+		return simulation
+	}
+
+	simulation.reasons = evaluatePolicyConditions(conditions, interaction)
+	simulation.matched = len(simulation.reasons) == 0
+	if simulation.matched {
+		simulation.actions = actions
+	}
+	return simulation
+}
+
+// mediaPolicyFor returns the conditions and actions of the media policy of a media type. Policies without media policies
+// are evaluated with their top level conditions and actions.
+func mediaPolicyFor(policy platformclientv2.Policy, mediaType string) (policyConditions, *platformclientv2.Policyactions, bool) {
+	if mediaPolicies := policy.MediaPolicies; mediaPolicies != nil {
+		switch mediaType {
+		case mediaTypeCall:
+			if mediaPolicies.CallPolicy != nil {
+				return callPolicyConditions(mediaPolicies.CallPolicy.Conditions), mediaPolicies.CallPolicy.Actions, true
+			}
+		case mediaTypeChat:
+			if mediaPolicies.ChatPolicy != nil {
+				return chatPolicyConditions(mediaPolicies.ChatPolicy.Conditions), mediaPolicies.ChatPolicy.Actions, true
+			}
+		case mediaTypeEmail:
+			if mediaPolicies.EmailPolicy != nil {
+				return emailPolicyConditions(mediaPolicies.EmailPolicy.Conditions), mediaPolicies.EmailPolicy.Actions, true
+			}
+		case mediaTypeMessage:
+			if mediaPolicies.MessagePolicy != nil {
+				return messagePolicyConditions(mediaPolicies.MessagePolicy.Conditions), mediaPolicies.MessagePolicy.Actions, true
+			}
+		}
+	}
+
+	if policy.Conditions == nil || policy.Conditions.MediaTypes == nil {
+		return policyConditions{}, nil, false
+	}
+	for _, policyMediaType := range *policy.Conditions.MediaTypes {
+		if strings.EqualFold(policyMediaType, mediaType) {
+			return topLevelPolicyConditions(policy.Conditions), policy.Actions, true
+		}
+	}
+	return policyConditions{}, nil, false
+}
+
+func callPolicyConditions(conditions *platformclientv2.Callmediapolicyconditions) policyConditions {
+	if conditions == nil {
+		return policyConditions{}
+	}
+	return policyConditions{
+		userIds:       userIds(conditions.ForUsers),
+		queueIds:      queueIds(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIds(conditions.WrapupCodes),
+		languageIds:   languageIds(conditions.Languages),
+		teamIds:       teamIds(conditions.Teams),
+		dateRanges:    stringList(conditions.DateRanges),
+		directions:    stringList(conditions.Directions),
+		timeAllowed:   conditions.TimeAllowed,
+		duration:      conditions.Duration,
+	}
+}
+
+func chatPolicyConditions(conditions *platformclientv2.Chatmediapolicyconditions) policyConditions {
+	if conditions == nil {
+		return policyConditions{}
+	}
+	return policyConditions{
+		userIds:       userIds(conditions.ForUsers),
+		queueIds:      queueIds(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIds(conditions.WrapupCodes),
+		languageIds:   languageIds(conditions.Languages),
+		teamIds:       teamIds(conditions.Teams),
+		dateRanges:    stringList(conditions.DateRanges),
+		timeAllowed:   conditions.TimeAllowed,
+		duration:      conditions.Duration,
+	}
+}
+
+func emailPolicyConditions(conditions *platformclientv2.Emailmediapolicyconditions) policyConditions {
+	if conditions == nil {
+		return policyConditions{}
+	}
+	return policyConditions{
+		userIds:       userIds(conditions.ForUsers),
+		queueIds:      queueIds(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIds(conditions.WrapupCodes),
+		languageIds:   languageIds(conditions.Languages),
+		teamIds:       teamIds(conditions.Teams),
+		dateRanges:    stringList(conditions.DateRanges),
+		timeAllowed:   conditions.TimeAllowed,
+	}
+}
+
+func messagePolicyConditions(conditions *platformclientv2.Messagemediapolicyconditions) policyConditions {
+	if conditions == nil {
+		return policyConditions{}
+	}
+	return policyConditions{
+		userIds:       userIds(conditions.ForUsers),
+		queueIds:      queueIds(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIds(conditions.WrapupCodes),
+		languageIds:   languageIds(conditions.Languages),
+		teamIds:       teamIds(conditions.Teams),
+		dateRanges:    stringList(conditions.DateRanges),
+		timeAllowed:   conditions.TimeAllowed,
+	}
+}
+
+func topLevelPolicyConditions(conditions *platformclientv2.Policyconditions) policyConditions {
+	return policyConditions{
+		userIds:       userIds(conditions.ForUsers),
+		queueIds:      queueIds(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIds(conditions.WrapupCodes),
+		teamIds:       teamIds(conditions.Teams),
+		dateRanges:    stringList(conditions.DateRanges),
+		directions:    stringList(conditions.Directions),
+		timeAllowed:   conditions.TimeAllowed,
+		duration:      conditions.Duration,
+	}
+}
+
+// evaluatePolicyConditions returns the reasons the interaction does not meet the conditions. No reasons means the conditions are met.
+func evaluatePolicyConditions(conditions policyConditions, interaction simulatedInteraction) []string {
+	var reasons []string
+	addReason := func(reason string) {
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	addReason(evaluateIdCondition("queue", "queue_id", conditions.queueIds, interaction.queueId))
+	addReason(evaluateIdCondition("user", "user_id", conditions.userIds, interaction.userId))
+	addReason(evaluateIdCondition("wrapup code", "wrapup_code_id", conditions.wrapupCodeIds, interaction.wrapupCodeId))
+	addReason(evaluateIdCondition("language", "language_id", conditions.languageIds, interaction.languageId))
+	addReason(evaluateIdCondition("team", "team_id", conditions.teamIds, interaction.teamId))
+	addReason(evaluateDirectionCondition(conditions.directions, interaction.direction))
+	addReason(evaluateDateRangeCondition(conditions.dateRanges, interaction.timestamp))
+	addReason(evaluateTimeAllowedCondition(conditions.timeAllowed, interaction.timestamp))
+	addReason(evaluateDurationCondition(conditions.duration, interaction.duration))
+	return reasons
+}
+
+func evaluateIdCondition(entity string, attribute string, ids []string, id string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	if id == "" {
+		return fmt.Sprintf("the policy applies to specific %ss and no %s is set", entity, attribute)
+	}
+	for _, conditionId := range ids {
+		if conditionId == id {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s %s is not one of the %ss of the policy", entity, id, entity)
+}
+
+func evaluateDirectionCondition(directions []string, direction string) string {
+	if len(directions) == 0 {
+		return ""
+	}
+	if direction == "" {
+		return "the policy applies to specific directions and no direction is set"
+	}
+	for _, conditionDirection := range directions {
+		if strings.EqualFold(conditionDirection, direction) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("direction %s is not one of the directions of the policy (%s)", direction, strings.Join(directions, ", "))
+}
+
+// evaluateDateRangeCondition checks the timestamp against date ranges such as 2022-05-12T04:00:00.000Z/2022-05-13T04:00:00.000Z
+func evaluateDateRangeCondition(dateRanges []string, timestamp time.Time) string {
+	if len(dateRanges) == 0 {
+		return ""
+	}
+	for _, dateRange := range dateRanges {
+		startTime, endTime, err := parseDateRange(dateRange)
+		if err != nil {
+			return fmt.Sprintf("date range %s of the policy could not be evaluated", dateRange)
+		}
+		if !timestamp.Before(startTime) && timestamp.Before(endTime) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("timestamp %s is outside the date ranges of the policy", timestamp.Format(time.RFC3339))
+}
+
+// parseDateRange parses a date range into its start time and its exclusive end time
+func parseDateRange(dateRange string) (time.Time, time.Time, error) {
+	start, end, found := strings.Cut(dateRange, "/")
+	if !found {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range %s", dateRange)
+	}
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return startTime, endTime, nil
+}
+
+// evaluateTimeAllowedCondition checks the timestamp against the time slots of the policy, in the time zone of the policy
+func evaluateTimeAllowedCondition(timeAllowed *platformclientv2.Timeallowed, timestamp time.Time) string {
+	if !timeAllowedActive(timeAllowed) {
+		return ""
+	}
+
+	location, err := time.LoadLocation(timeZoneId(timeAllowed))
+	if err != nil {
+		return fmt.Sprintf("time zone %s of the policy could not be evaluated", timeZoneId(timeAllowed))
+	}
+
+	local := timestamp.In(location)
+	// Time slots number the days from Monday = 1 to Sunday = 7
+	day := int(local.Weekday())
+	if day == 0 {
+		day = 7
+	}
+	secondOfDay := float64(local.Hour()*3600+local.Minute()*60+local.Second()) + float64(local.Nanosecond())/1e9
+
+	for _, slot := range *timeAllowed.TimeSlots {
+		if slot.Day != nil && *slot.Day != 0 && *slot.Day != day {
+			continue
+		}
+		start, startErr := parseTimeOfDay(util.StringValue(slot.StartTime), 0)
+		stop, stopErr := parseTimeOfDay(util.StringValue(slot.StopTime), 24*3600)
+		if startErr != nil || stopErr != nil {
+			return fmt.Sprintf("time slot %s-%s of the policy could not be evaluated", util.StringValue(slot.StartTime), util.StringValue(slot.StopTime))
+		}
+		if secondOfDay >= start && secondOfDay < stop {
+			return ""
+		}
+	}
+	return fmt.Sprintf("timestamp %s (%s %s in %s) is outside the time slots of the policy", timestamp.Format(time.RFC3339), local.Weekday(), local.Format("15:04:05"), location)
+}
+
+// timeAllowedActive returns true if the time slots of the policy restrict when the policy applies
+func timeAllowedActive(timeAllowed *platformclientv2.Timeallowed) bool {
+	return timeAllowed != nil && timeAllowed.TimeSlots != nil && len(*timeAllowed.TimeSlots) > 0 && !boolValue(timeAllowed.Empty)
+}
+
+// timeZoneId returns the time zone of the time slots of the policy. Time slots without a time zone are in UTC.
+func timeZoneId(timeAllowed *platformclientv2.Timeallowed) string {
+	if timeZoneId := util.StringValue(timeAllowed.TimeZoneId); timeZoneId != "" {
+		return timeZoneId
+	}
+	return "UTC"
+}
+
+// parseTimeOfDay parses times such as 10:10:10.010, 10:10:10 or 10:10 to seconds since midnight
+func parseTimeOfDay(value string, defaultSeconds float64) (float64, error) {
+	if value == "" {
+		return defaultSeconds, nil
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time of day %s", value)
+	}
+	var seconds float64
+	for i, multiplier := range []float64{3600, 60, 1} {
+		if i >= len(parts) {
+			break
+		}
+		part, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time of day %s", value)
+		}
+		seconds += part * multiplier
+	}
+	return seconds, nil
+}
+
+// durationBounds is the duration range of a duration condition. Over ranges exclude their lower bound and Under ranges
+// exclude their upper bound.
+type durationBounds struct {
+	lower          time.Duration
+	upper          time.Duration
+	hasUpper       bool
+	lowerExclusive bool
+	upperExclusive bool
+}
+
+// parseDurationBounds parses the duration range of the condition. Between ranges have a lower and an upper bound separated
+// by a slash, Over and Under ranges only use the lower and upper bound respectively.
+func parseDurationBounds(condition *platformclientv2.Durationcondition) (durationBounds, error) {
+	lowerValue, upperValue, hasUpper := strings.Cut(util.StringValue(condition.DurationRange), "/")
+	mode := util.StringValue(condition.DurationMode)
+	if mode == "Under" && !hasUpper {
+		upperValue, lowerValue = lowerValue, ""
+	}
+	lower, err := parseIsoDuration(lowerValue)
+	if err != nil {
+		return durationBounds{}, err
+	}
+	upper, err := parseIsoDuration(upperValue)
+	if err != nil {
+		return durationBounds{}, err
+	}
+
+	bounds := durationBounds{lower: lower, upper: upper, hasUpper: upperValue != ""}
+	switch mode {
+	case "Over":
+		bounds.hasUpper = false
+		bounds.lowerExclusive = true
+	case "Under":
+		bounds.lower = 0
+		bounds.hasUpper = true
+		bounds.upperExclusive = true
+	}
+	return bounds, nil
+}
+
+func (b durationBounds) contains(duration time.Duration) bool {
+	if duration < b.lower || (b.lowerExclusive && duration == b.lower) {
+		return false
+	}
+	return !b.hasUpper || duration < b.upper || (!b.upperExclusive && duration == b.upper)
+}
+
+// overlaps returns true if a duration can be within both ranges
+func (b durationBounds) overlaps(other durationBounds) bool {
+	return !b.endsBefore(other) && !other.endsBefore(b)
+}
+
+func (b durationBounds) endsBefore(other durationBounds) bool {
+	if !b.hasUpper {
+		return false
+	}
+	return b.upper < other.lower || (b.upper == other.lower && (b.upperExclusive || other.lowerExclusive))
+}
+
+// evaluateDurationCondition checks the duration against the duration range of the policy
+func evaluateDurationCondition(condition *platformclientv2.Durationcondition, duration *time.Duration) string {
+	if condition == nil || util.StringValue(condition.DurationRange) == "" {
+		return ""
+	}
+	durationRange := util.StringValue(condition.DurationRange)
+	if duration == nil {
+		return fmt.Sprintf("the policy applies to interactions with a duration of %s and no duration_seconds is set", durationRange)
+	}
+
+	bounds, err := parseDurationBounds(condition)
+	if err != nil {
+		return fmt.Sprintf("duration range %s of the policy could not be evaluated", durationRange)
+	}
+	if !bounds.contains(*duration) {
+		return fmt.Sprintf("duration %s is outside the duration range %s of the policy", *duration, durationRange)
+	}
+	return ""
+}
+
+// parseIsoDuration parses ISO 8601 durations such as P0DT0H1M30S. An empty value is a zero duration.
+func parseIsoDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	match := isoDurationPattern.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %s", value)
+	}
+
+	var duration time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute} {
+		if match[i+1] != "" {
+			count, _ := strconv.Atoi(match[i+1])
+			duration += time.Duration(count) * unit
+		}
+	}
+	if match[4] != "" {
+		seconds, _ := strconv.ParseFloat(match[4], 64)
+		duration += time.Duration(seconds * float64(time.Second))
+	}
+	return duration, nil
+}
+
+func flattenPolicySimulations(simulations []policySimulation) []interface{} {
+	flattened := make([]interface{}, 0, len(simulations))
+	for _, simulation := range simulations {
+		reasons := simulation.reasons
+		if reasons == nil {
+			reasons = []string{}
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"policy_id": util.StringValue(simulation.policy.Id),
+			"name":      util.StringValue(simulation.policy.Name),
+			"order":     policyOrder(simulation.policy),
+			"enabled":   policyEnabled(simulation.policy),
+			"matched":   simulation.matched,
+			"reasons":   reasons,
+		})
+	}
+	return flattened
+}
+
+// flattenMatchedPolicyActions lists the actions of each matched policy
+func flattenMatchedPolicyActions(simulations []policySimulation) []interface{} {
+	flattened := make([]interface{}, 0)
+	for _, simulation := range simulations {
+		if !simulation.matched {
+			continue
+		}
+		matchedPolicy := map[string]interface{}{
+			"policy_id":              util.StringValue(simulation.policy.Id),
+			"name":                   util.StringValue(simulation.policy.Name),
+			"retain_recording":       false,
+			"delete_recording":       false,
+			"always_delete":          false,
+			"archive_retention_days": 0,
+			"archive_storage_medium": "",
+			"delete_retention_days":  0,
+			"evaluation_form_ids":    []string{},
+			"calibration_form_ids":   []string{},
+			"survey_form_names":      []string{},
+			"screen_recording":       false,
+			"media_transcription":    false,
+			"integration_export_id":  "",
+		}
+		if actions := simulation.actions; actions != nil {
+			matchedPolicy["retain_recording"] = boolValue(actions.RetainRecording)
+			matchedPolicy["delete_recording"] = boolValue(actions.DeleteRecording)
+			matchedPolicy["always_delete"] = boolValue(actions.AlwaysDelete)
+			if retention := actions.RetentionDuration; retention != nil {
+				if retention.ArchiveRetention != nil {
+					matchedPolicy["archive_retention_days"] = intValue(retention.ArchiveRetention.Days)
+					matchedPolicy["archive_storage_medium"] = util.StringValue(retention.ArchiveRetention.StorageMedium)
+				}
+				if retention.DeleteRetention != nil {
+					matchedPolicy["delete_retention_days"] = intValue(retention.DeleteRetention.Days)
+				}
+			}
+			matchedPolicy["evaluation_form_ids"] = policyEvaluationFormIds(actions)
+			matchedPolicy["calibration_form_ids"] = policyCalibrationFormIds(actions)
+			matchedPolicy["survey_form_names"] = policySurveyFormNames(actions)
+			matchedPolicy["screen_recording"] = actions.InitiateScreenRecording != nil
+			matchedPolicy["media_transcription"] = actions.MediaTranscriptions != nil && len(*actions.MediaTranscriptions) > 0
+			if actions.IntegrationExport != nil && actions.IntegrationExport.Integration != nil {
+				matchedPolicy["integration_export_id"] = util.StringValue(actions.IntegrationExport.Integration.Id)
+			}
+		}
+		flattened = append(flattened, matchedPolicy)
+	}
+	return flattened
+}
+
+// policyEvaluationFormIds lists the evaluation forms of the evaluation assignments of the policy, without duplicates
+func policyEvaluationFormIds(actions *platformclientv2.Policyactions) []string {
+	var forms []*platformclientv2.Evaluationform
+	if actions.AssignEvaluations != nil {
+		for _, assignment := range *actions.AssignEvaluations {
+			forms = append(forms, assignment.EvaluationForm)
+		}
+	}
+	if actions.AssignMeteredEvaluations != nil {
+		for _, assignment := range *actions.AssignMeteredEvaluations {
+			forms = append(forms, assignment.EvaluationForm)
+		}
+	}
+	if actions.AssignMeteredAssignmentByAgent != nil {
+		for _, assignment := range *actions.AssignMeteredAssignmentByAgent {
+			forms = append(forms, assignment.EvaluationForm)
+		}
+	}
+	return evaluationFormIds(forms)
+}
+
+func policyCalibrationFormIds(actions *platformclientv2.Policyactions) []string {
+	var forms []*platformclientv2.Evaluationform
+	if actions.AssignCalibrations != nil {
+		for _, assignment := range *actions.AssignCalibrations {
+			forms = append(forms, assignment.EvaluationForm)
+		}
+	}
+	return evaluationFormIds(forms)
+}
+
+func evaluationFormIds(forms []*platformclientv2.Evaluationform) []string {
+	ids := make([]string, 0, len(forms))
+	seen := make(map[string]bool, len(forms))
+	for _, form := range forms {
+		if form == nil || form.Id == nil || seen[*form.Id] {
+			continue
+		}
+		seen[*form.Id] = true
+		ids = append(ids, *form.Id)
+	}
+	return ids
+}
+
+func policySurveyFormNames(actions *platformclientv2.Policyactions) []string {
+	names := make([]string, 0)
+	if actions.AssignSurveys == nil {
+		return names
+	}
+	for _, assignment := range *actions.AssignSurveys {
+		if assignment.SurveyForm != nil && assignment.SurveyForm.Name != nil {
+			names = append(names, *assignment.SurveyForm.Name)
+		}
+	}
+	return names
+}
+
+// policyEnabled returns true unless the policy is explicitly disabled
+func policyEnabled(policy platformclientv2.Policy) bool {
+	return policy.Enabled == nil || *policy.Enabled
+}
+
+// policyOrder returns the order of a policy. Policies without an order are evaluated last.
+func policyOrder(policy platformclientv2.Policy) int {
+	if policy.Order == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *policy.Order
+}
+
+func userIds(users *[]platformclientv2.User) []string {
+	var ids []string
+	if users != nil {
+		for _, user := range *users {
+			ids = append(ids, util.StringValue(user.Id))
+		}
+	}
+	return ids
+}
+
+func queueIds(queues *[]platformclientv2.Queue) []string {
+	var ids []string
+	if queues != nil {
+		for _, queue := range *queues {
+			ids = append(ids, util.StringValue(queue.Id))
+		}
+	}
+	return ids
+}
+
+func wrapupCodeIds(wrapupCodes *[]platformclientv2.Wrapupcode) []string {
+	var ids []string
+	if wrapupCodes != nil {
+		for _, wrapupCode := range *wrapupCodes {
+			ids = append(ids, util.StringValue(wrapupCode.Id))
+		}
+	}
+	return ids
+}
+
+func languageIds(languages *[]platformclientv2.Language) []string {
+	var ids []string
+	if languages != nil {
+		for _, language := range *languages {
+			ids = append(ids, util.StringValue(language.Id))
+		}
+	}
+	return ids
+}
+
+func teamIds(teams *[]platformclientv2.Team) []string {
+	var ids []string
+	if teams != nil {
+		for _, team := range *teams {
+			ids = append(ids, util.StringValue(team.Id))
+		}
+	}
+	return ids
+}
+
+func stringList(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -69,6 +69,8 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceRecordingMediaRetentionPolicy()
+	providerDataSources[simulationDataSourceName] = DataSourceRecordingMediaRetentionPolicySimulation()
+	providerDataSources[conflictsDataSourceName] = DataSourceRecordingMediaRetentionPolicyConflicts()
 }
 
 // initTestResources initializes all test resources and data sources.
@@ -70,13 +70,13 @@ func createMediaRetentionPolicy(ctx context.Context, d *schema.ResourceData, met
 	err, mediaPolicies := buildMediaPolicies(d, pp, ctx)
 
 	if err != nil {
-		util.BuildDiagnosticError(resourceName, "error while calling buildMediaPolicie()in createMediaRetention", err)
+		return util.BuildDiagnosticError(resourceName, "error while calling buildMediaPolicie()in createMediaRetention", err)
 	}
 
 	conditions := buildConditions(d)
 	err, actions := buildPolicyActionsFromResource(d, pp, ctx)
 	if err != nil {
-		util.BuildDiagnosticError(resourceName, "error while calling buildPolicyActionsFromResource()", err)
+		return util.BuildDiagnosticError(resourceName, "error while calling buildPolicyActionsFromResource()", err)
 	}
 
 	policyErrors := buildPolicyErrors(d)
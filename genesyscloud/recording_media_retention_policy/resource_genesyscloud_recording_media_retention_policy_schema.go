@@ -18,10 +18,14 @@ The genesyscloud_recording_media_retention_policy_schema.go should hold four typ
 */
 
 const resourceName = "genesyscloud_recording_media_retention_policy"
+const simulationDataSourceName = "genesyscloud_recording_media_retention_policy_simulation"
+const conflictsDataSourceName = "genesyscloud_recording_media_retention_policy_conflicts"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceRecordingMediaRetentionPolicy())
+	l.RegisterDataSource(simulationDataSourceName, DataSourceRecordingMediaRetentionPolicySimulation())
+	l.RegisterDataSource(conflictsDataSourceName, DataSourceRecordingMediaRetentionPolicyConflicts())
 	l.RegisterResource(resourceName, ResourceMediaRetentionPolicy())
 	l.RegisterExporter(resourceName, MediaRetentionPolicyExporter())
 }
@@ -1053,3 +1057,299 @@ func DataSourceRecordingMediaRetentionPolicy() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceRecordingMediaRetentionPolicySimulation registers the genesyscloud_recording_media_retention_policy_simulation data source
+func DataSourceRecordingMediaRetentionPolicySimulation() *schema.Resource {
+	policyResult := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Description: "ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"order": {
+				Description: "Order of the policy.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"enabled": {
+				Description: "True if the policy is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"matched": {
+				Description: "True if the interaction meets all conditions of the policy.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"reasons": {
+				Description: "Reasons the policy did not match the interaction. Empty if the policy matched.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	matchedPolicy := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Description: "ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"retain_recording": {
+				Description: "True if the policy retains the recording.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"delete_recording": {
+				Description: "True if the policy deletes the recording.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"always_delete": {
+				Description: "True if the policy always deletes the recording.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"archive_retention_days": {
+				Description: "Days after which the recording is archived. 0 if the policy does not archive recordings.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"archive_storage_medium": {
+				Description: "Storage medium of archived recordings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"delete_retention_days": {
+				Description: "Days after which the recording is deleted. 0 if the policy does not set a deletion period.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"evaluation_form_ids": {
+				Description: "IDs of the evaluation forms of the evaluations the policy assigns.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"calibration_form_ids": {
+				Description: "IDs of the evaluation forms of the calibrations the policy assigns.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"survey_form_names": {
+				Description: "Names of the survey forms of the surveys the policy sends.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"screen_recording": {
+				Description: "True if the policy initiates a screen recording.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"media_transcription": {
+				Description: "True if the policy transcribes the media.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"integration_export_id": {
+				Description: "ID of the integration the policy exports recordings to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source simulating which media retention policies of the organization apply to an interaction, and the resulting actions. " +
+			"Conditions on customer participation are not evaluated. Duration conditions are evaluated from their `duration_mode` and `duration_range`.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceRecordingMediaRetentionPolicySimulationRead),
+		Schema: map[string]*schema.Schema{
+			"media_type": {
+				Description:  "Media type of the interaction (call | chat | email | message).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{mediaTypeCall, mediaTypeChat, mediaTypeEmail, mediaTypeMessage}, false),
+			},
+			"queue_id": {
+				Description: "ID of the queue of the interaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"user_id": {
+				Description: "ID of the user who handled the interaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"wrapup_code_id": {
+				Description: "ID of the wrapup code of the interaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"language_id": {
+				Description: "ID of the routing language of the interaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"team_id": {
+				Description: "ID of the team of the user who handled the interaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"direction": {
+				Description:  "Direction of the interaction (INBOUND | OUTBOUND).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INBOUND", "OUTBOUND"}, false),
+			},
+			"duration_seconds": {
+				Description:  "Duration of the interaction in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"timestamp": {
+				Description:  "Time of the interaction in RFC 3339 format, e.g. `2024-05-01T14:30:00Z`. Defaults to the current time.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"policies": {
+				Description: "Every media retention policy of the organization with whether it matched the interaction, ordered by policy order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        policyResult,
+			},
+			"matched_policies": {
+				Description: "The policies that matched the interaction with their actions, ordered by policy order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        matchedPolicy,
+			},
+		},
+	}
+}
+
+// DataSourceRecordingMediaRetentionPolicyConflicts registers the genesyscloud_recording_media_retention_policy_conflicts data source
+func DataSourceRecordingMediaRetentionPolicyConflicts() *schema.Resource {
+	policySchema := ResourceMediaRetentionPolicy().Schema
+
+	plannedPolicy := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The policy name. A policy of the organization with the same name is replaced by this policy in the analysis.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"order":          policySchema["order"],
+			"enabled":        policySchema["enabled"],
+			"media_policies": policySchema["media_policies"],
+			"conditions":     policySchema["conditions"],
+			"actions":        policySchema["actions"],
+		},
+	}
+
+	policyPair := map[string]*schema.Schema{
+		"media_type": {
+			Description: "Media type the conditions of both policies can match.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_a_id": {
+			Description: "ID of the first policy. Empty for planned policies that do not exist yet.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_a_name": {
+			Description: "Name of the first policy, which comes first in policy order.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_b_id": {
+			Description: "ID of the second policy. Empty for planned policies that do not exist yet.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_b_name": {
+			Description: "Name of the second policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	overlapSchema := map[string]*schema.Schema{
+		"conflicting": {
+			Description: "True if the actions of the policies conflict.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+	conflictSchema := map[string]*schema.Schema{
+		"conflict_type": {
+			Description: "Type of the conflict (retention | evaluation | screen_recording).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "Description of the conflicting actions.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for key, value := range policyPair {
+		overlapSchema[key] = value
+		conflictSchema[key] = value
+	}
+
+	return &schema.Resource{
+		Description: "Data source pairing the enabled media retention policies whose conditions can match the same interaction, and reporting the pairs with conflicting " +
+			"retention, evaluation assignment or screen recording actions. Planned policies are analyzed together with the policies of the organization. " +
+			"Conditions on customer participation are not compared, and time slots in different time zones are treated as overlapping.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceRecordingMediaRetentionPolicyConflictsRead),
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Description: "Planned media retention policies, with the same attributes as the `genesyscloud_recording_media_retention_policy` resource.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        plannedPolicy,
+			},
+			"include_organization_policies": {
+				Description: "Analyze the planned policies together with the media retention policies of the organization.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"overlapping_policies": {
+				Description: "Pairs of enabled policies whose conditions can match the same interaction, per media type.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: overlapSchema},
+			},
+			"conflicts": {
+				Description: "Conflicting actions of overlapping policies.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: conflictSchema},
+			},
+			"has_conflicts": {
+				Description: "True if any overlapping policies have conflicting actions.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -1531,7 +1531,7 @@ func flattenMessageMediaPolicy(messageMediaPolicy *platformclientv2.Messagemedia
 
 func buildMediaPolicies(d *schema.ResourceData, pp *policyProxy, ctx context.Context) (error, *platformclientv2.Mediapolicies) {
 	sdkMediaPolicies := platformclientv2.Mediapolicies{}
-	var err error //Placeholder error that will be set in the iff conditions below
+	var err error
 
 	if mediaPolicies, ok := d.Get("media_policies").([]interface{}); ok && len(mediaPolicies) > 0 {
 		mediaPoliciesMap, ok := mediaPolicies[0].(map[string]interface{})
@@ -1539,20 +1539,29 @@ func buildMediaPolicies(d *schema.ResourceData, pp *policyProxy, ctx context.Con
 			return nil, nil
 		}
 
-		if callPolicy := mediaPoliciesMap["call_policy"]; callPolicy != nil {
-			err, sdkMediaPolicies.CallPolicy = buildCallMediaPolicy(callPolicy.([]interface{}), pp, ctx)
+		// Media types without a policy are left unset rather than reported as errors by their build functions
+		if callPolicy, ok := mediaPoliciesMap["call_policy"].([]interface{}); ok && len(callPolicy) > 0 {
+			if err, sdkMediaPolicies.CallPolicy = buildCallMediaPolicy(callPolicy, pp, ctx); err != nil {
+				return err, nil
+			}
 		}
 
-		if chatPolicy := mediaPoliciesMap["chat_policy"]; chatPolicy != nil {
-			err, sdkMediaPolicies.ChatPolicy = buildChatMediaPolicy(chatPolicy.([]interface{}), pp, ctx)
+		if chatPolicy, ok := mediaPoliciesMap["chat_policy"].([]interface{}); ok && len(chatPolicy) > 0 {
+			if err, sdkMediaPolicies.ChatPolicy = buildChatMediaPolicy(chatPolicy, pp, ctx); err != nil {
+				return err, nil
+			}
 		}
 
-		if emailPolicy := mediaPoliciesMap["email_policy"]; emailPolicy != nil {
-			err, sdkMediaPolicies.EmailPolicy = buildEmailMediaPolicy(emailPolicy.([]interface{}), pp, ctx)
+		if emailPolicy, ok := mediaPoliciesMap["email_policy"].([]interface{}); ok && len(emailPolicy) > 0 {
+			if err, sdkMediaPolicies.EmailPolicy = buildEmailMediaPolicy(emailPolicy, pp, ctx); err != nil {
+				return err, nil
+			}
 		}
 
-		if messagePolicy := mediaPoliciesMap["message_policy"]; messagePolicy != nil {
-			err, sdkMediaPolicies.MessagePolicy = buildMessageMediaPolicy(messagePolicy.([]interface{}), pp, ctx)
+		if messagePolicy, ok := mediaPoliciesMap["message_policy"].([]interface{}); ok && len(messagePolicy) > 0 {
+			if err, sdkMediaPolicies.MessagePolicy = buildMessageMediaPolicy(messagePolicy, pp, ctx); err != nil {
+				return err, nil
+			}
 		}
 	}
 	return err, &sdkMediaPolicies
@@ -32,6 +32,10 @@ type deleteRoutingQueueWrapupCodeFunc func(ctx context.Context, p *RoutingQueueP
 type addOrRemoveMembersFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error)
 type updateRoutingQueueMemberFunc func(ctx context.Context, p *RoutingQueueProxy, queueId, userId string, body platformclientv2.Queuemember) (*platformclientv2.APIResponse, error)
 
+type getRoutingSkillFunc func(ctx context.Context, p *RoutingQueueProxy, skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error)
+type getRoutingSkillGroupFunc func(ctx context.Context, p *RoutingQueueProxy, skillGroupId string) (*platformclientv2.Skillgroup, *platformclientv2.APIResponse, error)
+type getRoutingQueueMemberSkillIdsFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string) (map[string]bool, *platformclientv2.APIResponse, error)
+
 // RoutingQueueProxy contains all the methods that call genesys cloud APIs.
 type RoutingQueueProxy struct {
 	clientConfig *platformclientv2.Configuration
@@ -51,6 +55,10 @@ type RoutingQueueProxy struct {
 	addOrRemoveMembersAttr       addOrRemoveMembersFunc
 	updateRoutingQueueMemberAttr updateRoutingQueueMemberFunc
 
+	getRoutingSkillAttr               getRoutingSkillFunc
+	getRoutingSkillGroupAttr          getRoutingSkillGroupFunc
+	getRoutingQueueMemberSkillIdsAttr getRoutingQueueMemberSkillIdsFunc
+
 	RoutingQueueCache rc.CacheInterface[platformclientv2.Queue]
 	wrapupCodeCache   rc.CacheInterface[platformclientv2.Wrapupcode]
 }
@@ -78,6 +86,10 @@ func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *Routin
 		addOrRemoveMembersAttr:       addOrRemoveMembersFn,
 		updateRoutingQueueMemberAttr: updateRoutingQueueMemberFn,
 
+		getRoutingSkillAttr:               getRoutingSkillFn,
+		getRoutingSkillGroupAttr:          getRoutingSkillGroupFn,
+		getRoutingQueueMemberSkillIdsAttr: getRoutingQueueMemberSkillIdsFn,
+
 		RoutingQueueCache: routingQueueCache,
 		wrapupCodeCache:   wrapupCodeCache,
 	}
@@ -136,6 +148,18 @@ func (p *RoutingQueueProxy) updateRoutingQueueMember(ctx context.Context, queueI
 	return p.updateRoutingQueueMemberAttr(ctx, p, queueId, userId, body)
 }
 
+func (p *RoutingQueueProxy) getRoutingSkill(ctx context.Context, skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	return p.getRoutingSkillAttr(ctx, p, skillId)
+}
+
+func (p *RoutingQueueProxy) getRoutingSkillGroup(ctx context.Context, skillGroupId string) (*platformclientv2.Skillgroup, *platformclientv2.APIResponse, error) {
+	return p.getRoutingSkillGroupAttr(ctx, p, skillGroupId)
+}
+
+func (p *RoutingQueueProxy) getRoutingQueueMemberSkillIds(ctx context.Context, queueId string) (map[string]bool, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueMemberSkillIdsAttr(ctx, p, queueId)
+}
+
 // GetAllRoutingQueuesFn is the implementation for retrieving all routing queues in Genesys Cloud
 func GetAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy, name string) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
 	var allQueues []platformclientv2.Queue
@@ -288,3 +312,37 @@ func addOrRemoveMembersFn(ctx context.Context, p *RoutingQueueProxy, queueId str
 func updateRoutingQueueMemberFn(ctx context.Context, p *RoutingQueueProxy, queueId, userId string, body platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
 	return p.routingApi.PatchRoutingQueueMember(queueId, userId, body)
 }
+
+// getRoutingSkillFn is the implementation for retrieving a routing skill referenced by a queue
+func getRoutingSkillFn(ctx context.Context, p *RoutingQueueProxy, skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	return p.routingApi.GetRoutingSkill(skillId)
+}
+
+// getRoutingSkillGroupFn is the implementation for retrieving a skill group referenced by a queue
+func getRoutingSkillGroupFn(ctx context.Context, p *RoutingQueueProxy, skillGroupId string) (*platformclientv2.Skillgroup, *platformclientv2.APIResponse, error) {
+	return p.routingApi.GetRoutingSkillgroup(skillGroupId)
+}
+
+// getRoutingQueueMemberSkillIdsFn is the implementation for retrieving the IDs of the skills held by the members of a queue
+func getRoutingQueueMemberSkillIdsFn(ctx context.Context, p *RoutingQueueProxy, queueId string) (map[string]bool, *platformclientv2.APIResponse, error) {
+	skillIds := make(map[string]bool)
+	for pageNum := 1; ; pageNum++ {
+		members, resp, err := sdkGetRoutingQueueMembers(queueId, "", []string{"skills"}, pageNum, 100, p.clientConfig)
+		if err != nil {
+			return nil, resp, err
+		}
+		if members == nil || members.Entities == nil || len(*members.Entities) == 0 {
+			return skillIds, resp, nil
+		}
+		for _, member := range *members.Entities {
+			if member.User == nil || member.User.Skills == nil {
+				continue
+			}
+			for _, skill := range *member.User.Skills {
+				if skill.Id != nil {
+					skillIds[*skill.Id] = true
+				}
+			}
+		}
+	}
+}
@@ -143,7 +143,7 @@ func createRoutingQueue(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("Created Routing Queue %s", d.Id())
-	return readRoutingQueue(ctx, d, meta)
+	return append(routingWarnings(ctx, d, proxy), readRoutingQueue(ctx, d, meta)...)
 }
 
 func readRoutingQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -274,6 +274,8 @@ func readRoutingQueue(ctx context.Context, d *schema.ResourceData, meta interfac
 			log.Printf("%s is set, not reading outbound_email_address attribute in routing_queue %s resource", featureToggles.OEAToggleName(), d.Id())
 		}
 
+		_ = d.Set("routing_summary", readQueueRoutingConfig(d).summary())
+
 		log.Printf("Read queue %s %s", d.Id(), *currentQueue.Name)
 		return cc.CheckState(d)
 	})
@@ -353,7 +355,7 @@ func updateRoutingQueue(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("Updated queue %s", *updateQueue.Name)
-	return readRoutingQueue(ctx, d, meta)
+	return append(routingWarnings(ctx, d, proxy), readRoutingQueue(ctx, d, meta)...)
 }
 
 /*
@@ -40,7 +40,8 @@ func postRoutingQueueMembers(queueID string, membersToUpdate []string, remove bo
 	return nil
 }
 
-func getRoutingQueueMembers(queueID string, memberBy string, sdkConfig *platformclientv2.Configuration) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+// GetRoutingQueueMembers returns every member of a queue. memberBy may be "user" or "group" to filter on how the member was added.
+func GetRoutingQueueMembers(queueID string, memberBy string, sdkConfig *platformclientv2.Configuration) ([]platformclientv2.Queuemember, diag.Diagnostics) {
 	proxy := GetRoutingQueueProxy(sdkConfig)
 	var members []platformclientv2.Queuemember
 
@@ -59,7 +60,7 @@ func getRoutingQueueMembers(queueID string, memberBy string, sdkConfig *platform
 	log.Printf("%d members belong to queue %s", queueMembers, queueID)
 
 	for pageNum := 1; ; pageNum++ {
-		users, resp, err := sdkGetRoutingQueueMembers(queueID, memberBy, pageNum, 100, sdkConfig)
+		users, resp, err := sdkGetRoutingQueueMembers(queueID, memberBy, nil, pageNum, 100, sdkConfig)
 		if err != nil || resp.StatusCode != http.StatusOK {
 			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to query users for queue %s error: %s", queueID, err), resp)
 		}
@@ -126,7 +127,7 @@ func removeAllExistingUserMembersFromQueue(queueId string, sdkConfig *platformcl
 
 	log.Printf("Reading user members of queue %s", queueId)
 
-	oldSdkUsers, err := getRoutingQueueMembers(queueId, "user", sdkConfig)
+	oldSdkUsers, err := GetRoutingQueueMembers(queueId, "user", sdkConfig)
 	if err != nil {
 		return fmt.Errorf("%v", err)
 	}
@@ -181,7 +182,7 @@ func updateQueueUserRingNum(queueID string, userID string, ringNum int, sdkConfi
 	return nil
 }
 
-func sdkGetRoutingQueueMembers(queueID, memberBy string, pageNumber, pageSize int, sdkConfig *platformclientv2.Configuration) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+func sdkGetRoutingQueueMembers(queueID, memberBy string, expand []string, pageNumber, pageSize int, sdkConfig *platformclientv2.Configuration) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
 	api := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
 	apiClient := &api.Configuration.APIClient
@@ -212,6 +213,9 @@ func sdkGetRoutingQueueMembers(queueID, memberBy string, pageNumber, pageSize in
 	if memberBy != "" {
 		queryParams["memberBy"] = memberBy
 	}
+	if len(expand) > 0 {
+		queryParams["expand"] = apiClient.ParameterToString(expand, "multi")
+	}
 
 	headerParams["Content-Type"] = "application/json"
 	headerParams["Accept"] = "application/json"
@@ -233,7 +237,7 @@ func checkUserMembership(queueId string, newUserIds []string, sdkConfig *platfor
 		log.Printf("Sleeping for 10 seconds")
 		time.Sleep(10 * time.Second)
 
-		members, diagErr := getRoutingQueueMembers(queueId, "group", sdkConfig)
+		members, diagErr := GetRoutingQueueMembers(queueId, "group", sdkConfig)
 		if diagErr != nil {
 			return fmt.Errorf("%v", diagErr)
 		}
@@ -275,7 +279,7 @@ func getNewUsersAndRingNums(membersSet *schema.Set) ([]string, map[string]int) {
 }
 
 func getExistingUsersAndRingNums(queueID string, sdkConfig *platformclientv2.Configuration) ([]string, map[string]int, diag.Diagnostics) {
-	oldSdkUsers, err := getRoutingQueueMembers(queueID, "user", sdkConfig)
+	oldSdkUsers, err := GetRoutingQueueMembers(queueID, "user", sdkConfig)
 	if err != nil {
 		return nil, nil, err
 	}
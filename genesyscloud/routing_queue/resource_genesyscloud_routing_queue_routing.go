@@ -0,0 +1,542 @@
+package routing_queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_routing.go file contains the validation of the routing settings of a queue
+(skill_evaluation_method, routing_rules, bullseye_rings and conditional_group_routing_rules) and builds the routing_summary
+attribute that describes the path an interaction takes through the queue. Settings the API would reject fail the plan.
+Settings that are accepted but have no effect, and skills removed by bullseye rings that no member of the queue has, are
+reported as warnings when the queue is created or updated, as CustomizeDiff cannot return warnings.
+*/
+
+const (
+	memberGroupTypeSkillGroup = "SKILLGROUP"
+	memberGroupTypeGroup      = "GROUP"
+	memberGroupTypeTeam       = "TEAM"
+)
+
+// routingConfigGetter is implemented by both *schema.ResourceData and *schema.ResourceDiff
+type routingConfigGetter interface {
+	Get(key string) interface{}
+}
+
+// priorRoutingConfigGetter reads the values of a diff before the change
+type priorRoutingConfigGetter struct {
+	diff *schema.ResourceDiff
+}
+
+func (g priorRoutingConfigGetter) Get(key string) interface{} {
+	prior, _ := g.diff.GetChange(key)
+	return prior
+}
+
+// routingAttributes are the attributes the routing settings of a queue are read from
+var routingAttributes = []string{
+	"skill_evaluation_method",
+	"skill_groups",
+	"groups",
+	"teams",
+	"routing_rules",
+	"bullseye_rings",
+	"conditional_group_routing_rules",
+}
+
+type memberGroupConfig struct {
+	id        string
+	groupType string
+}
+
+type routingRuleConfig struct {
+	operator    string
+	threshold   int
+	waitSeconds float64
+}
+
+type bullseyeRingConfig struct {
+	expansionTimeoutSeconds float64
+	skillsToRemove          []string
+	memberGroups            []memberGroupConfig
+}
+
+type conditionalGroupRoutingRuleConfig struct {
+	queueId        string
+	operator       string
+	metric         string
+	conditionValue float64
+	waitSeconds    int
+	groups         []memberGroupConfig
+}
+
+// queueRoutingConfig holds the routing settings of a queue independently of whether they come from a plan or from state
+type queueRoutingConfig struct {
+	skillEvaluationMethod string
+	skillGroupIds         []string
+	groupIds              []string
+	teamIds               []string
+	routingRules          []routingRuleConfig
+	bullseyeRings         []bullseyeRingConfig
+	cgrRules              []conditionalGroupRoutingRuleConfig
+}
+
+func readQueueRoutingConfig(d routingConfigGetter) queueRoutingConfig {
+	config := queueRoutingConfig{
+		skillEvaluationMethod: d.Get("skill_evaluation_method").(string),
+		skillGroupIds:         sortedSetStrings(d.Get("skill_groups")),
+		groupIds:              sortedSetStrings(d.Get("groups")),
+		teamIds:               sortedSetStrings(d.Get("teams")),
+	}
+
+	for _, item := range interfaceList(d.Get("routing_rules")) {
+		rule := item.(map[string]interface{})
+		config.routingRules = append(config.routingRules, routingRuleConfig{
+			operator:    rule["operator"].(string),
+			threshold:   rule["threshold"].(int),
+			waitSeconds: rule["wait_seconds"].(float64),
+		})
+	}
+
+	for _, item := range interfaceList(d.Get("bullseye_rings")) {
+		ring := item.(map[string]interface{})
+		config.bullseyeRings = append(config.bullseyeRings, bullseyeRingConfig{
+			expansionTimeoutSeconds: ring["expansion_timeout_seconds"].(float64),
+			skillsToRemove:          sortedSetStrings(ring["skills_to_remove"]),
+			memberGroups:            readMemberGroups(ring["member_groups"]),
+		})
+	}
+
+	// Conditional group routing is managed by its own resource when the feature toggle is set
+	if !featureToggles.CSGToggleExists() {
+		for _, item := range interfaceList(d.Get("conditional_group_routing_rules")) {
+			rule := item.(map[string]interface{})
+			config.cgrRules = append(config.cgrRules, conditionalGroupRoutingRuleConfig{
+				queueId:        rule["queue_id"].(string),
+				operator:       rule["operator"].(string),
+				metric:         rule["metric"].(string),
+				conditionValue: rule["condition_value"].(float64),
+				waitSeconds:    rule["wait_seconds"].(int),
+				groups:         readMemberGroups(rule["groups"]),
+			})
+		}
+	}
+	return config
+}
+
+// referencedIds returns every skill, skill group, group, team and queue ID referenced by the routing settings
+func (c queueRoutingConfig) referencedIds() []string {
+	ids := append(append(append([]string{}, c.skillGroupIds...), c.groupIds...), c.teamIds...)
+	for _, ring := range c.bullseyeRings {
+		ids = append(ids, ring.skillsToRemove...)
+		for _, group := range ring.memberGroups {
+			ids = append(ids, group.id)
+		}
+	}
+	for _, rule := range c.cgrRules {
+		if rule.queueId != "" {
+			ids = append(ids, rule.queueId)
+		}
+		for _, group := range rule.groups {
+			ids = append(ids, group.id)
+		}
+	}
+	return ids
+}
+
+// hasUnknownIds reports whether any referenced ID is not yet known. Unknown values are read as empty strings during a plan.
+func (c queueRoutingConfig) hasUnknownIds() bool {
+	return hasUnknownString(c.referencedIds())
+}
+
+// validate returns every setting the API rejects that can be detected without calling the API
+func (c queueRoutingConfig) validate() error {
+	var errs []error
+
+	assigned := map[string][]string{
+		memberGroupTypeSkillGroup: c.skillGroupIds,
+		memberGroupTypeGroup:      c.groupIds,
+		memberGroupTypeTeam:       c.teamIds,
+	}
+	attrByType := map[string]string{
+		memberGroupTypeSkillGroup: "skill_groups",
+		memberGroupTypeGroup:      "groups",
+		memberGroupTypeTeam:       "teams",
+	}
+	for i, rule := range c.cgrRules {
+		if i == 0 && rule.queueId != "" {
+			errs = append(errs, fmt.Errorf("conditional_group_routing_rules.0.queue_id must not be set, the first rule always evaluates the current queue"))
+		}
+		// Groups activated by conditional group routing must be members of this queue
+		for _, group := range rule.groups {
+			if group.id == "" || hasUnknownString(assigned[group.groupType]) {
+				continue
+			}
+			if !containsString(assigned[group.groupType], group.id) {
+				errs = append(errs, fmt.Errorf("conditional_group_routing_rules.%d.groups references %s %s which is not in the queue's %s", i, group.groupType, group.id, attrByType[group.groupType]))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// warnings returns the settings that the API accepts but that have no effect
+func (c queueRoutingConfig) warnings() []string {
+	var warnings []string
+
+	if c.skillEvaluationMethod == "NONE" {
+		for i, ring := range c.bullseyeRings {
+			if len(ring.skillsToRemove) > 0 {
+				warnings = append(warnings, fmt.Sprintf("bullseye_rings.%d.skills_to_remove has no effect when skill_evaluation_method is NONE because skills are not evaluated", i))
+			}
+		}
+	}
+
+	removedIn := make(map[string]int)
+	for i, ring := range c.bullseyeRings {
+		for _, skillId := range ring.skillsToRemove {
+			if skillId == "" {
+				continue
+			}
+			if removedInRing, removed := removedIn[skillId]; removed {
+				warnings = append(warnings, fmt.Sprintf("bullseye_rings.%d.skills_to_remove contains skill %s which is already removed in bullseye_rings.%d", i, skillId, removedInRing))
+				continue
+			}
+			removedIn[skillId] = i
+		}
+		warnings = append(warnings, findDuplicateMemberGroups(fmt.Sprintf("bullseye_rings.%d.member_groups", i), ring.memberGroups)...)
+	}
+
+	for i, rule := range c.cgrRules {
+		warnings = append(warnings, findDuplicateMemberGroups(fmt.Sprintf("conditional_group_routing_rules.%d.groups", i), rule.groups)...)
+	}
+	return warnings
+}
+
+// unusedSkillWarnings returns the skills removed by bullseye rings that no member of the queue has. Interactions are only
+// offered to members with their skills, so such a skill is not one the members of the queue can be routed on.
+func (c queueRoutingConfig) unusedSkillWarnings(memberSkillIds map[string]bool) []string {
+	var warnings []string
+	for i, ring := range c.bullseyeRings {
+		for _, skillId := range ring.skillsToRemove {
+			if skillId != "" && !memberSkillIds[skillId] {
+				warnings = append(warnings, fmt.Sprintf("bullseye_rings.%d.skills_to_remove references skill %s which no member of the queue has", i, skillId))
+			}
+		}
+	}
+	return warnings
+}
+
+func (c queueRoutingConfig) removesSkills() bool {
+	for _, ring := range c.bullseyeRings {
+		if len(ring.skillsToRemove) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// routingWarnings returns a warning diagnostic for each routing setting of the queue that has no effect, and for each
+// skill removed by a bullseye ring that no member of the queue has. The settings are only checked when the queue is
+// created or its routing settings change.
+func routingWarnings(ctx context.Context, d *schema.ResourceData, proxy *RoutingQueueProxy) diag.Diagnostics {
+	if !d.IsNewResource() && !d.HasChanges(routingAttributes...) {
+		return nil
+	}
+	config := readQueueRoutingConfig(d)
+	name := d.Get("name").(string)
+
+	var diags diag.Diagnostics
+	for _, warning := range config.warnings() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Routing setting of queue %s has no effect", name),
+			Detail:   warning,
+		})
+	}
+
+	// Skills are not evaluated with NONE, which warnings already reports
+	if config.skillEvaluationMethod == "NONE" || !config.removesSkills() {
+		return diags
+	}
+	memberSkillIds, _, err := proxy.getRoutingQueueMemberSkillIds(ctx, d.Id())
+	if err != nil {
+		// Failing to read the skills of the members should not fail the apply
+		log.Printf("Failed to read the skills of the members of queue %s: %v", d.Id(), err)
+		return diags
+	}
+	for _, warning := range config.unusedSkillWarnings(memberSkillIds) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Routing setting of queue %s references a skill no member has", name),
+			Detail:   warning,
+		})
+	}
+	return diags
+}
+
+// validateReferences checks that the skills, skill groups and queues referenced by the routing settings exist. IDs that
+// are not known yet, and IDs that prior already references, are not looked up.
+func (c queueRoutingConfig) validateReferences(ctx context.Context, proxy *RoutingQueueProxy, prior queueRoutingConfig) error {
+	var errs []error
+	checked := make(map[string]bool)
+	for _, id := range prior.referencedIds() {
+		checked[id] = true
+	}
+	check := func(kind, id, attr string, get func() (*platformclientv2.APIResponse, error)) {
+		if id == "" || checked[id] {
+			return
+		}
+		checked[id] = true
+		resp, err := get()
+		if err == nil {
+			return
+		}
+		if util.IsStatus404(resp) {
+			errs = append(errs, fmt.Errorf("%s references %s %s which does not exist", attr, kind, id))
+			return
+		}
+		// Failing to look up a reference should not block the plan, the API will validate it on apply
+		log.Printf("Failed to look up %s %s referenced by %s: %v", kind, id, attr, err)
+	}
+	getSkillGroup := func(id string) func() (*platformclientv2.APIResponse, error) {
+		return func() (*platformclientv2.APIResponse, error) {
+			_, resp, err := proxy.getRoutingSkillGroup(ctx, id)
+			return resp, err
+		}
+	}
+
+	for _, id := range c.skillGroupIds {
+		check("skill group", id, "skill_groups", getSkillGroup(id))
+	}
+	for i, ring := range c.bullseyeRings {
+		for _, id := range ring.skillsToRemove {
+			id := id
+			check("skill", id, fmt.Sprintf("bullseye_rings.%d.skills_to_remove", i), func() (*platformclientv2.APIResponse, error) {
+				_, resp, err := proxy.getRoutingSkill(ctx, id)
+				return resp, err
+			})
+		}
+		for _, group := range ring.memberGroups {
+			if group.groupType == memberGroupTypeSkillGroup {
+				check("skill group", group.id, fmt.Sprintf("bullseye_rings.%d.member_groups", i), getSkillGroup(group.id))
+			}
+		}
+	}
+	for i, rule := range c.cgrRules {
+		if rule.queueId != "" {
+			queueId := rule.queueId
+			check("queue", queueId, fmt.Sprintf("conditional_group_routing_rules.%d.queue_id", i), func() (*platformclientv2.APIResponse, error) {
+				_, resp, err := proxy.getRoutingQueueById(ctx, queueId, true)
+				return resp, err
+			})
+		}
+		for _, group := range rule.groups {
+			if group.groupType == memberGroupTypeSkillGroup {
+				check("skill group", group.id, fmt.Sprintf("conditional_group_routing_rules.%d.groups", i), getSkillGroup(group.id))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// summary describes the effective routing path of an interaction in the queue, in the order the settings are applied
+func (c queueRoutingConfig) summary() string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	switch c.skillEvaluationMethod {
+	case "NONE":
+		add("Skill evaluation: NONE (skills are ignored, any available member can be offered the interaction)")
+	case "BEST":
+		add("Skill evaluation: BEST (members with the most matching skills are offered the interaction first)")
+	default:
+		add("Skill evaluation: %s (members must have all of the interaction's skills)", c.skillEvaluationMethod)
+	}
+
+	var memberGroups []string
+	if len(c.skillGroupIds) > 0 {
+		memberGroups = append(memberGroups, "skill groups "+strings.Join(c.skillGroupIds, ", "))
+	}
+	if len(c.groupIds) > 0 {
+		memberGroups = append(memberGroups, "groups "+strings.Join(c.groupIds, ", "))
+	}
+	if len(c.teamIds) > 0 {
+		memberGroups = append(memberGroups, "teams "+strings.Join(c.teamIds, ", "))
+	}
+	if len(memberGroups) > 0 {
+		add("Member groups: %s", strings.Join(memberGroups, "; "))
+	}
+
+	if len(c.routingRules) > 0 {
+		add("Preferred agent routing:")
+		for i, rule := range c.routingRules {
+			match := "any preferred agent"
+			if rule.operator == "MEETS_THRESHOLD" {
+				match = fmt.Sprintf("preferred agents with a score of at least %d", rule.threshold)
+			}
+			add("  %d. Offer to %s for %ss", i+1, match, formatSeconds(rule.waitSeconds))
+		}
+	}
+
+	if len(c.bullseyeRings) > 0 {
+		add("Bullseye routing:")
+		for i, ring := range c.bullseyeRings {
+			description := fmt.Sprintf("  Ring %d: offer for %ss", i+1, formatSeconds(ring.expansionTimeoutSeconds))
+			if len(ring.memberGroups) > 0 {
+				description += " to " + formatMemberGroups(ring.memberGroups)
+			}
+			if len(ring.skillsToRemove) > 0 {
+				description += ", then remove skills " + strings.Join(ring.skillsToRemove, ", ")
+			}
+			add(description)
+		}
+		add("  Ring %d: offer to all matching members", len(c.bullseyeRings)+1)
+	}
+
+	if len(c.cgrRules) > 0 {
+		add("Conditional group routing:")
+		for i, rule := range c.cgrRules {
+			queue := "this queue"
+			if rule.queueId != "" {
+				queue = "queue " + rule.queueId
+			}
+			description := fmt.Sprintf("  Rule %d: when %s %s %s %s, add %s", i+1, queue, rule.metric, rule.operator,
+				formatSeconds(rule.conditionValue), formatMemberGroups(rule.groups))
+			if i < len(c.cgrRules)-1 {
+				description += fmt.Sprintf(", then wait %ds", rule.waitSeconds)
+			}
+			add(description)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// customizeRoutingQueueDiff validates the routing settings of the queue and plans the routing_summary so it can be reviewed with the plan
+func customizeRoutingQueueDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := readQueueRoutingConfig(diff)
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	if meta != nil && (diff.Id() == "" || diff.HasChanges(routingAttributes...)) {
+		sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+		if err := config.validateReferences(ctx, GetRoutingQueueProxy(sdkConfig), readQueueRoutingConfig(priorRoutingConfigGetter{diff})); err != nil {
+			return err
+		}
+	}
+
+	if config.hasUnknownIds() || !cgrQueueIdsKnown(diff, len(config.cgrRules)) {
+		return diff.SetNewComputed("routing_summary")
+	}
+	if summary := config.summary(); summary != diff.Get("routing_summary").(string) {
+		return diff.SetNew("routing_summary", summary)
+	}
+	return nil
+}
+
+// cgrQueueIdsKnown reports whether the queue_id of every conditional group routing rule after the first is known.
+// queue_id is optional, so an unknown value cannot be told apart from an unset one by its value.
+func cgrQueueIdsKnown(diff *schema.ResourceDiff, ruleCount int) bool {
+	for i := 1; i < ruleCount; i++ {
+		if !diff.NewValueKnown(fmt.Sprintf("conditional_group_routing_rules.%d.queue_id", i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func readMemberGroups(value interface{}) []memberGroupConfig {
+	var groups []memberGroupConfig
+	for _, item := range interfaceList(value) {
+		group := item.(map[string]interface{})
+		groups = append(groups, memberGroupConfig{
+			id:        group["member_group_id"].(string),
+			groupType: group["member_group_type"].(string),
+		})
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].groupType != groups[j].groupType {
+			return groups[i].groupType < groups[j].groupType
+		}
+		return groups[i].id < groups[j].id
+	})
+	return groups
+}
+
+func findDuplicateMemberGroups(attr string, groups []memberGroupConfig) []string {
+	var duplicates []string
+	seen := make(map[memberGroupConfig]bool)
+	for _, group := range groups {
+		if group.id == "" {
+			continue
+		}
+		if seen[group] {
+			duplicates = append(duplicates, fmt.Sprintf("%s contains %s %s more than once", attr, group.groupType, group.id))
+		}
+		seen[group] = true
+	}
+	return duplicates
+}
+
+func formatMemberGroups(groups []memberGroupConfig) string {
+	formatted := make([]string, 0, len(groups))
+	for _, group := range groups {
+		formatted = append(formatted, group.groupType+" "+group.id)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
+
+// interfaceList returns the items of a list or set attribute
+func interfaceList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+func sortedSetStrings(value interface{}) []string {
+	var values []string
+	for _, item := range interfaceList(value) {
+		values = append(values, item.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func hasUnknownString(values []string) bool {
+	return containsString(values, "")
+}
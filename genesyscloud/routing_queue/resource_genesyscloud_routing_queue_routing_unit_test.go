@@ -0,0 +1,231 @@
+package routing_queue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildRoutingConfigResourceData(t *testing.T, resourceDataMap map[string]interface{}) *schema.ResourceData {
+	resourceDataMap["name"] = "Routing Queue"
+	return schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, resourceDataMap)
+}
+
+func TestUnitReadQueueRoutingConfigAndSummary(t *testing.T) {
+	d := buildRoutingConfigResourceData(t, map[string]interface{}{
+		"skill_evaluation_method": "BEST",
+		"skill_groups":            []interface{}{"skill-group-2", "skill-group-1"},
+		"groups":                  []interface{}{"group-1"},
+		"routing_rules": []interface{}{
+			map[string]interface{}{"operator": "MEETS_THRESHOLD", "threshold": 90, "wait_seconds": 30.0},
+			map[string]interface{}{"operator": "ANY", "wait_seconds": 5.5},
+		},
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 10.0,
+				"skills_to_remove":          []interface{}{"skill-1"},
+				"member_groups": []interface{}{
+					map[string]interface{}{"member_group_id": "group-1", "member_group_type": "GROUP"},
+				},
+			},
+		},
+		"conditional_group_routing_rules": []interface{}{
+			map[string]interface{}{
+				"operator":        "GreaterThan",
+				"metric":          "EstimatedWaitTime",
+				"condition_value": 30.0,
+				"wait_seconds":    2,
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "skill-group-1", "member_group_type": "SKILLGROUP"},
+				},
+			},
+			map[string]interface{}{
+				"queue_id":        "queue-2",
+				"operator":        "LessThan",
+				"metric":          "ServiceLevel",
+				"condition_value": 0.8,
+				"wait_seconds":    2,
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "group-1", "member_group_type": "GROUP"},
+				},
+			},
+		},
+	})
+
+	config := readQueueRoutingConfig(d)
+	assert.Equal(t, []string{"skill-group-1", "skill-group-2"}, config.skillGroupIds)
+	assert.False(t, config.hasUnknownIds())
+	assert.NoError(t, config.validate())
+
+	expected := `Skill evaluation: BEST (members with the most matching skills are offered the interaction first)
+Member groups: skill groups skill-group-1, skill-group-2; groups group-1
+Preferred agent routing:
+  1. Offer to preferred agents with a score of at least 90 for 30s
+  2. Offer to any preferred agent for 5.5s
+Bullseye routing:
+  Ring 1: offer for 10s to GROUP group-1, then remove skills skill-1
+  Ring 2: offer to all matching members
+Conditional group routing:
+  Rule 1: when this queue EstimatedWaitTime GreaterThan 30, add SKILLGROUP skill-group-1, then wait 2s
+  Rule 2: when queue queue-2 ServiceLevel LessThan 0.8, add GROUP group-1`
+	assert.Equal(t, expected, config.summary())
+}
+
+func TestUnitQueueRoutingConfigValidate(t *testing.T) {
+	config := queueRoutingConfig{
+		skillEvaluationMethod: "NONE",
+		groupIds:              []string{"group-1"},
+		bullseyeRings: []bullseyeRingConfig{
+			{expansionTimeoutSeconds: 10, skillsToRemove: []string{"skill-1"}},
+			{
+				expansionTimeoutSeconds: 10,
+				skillsToRemove:          []string{"skill-1"},
+				memberGroups:            []memberGroupConfig{{id: "group-1", groupType: "GROUP"}, {id: "group-1", groupType: "GROUP"}},
+			},
+		},
+		cgrRules: []conditionalGroupRoutingRuleConfig{
+			{queueId: "queue-1", groups: []memberGroupConfig{{id: "group-2", groupType: "GROUP"}}},
+			{queueId: "queue-2", groups: []memberGroupConfig{{id: "team-1", groupType: "TEAM"}}},
+		},
+	}
+
+	err := config.validate()
+	assert.ErrorContains(t, err, "conditional_group_routing_rules.0.queue_id must not be set")
+	assert.ErrorContains(t, err, "conditional_group_routing_rules.0.groups references GROUP group-2 which is not in the queue's groups")
+	assert.ErrorContains(t, err, "conditional_group_routing_rules.1.groups references TEAM team-1 which is not in the queue's teams")
+	assert.NotContains(t, err.Error(), "bullseye_rings")
+
+	// Settings that have no effect are only warnings
+	assert.Equal(t, []string{
+		"bullseye_rings.0.skills_to_remove has no effect when skill_evaluation_method is NONE because skills are not evaluated",
+		"bullseye_rings.1.skills_to_remove has no effect when skill_evaluation_method is NONE because skills are not evaluated",
+		"bullseye_rings.1.skills_to_remove contains skill skill-1 which is already removed in bullseye_rings.0",
+		"bullseye_rings.1.member_groups contains GROUP group-1 more than once",
+	}, config.warnings())
+
+	// Member groups that are not known yet are not reported
+	config = queueRoutingConfig{
+		skillEvaluationMethod: "ALL",
+		groupIds:              []string{""},
+		cgrRules:              []conditionalGroupRoutingRuleConfig{{groups: []memberGroupConfig{{id: "group-2", groupType: "GROUP"}}}},
+	}
+	assert.NoError(t, config.validate())
+	assert.True(t, config.hasUnknownIds())
+}
+
+func TestUnitQueueRoutingConfigValidateReferences(t *testing.T) {
+	notFound := &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}
+	found := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	lookups := make(map[string]int)
+
+	proxy := &RoutingQueueProxy{}
+	proxy.getRoutingSkillAttr = func(ctx context.Context, p *RoutingQueueProxy, skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+		lookups[skillId]++
+		if skillId == "missing-skill" {
+			return nil, notFound, assert.AnError
+		}
+		return &platformclientv2.Routingskill{Id: &skillId}, found, nil
+	}
+	proxy.getRoutingSkillGroupAttr = func(ctx context.Context, p *RoutingQueueProxy, skillGroupId string) (*platformclientv2.Skillgroup, *platformclientv2.APIResponse, error) {
+		lookups[skillGroupId]++
+		if skillGroupId == "missing-skill-group" {
+			return nil, notFound, assert.AnError
+		}
+		return &platformclientv2.Skillgroup{Id: &skillGroupId}, found, nil
+	}
+	proxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string, checkCache bool) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		lookups[queueId]++
+		if queueId == "unavailable-queue" {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusInternalServerError}, assert.AnError
+		}
+		return nil, notFound, assert.AnError
+	}
+
+	config := queueRoutingConfig{
+		skillGroupIds: []string{"skill-group-1", "missing-skill-group"},
+		bullseyeRings: []bullseyeRingConfig{
+			{skillsToRemove: []string{"skill-1", "missing-skill"}},
+			{memberGroups: []memberGroupConfig{{id: "skill-group-1", groupType: "SKILLGROUP"}}},
+		},
+		cgrRules: []conditionalGroupRoutingRuleConfig{
+			{},
+			{queueId: "missing-queue"},
+			{queueId: "unavailable-queue"},
+		},
+	}
+
+	err := config.validateReferences(context.Background(), proxy, queueRoutingConfig{})
+	assert.ErrorContains(t, err, "skill_groups references skill group missing-skill-group which does not exist")
+	assert.ErrorContains(t, err, "bullseye_rings.0.skills_to_remove references skill missing-skill which does not exist")
+	assert.ErrorContains(t, err, "conditional_group_routing_rules.1.queue_id references queue missing-queue which does not exist")
+	assert.NotContains(t, err.Error(), "unavailable-queue")
+
+	// Each reference is only looked up once
+	assert.Equal(t, 1, lookups["skill-group-1"])
+
+	// References that are already in state are not looked up again
+	lookups = make(map[string]int)
+	prior := queueRoutingConfig{
+		skillGroupIds: []string{"skill-group-1", "missing-skill-group"},
+		bullseyeRings: []bullseyeRingConfig{{skillsToRemove: []string{"skill-1", "missing-skill"}}},
+	}
+	err = config.validateReferences(context.Background(), proxy, prior)
+	assert.ErrorContains(t, err, "conditional_group_routing_rules.1.queue_id references queue missing-queue which does not exist")
+	assert.NotContains(t, err.Error(), "missing-skill")
+	assert.Equal(t, map[string]int{"missing-queue": 1, "unavailable-queue": 1}, lookups)
+}
+
+func TestUnitRoutingWarnings(t *testing.T) {
+	d := buildRoutingConfigResourceData(t, map[string]interface{}{
+		"skill_evaluation_method": "NONE",
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 10.0,
+				"skills_to_remove":          []interface{}{"skill-1"},
+			},
+		},
+	})
+
+	// Skills are not evaluated with NONE, so the skills of the members are not read
+	diags := routingWarnings(context.Background(), d, nil)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Routing setting of queue Routing Queue has no effect", diags[0].Summary)
+	assert.Equal(t, "bullseye_rings.0.skills_to_remove has no effect when skill_evaluation_method is NONE because skills are not evaluated", diags[0].Detail)
+}
+
+func TestUnitRoutingWarningsMemberSkills(t *testing.T) {
+	proxy := &RoutingQueueProxy{}
+	proxy.getRoutingQueueMemberSkillIdsAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string) (map[string]bool, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "queue-1", queueId)
+		return map[string]bool{"skill-1": true}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	d := buildRoutingConfigResourceData(t, map[string]interface{}{
+		"skill_evaluation_method": "ALL",
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 10.0,
+				"skills_to_remove":          []interface{}{"skill-1", "skill-2"},
+			},
+		},
+	})
+	d.SetId("queue-1")
+
+	diags := routingWarnings(context.Background(), d, proxy)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Routing setting of queue Routing Queue references a skill no member has", diags[0].Summary)
+	assert.Equal(t, "bullseye_rings.0.skills_to_remove references skill skill-2 which no member of the queue has", diags[0].Detail)
+
+	// Failing to read the skills of the members does not fail the apply
+	proxy.getRoutingQueueMemberSkillIdsAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string) (map[string]bool, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusInternalServerError}, assert.AnError
+	}
+	assert.Empty(t, routingWarnings(context.Background(), d, proxy))
+}
@@ -4,6 +4,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -160,7 +161,7 @@ var (
 
 func ResourceRoutingQueue() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud Routing Queue",
+		Description: "Genesys Cloud Routing Queue. Routing settings the API rejects, such as conditional group routing groups that are not member groups of the queue or references to skills that do not exist, fail the plan. Referenced skills, skill groups and queues are only looked up when they are added. Routing settings that have no effect, such as `bullseye_rings.skills_to_remove` when `skill_evaluation_method` is NONE, and skills in `bullseye_rings.skills_to_remove` that no member of the queue has are reported as warnings when the queue is created or updated.",
 
 		CreateContext: provider.CreateWithPooledClient(createRoutingQueue),
 		ReadContext:   provider.ReadWithPooledClient(readRoutingQueue),
@@ -169,6 +170,7 @@ func ResourceRoutingQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeRoutingQueueDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -429,9 +431,10 @@ func ResourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 			},
 			"calling_party_number": {
-				Description: "The phone number to use for caller identification for outbound calls from this queue.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The phone number to use for caller identification for outbound calls from this queue. Phone number must be in an E.164 number format.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validators.ValidatePhoneNumber,
 			},
 			"scoring_method": {
 				Description:  "The Scoring Method for the queue. Defaults to TimestampAndPriority.",
@@ -522,6 +525,11 @@ func ResourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"routing_summary": {
+				Description: "A description of the effective routing path an interaction takes through the queue, built from `skill_evaluation_method`, the member groups, `routing_rules`, `bullseye_rings` and `conditional_group_routing_rules`. It is computed at plan time so routing changes can be reviewed with the plan.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
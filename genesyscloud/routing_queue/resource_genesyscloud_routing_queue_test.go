@@ -51,7 +51,7 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 		skillEvalAll             = "ALL"
 		skillEvalBest            = "BEST"
 		callingPartyName         = "Acme"
-		callingPartyNumber       = "3173416548"
+		callingPartyNumber       = "+13173416548"
 		scoringMethod            = "TimestampAndPriority"
 		queueSkillResource       = "test-queue-skill"
 		queueSkillName           = "Terraform Skill " + uuid.NewString()
@@ -126,6 +126,7 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, util.FalseValue, slPercent1, slDuration1),
 					validateBullseyeSettings(queueResource1, 1, alertTimeout1, "genesyscloud_routing_skill."+queueSkillResource),
 					validateRoutingRules(queueResource1, 0, routingRuleOpAny, "50", "5"),
+					resource.TestCheckResourceAttrSet("genesyscloud_routing_queue."+queueResource1, "routing_summary"),
 					validateAgentOwnedRouting(queueResource1, "agent_owned_routing", util.TrueValue, callbackHours, callbackHours),
 					func(s *terraform.State) error {
 						rs, ok := s.RootModule().Resources["genesyscloud_user."+testUserResource]
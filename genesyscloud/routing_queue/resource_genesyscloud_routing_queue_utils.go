@@ -590,7 +590,7 @@ func FlattenQueueEmailAddress(settings platformclientv2.Queueemailaddress) map[s
 }
 
 func flattenQueueMembers(queueID string, memberBy string, sdkConfig *platformclientv2.Configuration) (*schema.Set, diag.Diagnostics) {
-	members, err := getRoutingQueueMembers(queueID, memberBy, sdkConfig)
+	members, err := GetRoutingQueueMembers(queueID, memberBy, sdkConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,142 @@
+package routing_queue_members
+
+import (
+	"context"
+	"fmt"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_queue_members_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingQueueMembersProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getRoutingQueueFunc func(ctx context.Context, p *routingQueueMembersProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueUserMembersFunc func(ctx context.Context, p *routingQueueMembersProxy, queueId string) ([]platformclientv2.Queuemember, diag.Diagnostics)
+type addOrRemoveRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueMembersProxy, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error)
+type updateRoutingQueueMemberRingNumFunc func(ctx context.Context, p *routingQueueMembersProxy, queueId, userId string, ringNum int) (*platformclientv2.APIResponse, error)
+type searchUsersFunc func(ctx context.Context, p *routingQueueMembersProxy, criteria []platformclientv2.Usersearchcriteria, expand []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+
+// routingQueueMembersProxy contains all of the methods that call genesys cloud APIs.
+type routingQueueMembersProxy struct {
+	clientConfig                        *platformclientv2.Configuration
+	routingApi                          *platformclientv2.RoutingApi
+	usersApi                            *platformclientv2.UsersApi
+	getRoutingQueueAttr                 getRoutingQueueFunc
+	getRoutingQueueUserMembersAttr      getRoutingQueueUserMembersFunc
+	addOrRemoveRoutingQueueMembersAttr  addOrRemoveRoutingQueueMembersFunc
+	updateRoutingQueueMemberRingNumAttr updateRoutingQueueMemberRingNumFunc
+	searchUsersAttr                     searchUsersFunc
+}
+
+// newRoutingQueueMembersProxy initializes the routing queue members proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingQueueMembersProxy(clientConfig *platformclientv2.Configuration) *routingQueueMembersProxy {
+	return &routingQueueMembersProxy{
+		clientConfig:                        clientConfig,
+		routingApi:                          platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		usersApi:                            platformclientv2.NewUsersApiWithConfig(clientConfig),
+		getRoutingQueueAttr:                 getRoutingQueueFn,
+		getRoutingQueueUserMembersAttr:      getRoutingQueueUserMembersFn,
+		addOrRemoveRoutingQueueMembersAttr:  addOrRemoveRoutingQueueMembersFn,
+		updateRoutingQueueMemberRingNumAttr: updateRoutingQueueMemberRingNumFn,
+		searchUsersAttr:                     searchUsersFn,
+	}
+}
+
+// getRoutingQueueMembersProxy acts as a singleton to for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingQueueMembersProxy(clientConfig *platformclientv2.Configuration) *routingQueueMembersProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingQueueMembersProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getRoutingQueue returns the queue the members belong to
+func (p *routingQueueMembersProxy) getRoutingQueue(ctx context.Context, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueAttr(ctx, p, queueId)
+}
+
+// getRoutingQueueUserMembers returns the members of a queue that were added as users
+func (p *routingQueueMembersProxy) getRoutingQueueUserMembers(ctx context.Context, queueId string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+	return p.getRoutingQueueUserMembersAttr(ctx, p, queueId)
+}
+
+// addOrRemoveRoutingQueueMembers adds or removes a batch of up to 100 users in a queue
+func (p *routingQueueMembersProxy) addOrRemoveRoutingQueueMembers(ctx context.Context, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error) {
+	return p.addOrRemoveRoutingQueueMembersAttr(ctx, p, queueId, body, delete)
+}
+
+// updateRoutingQueueMemberRingNum sets the ring number of a single queue member
+func (p *routingQueueMembersProxy) updateRoutingQueueMemberRingNum(ctx context.Context, queueId, userId string, ringNum int) (*platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueMemberRingNumAttr(ctx, p, queueId, userId, ringNum)
+}
+
+// searchUsers returns every user matching all the search criteria
+func (p *routingQueueMembersProxy) searchUsers(ctx context.Context, criteria []platformclientv2.Usersearchcriteria, expand []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.searchUsersAttr(ctx, p, criteria, expand)
+}
+
+// getRoutingQueueFn is the implementation for retrieving the queue the members belong to
+func getRoutingQueueFn(_ context.Context, p *routingQueueMembersProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.routingApi.GetRoutingQueue(queueId)
+}
+
+// getRoutingQueueUserMembersFn is the implementation for retrieving the user members of a queue
+func getRoutingQueueUserMembersFn(_ context.Context, p *routingQueueMembersProxy, queueId string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+	return routingQueue.GetRoutingQueueMembers(queueId, "user", p.clientConfig)
+}
+
+// addOrRemoveRoutingQueueMembersFn is the implementation for adding or removing a batch of queue members
+func addOrRemoveRoutingQueueMembersFn(_ context.Context, p *routingQueueMembersProxy, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.PostRoutingQueueMembers(queueId, body, delete)
+}
+
+// updateRoutingQueueMemberRingNumFn is the implementation for updating the ring number of a queue member
+func updateRoutingQueueMemberRingNumFn(_ context.Context, p *routingQueueMembersProxy, queueId, userId string, ringNum int) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.PatchRoutingQueueMember(queueId, userId, platformclientv2.Queuemember{
+		Id:         &userId,
+		RingNumber: &ringNum,
+	})
+}
+
+// searchUsersFn is the implementation for searching users. It pages through all the results.
+func searchUsersFn(_ context.Context, p *routingQueueMembersProxy, criteria []platformclientv2.Usersearchcriteria, expand []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	var (
+		allUsers []platformclientv2.User
+		pageSize = 100
+	)
+
+	for pageNum := 1; ; pageNum++ {
+		pageNumber := pageNum
+		body := platformclientv2.Usersearchrequest{
+			PageSize:   &pageSize,
+			PageNumber: &pageNumber,
+			Query:      &criteria,
+		}
+		if len(expand) > 0 {
+			body.Expand = &expand
+		}
+
+		results, resp, err := p.usersApi.PostUsersSearch(body)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to search users: %s", err)
+		}
+		if results.Results == nil || len(*results.Results) == 0 {
+			return &allUsers, resp, nil
+		}
+		allUsers = append(allUsers, *results.Results...)
+
+		if results.PageCount == nil || pageNum >= *results.PageCount {
+			return &allUsers, resp, nil
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package routing_queue_members
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_routing_queue_members.go contains all the methods that perform the core logic for the resource.
+*/
+
+// createRoutingQueueMembers is used by the routing_queue_members resource to add members to a queue
+func createRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+	d.SetId(queueId + "/members") // Adding /members to the id so the id doesn't conflict with the id of the routing queue these members belong to
+
+	log.Printf("Creating members for queue %s", queueId)
+	return updateRoutingQueueMembers(ctx, d, meta)
+}
+
+// readRoutingQueueMembers is used by the routing_queue_members resource to read the members of a queue
+func readRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMembersProxy(sdkConfig)
+	queueId := strings.Split(d.Id(), "/")[0]
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		log.Printf("Reading members of queue %s", queueId)
+		if _, resp, err := proxy.getRoutingQueue(ctx, queueId); err != nil {
+			if util.IsStatus404(resp) {
+				// The members went away with a queue deleted outside of Terraform
+				d.SetId("")
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read queue %s | error: %s", queueId, err), resp))
+		}
+
+		sdkMembers, diagErr := proxy.getRoutingQueueUserMembers(ctx, queueId)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read members of queue %s | error: %v", queueId, diagErr))
+		}
+		existing := queueMembersToRingNums(sdkMembers)
+
+		mode, _ := d.Get("mode").(string)
+		if mode == "" {
+			mode = modeAuthoritative
+		}
+
+		_ = d.Set("queue_id", queueId)
+		_ = d.Set("mode", mode)
+		_ = d.Set("managed_member_ids", lists.StringListToSet(getManagedMemberIds(mode, existing, d.Get("managed_member_ids").(*schema.Set))))
+		if membersSet, ok := d.Get("members").(*schema.Set); ok && membersSet.Len() > 0 {
+			_ = d.Set("members", flattenConfiguredMembers(membersSet, existing))
+		}
+
+		log.Printf("Read %d members of queue %s", len(existing), queueId)
+		return nil
+	})
+}
+
+// updateRoutingQueueMembers is used by the routing_queue_members resource to reconcile the members of a queue
+func updateRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMembersProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	mode := d.Get("mode").(string)
+
+	desired, err := resolveDesiredMembers(ctx, d, proxy)
+	if err != nil {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("failed to resolve members for queue %s", queueId), err)
+	}
+
+	sdkMembers, diagErr := proxy.getRoutingQueueUserMembers(ctx, queueId)
+	if diagErr != nil {
+		return diagErr
+	}
+	existing := queueMembersToRingNums(sdkMembers)
+
+	oldManaged, _ := d.GetChange("managed_member_ids")
+	toAdd, toRemove := getMemberChanges(mode, existing, desired, *lists.SetToStringList(oldManaged.(*schema.Set)))
+
+	log.Printf("Updating members for queue %s: %d to add, %d to remove", queueId, len(toAdd), len(toRemove))
+	chunkSize := d.Get("chunk_size").(int)
+	if diagErr := postRoutingQueueMembers(ctx, proxy, queueId, toRemove, true, chunkSize); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := postRoutingQueueMembers(ctx, proxy, queueId, toAdd, false, chunkSize); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := updateRingNumbers(ctx, proxy, queueId, existing, desired); diagErr != nil {
+		return diagErr
+	}
+
+	_ = d.Set("managed_member_ids", lists.StringListToSet(stringmap.SortedKeys(desired)))
+	log.Printf("Updated members for queue %s", queueId)
+	return readRoutingQueueMembers(ctx, d, meta)
+}
+
+// deleteRoutingQueueMembers is used by the routing_queue_members resource to remove the managed members from a queue
+func deleteRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMembersProxy(sdkConfig)
+	queueId := strings.Split(d.Id(), "/")[0]
+
+	if _, resp, err := proxy.getRoutingQueue(ctx, queueId); err != nil {
+		if util.IsStatus404(resp) {
+			log.Printf("Queue %s already deleted", queueId)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read queue %s | error: %s", queueId, err), resp)
+	}
+
+	sdkMembers, diagErr := proxy.getRoutingQueueUserMembers(ctx, queueId)
+	if diagErr != nil {
+		return diagErr
+	}
+	existing := queueMembersToRingNums(sdkMembers)
+
+	var toRemove []string
+	for _, userId := range *lists.SetToStringList(d.Get("managed_member_ids").(*schema.Set)) {
+		if _, found := existing[userId]; found {
+			toRemove = append(toRemove, userId)
+		}
+	}
+
+	log.Printf("Removing %d managed members from queue %s", len(toRemove), queueId)
+	if diagErr := postRoutingQueueMembers(ctx, proxy, queueId, toRemove, true, d.Get("chunk_size").(int)); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed managed members from queue %s", queueId)
+	return nil
+}
+
+// customizeRoutingQueueMembersDiff resolves the file and selector members at plan time so that changes to them show up in the plan
+func customizeRoutingQueueMembersDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("members") || !diff.NewValueKnown("members_file") || !diff.NewValueKnown("member_selector") {
+		return diff.SetNewComputed("managed_member_ids")
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMembersProxy(sdkConfig)
+
+	desired, err := resolveDesiredMembers(ctx, diff, proxy)
+	if err != nil {
+		return err
+	}
+
+	desiredIds := stringmap.SortedKeys(desired)
+	currentIds := *lists.SetToStringList(diff.Get("managed_member_ids").(*schema.Set))
+	if diff.Id() == "" || !lists.AreEquivalent(desiredIds, currentIds) {
+		return diff.SetNew("managed_member_ids", desiredIds)
+	}
+	return nil
+}
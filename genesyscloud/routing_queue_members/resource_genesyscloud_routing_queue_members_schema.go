@@ -0,0 +1,133 @@
+package routing_queue_members
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const resourceName = "genesyscloud_routing_queue_members"
+
+const (
+	modeAuthoritative = "authoritative"
+	modeAdditive      = "additive"
+)
+
+// SetRegistrar registers all the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceRoutingQueueMembers())
+}
+
+var (
+	queueMemberResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "User ID",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for this user in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+
+	memberSelectorResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"department": {
+				Description: "Select active users whose department matches this value exactly.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location_ids": {
+				Description: "Select active users placed in any of these locations.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"skill_ids": {
+				Description: "Select active users that have any of these routing skills.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for the users selected by this selector.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+)
+
+// ResourceRoutingQueueMembers registers the genesyscloud_routing_queue_members resource with Terraform
+func ResourceRoutingQueueMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Members. Manages the user membership of a queue separately from the queue's configuration.
+In authoritative mode any user member not declared by this resource is removed from the queue. In additive mode only the members added by this resource are managed.
+Do not combine this resource with the 'members' attribute of the genesyscloud_routing_queue resource for the same queue.`,
+
+		CreateContext: provider.CreateWithPooledClient(createRoutingQueueMembers),
+		ReadContext:   provider.ReadWithPooledClient(readRoutingQueueMembers),
+		UpdateContext: provider.UpdateWithPooledClient(updateRoutingQueueMembers),
+		DeleteContext: provider.DeleteWithPooledClient(deleteRoutingQueueMembers),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeRoutingQueueMembersDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue whose members are managed.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "Membership management mode. Valid values: authoritative, additive.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      modeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{modeAuthoritative, modeAdditive}, false),
+			},
+			"members": {
+				Description: "Users to add to the queue. A ring number set here takes precedence over the file and selectors.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        queueMemberResource,
+			},
+			"members_file": {
+				Description: "Path or URL to a CSV file of members. The file must have a 'user_id' column and may have a 'ring_num' column.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"member_selector": {
+				Description: "Selects users by attribute. Users matching any selector are added to the queue. Within a selector all of the set criteria must match.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        memberSelectorResource,
+			},
+			"chunk_size": {
+				Description:  "Number of members added or removed per API call.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"managed_member_ids": {
+				Description: "IDs of the users whose queue membership is managed by this resource.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package routing_queue_members
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"terraform-provider-genesyscloud/genesyscloud/user"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRoutingQueueMembers(t *testing.T) {
+	var (
+		membersResource = "test-queue-members"
+		queueResource   = "test-queue"
+		queueName       = "Terraform Test Queue-" + uuid.NewString()
+		userResource1   = "test-user-1"
+		userResource2   = "test-user-2"
+		userEmail1      = "terraform-" + uuid.NewString() + "@example.com"
+		userEmail2      = "terraform-" + uuid.NewString() + "@example.com"
+	)
+
+	baseConfig := routingQueue.GenerateRoutingQueueResourceBasic(queueResource, queueName) +
+		user.GenerateBasicUserResource(userResource1, userEmail1, "Terraform Queue Member 1") +
+		user.GenerateBasicUserResource(userResource2, userEmail2, "Terraform Queue Member 2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, nil),
+		Steps: []resource.TestStep{
+			{
+				// Create with a single member
+				Config: baseConfig + generateRoutingQueueMembersResource(
+					membersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					modeAdditive,
+					generateQueueMember("genesyscloud_user."+userResource1+".id", "1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue_members."+membersResource, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+membersResource, "mode", modeAdditive),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+membersResource, "managed_member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_routing_queue_members."+membersResource, "managed_member_ids.*", "genesyscloud_user."+userResource1, "id"),
+				),
+			},
+			{
+				// Add a second member on a different ring
+				Config: baseConfig + generateRoutingQueueMembersResource(
+					membersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					modeAuthoritative,
+					generateQueueMember("genesyscloud_user."+userResource1+".id", "1"),
+					generateQueueMember("genesyscloud_user."+userResource2+".id", "3"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+membersResource, "mode", modeAuthoritative),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+membersResource, "managed_member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_routing_queue_members."+membersResource, "managed_member_ids.*", "genesyscloud_user."+userResource2, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_routing_queue_members."+membersResource, "members.*", map[string]string{"ring_num": "3"}),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_routing_queue_members." + membersResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"members", "chunk_size"},
+			},
+		},
+	})
+}
+
+func generateRoutingQueueMembersResource(resourceId, queueId, mode string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_members" "%s" {
+		queue_id = %s
+		mode     = "%s"
+		%s
+	}
+	`, resourceId, queueId, mode, strings.Join(nestedBlocks, "\n"))
+}
+
+func generateQueueMember(userId, ringNum string) string {
+	return fmt.Sprintf(`members {
+		user_id  = %s
+		ring_num = %s
+	}
+	`, userId, ringNum)
+}
@@ -0,0 +1,41 @@
+package routing_queue_members
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitReadRoutingQueueMembersQueueDeleted(t *testing.T) {
+	queueId := uuid.NewString()
+
+	membersProxy := &routingQueueMembersProxy{}
+	membersProxy.getRoutingQueueAttr = func(ctx context.Context, p *routingQueueMembersProxy, id string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+	membersProxy.getRoutingQueueUserMembersAttr = func(ctx context.Context, p *routingQueueMembersProxy, id string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+		t.Errorf("the members of deleted queue %s should not be read", id)
+		return nil, nil
+	}
+	internalProxy = membersProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueMembers().Schema, map[string]interface{}{
+		"queue_id": queueId,
+	})
+	d.SetId(queueId + "/members")
+
+	diags := readRoutingQueueMembers(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), "%v", diags)
+	// The resource is removed from state instead of failing every plan
+	assert.Equal(t, "", d.Id())
+}
@@ -0,0 +1,332 @@
+package routing_queue_members
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_members_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// resourceGetter is satisfied by both *schema.ResourceData and *schema.ResourceDiff
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// resolveDesiredMembers merges the members, members_file and member_selector attributes into a map of user ID to ring number.
+// Ring numbers from explicit members take precedence over the file, which takes precedence over the selectors.
+func resolveDesiredMembers(ctx context.Context, d resourceGetter, proxy *routingQueueMembersProxy) (map[string]int, error) {
+	desired := make(map[string]int)
+
+	if selectors, ok := d.Get("member_selector").([]interface{}); ok {
+		for _, selector := range selectors {
+			selectorMap, ok := selector.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			userIds, err := resolveMemberSelector(ctx, selectorMap, proxy)
+			if err != nil {
+				return nil, err
+			}
+			ringNum := selectorMap["ring_num"].(int)
+			for _, userId := range userIds {
+				if existing, found := desired[userId]; !found || ringNum < existing {
+					desired[userId] = ringNum
+				}
+			}
+		}
+	}
+
+	if filePath, _ := d.Get("members_file").(string); filePath != "" {
+		reader, file, err := files.DownloadOrOpenFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open members file %s: %v", filePath, err)
+		}
+		if file != nil {
+			defer file.Close()
+		}
+		fileMembers, err := parseMembersCsv(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse members file %s: %v", filePath, err)
+		}
+		for userId, ringNum := range fileMembers {
+			desired[userId] = ringNum
+		}
+	}
+
+	if membersSet, ok := d.Get("members").(*schema.Set); ok {
+		for _, member := range membersSet.List() {
+			memberMap := member.(map[string]interface{})
+			desired[memberMap["user_id"].(string)] = memberMap["ring_num"].(int)
+		}
+	}
+
+	return desired, nil
+}
+
+// parseMembersCsv reads a CSV with a required user_id column and an optional ring_num column
+func parseMembersCsv(reader io.Reader) (map[string]int, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, err
+	}
+
+	userIdCol, ringNumCol := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "user_id":
+			userIdCol = i
+		case "ring_num":
+			ringNumCol = i
+		}
+	}
+	if userIdCol == -1 {
+		return nil, fmt.Errorf("missing required column 'user_id'")
+	}
+
+	members := make(map[string]int)
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return members, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		userId := strings.TrimSpace(record[userIdCol])
+		if userId == "" {
+			continue
+		}
+
+		ringNum := 1
+		if ringNumCol != -1 && strings.TrimSpace(record[ringNumCol]) != "" {
+			ringNum, err = strconv.Atoi(strings.TrimSpace(record[ringNumCol]))
+			if err != nil || ringNum < 1 || ringNum > 6 {
+				return nil, fmt.Errorf("line %d: ring_num must be a number between 1 and 6, got '%s'", line, record[ringNumCol])
+			}
+		}
+		members[userId] = ringNum
+	}
+}
+
+// resolveMemberSelector searches for the active users matching every criteria of a member_selector block
+func resolveMemberSelector(ctx context.Context, selector map[string]interface{}, proxy *routingQueueMembersProxy) ([]string, error) {
+	department, _ := selector["department"].(string)
+	locationIds := setToStringListOrEmpty(selector["location_ids"])
+	skillIds := setToStringListOrEmpty(selector["skill_ids"])
+
+	if department == "" && len(locationIds) == 0 && len(skillIds) == 0 {
+		return nil, fmt.Errorf("member_selector must set at least one of department, location_ids or skill_ids")
+	}
+
+	criteria := []platformclientv2.Usersearchcriteria{
+		{
+			Fields:  &[]string{"state"},
+			Value:   platformclientv2.String("active"),
+			VarType: platformclientv2.String("EXACT"),
+		},
+	}
+	if department != "" {
+		criteria = append(criteria, platformclientv2.Usersearchcriteria{
+			Fields:  &[]string{"department"},
+			Value:   &department,
+			VarType: platformclientv2.String("EXACT"),
+		})
+	}
+
+	users, resp, err := proxy.searchUsers(ctx, criteria, []string{"locations", "skills"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users for member_selector: %v %v", err, resp)
+	}
+
+	var userIds []string
+	for _, user := range *users {
+		if user.Id == nil {
+			continue
+		}
+		if department != "" && (user.Department == nil || *user.Department != department) {
+			continue
+		}
+		if len(locationIds) > 0 && !userHasAnyLocation(user, locationIds) {
+			continue
+		}
+		if len(skillIds) > 0 && !userHasAnySkill(user, skillIds) {
+			continue
+		}
+		userIds = append(userIds, *user.Id)
+	}
+	log.Printf("member_selector matched %d users", len(userIds))
+	return userIds, nil
+}
+
+func userHasAnyLocation(user platformclientv2.User, locationIds []string) bool {
+	if user.Locations == nil {
+		return false
+	}
+	for _, location := range *user.Locations {
+		if location.LocationDefinition != nil && location.LocationDefinition.Id != nil && lists.ItemInSlice(*location.LocationDefinition.Id, locationIds) {
+			return true
+		}
+	}
+	return false
+}
+
+func userHasAnySkill(user platformclientv2.User, skillIds []string) bool {
+	if user.Skills == nil {
+		return false
+	}
+	for _, skill := range *user.Skills {
+		if skill.Id != nil && lists.ItemInSlice(*skill.Id, skillIds) {
+			return true
+		}
+	}
+	return false
+}
+
+func setToStringListOrEmpty(value interface{}) []string {
+	set, ok := value.(*schema.Set)
+	if !ok || set == nil {
+		return nil
+	}
+	return *lists.SetToStringList(set)
+}
+
+// getMemberChanges works out which users to add and remove. In authoritative mode every user member not desired is removed.
+// In additive mode only users previously managed by the resource are removed.
+func getMemberChanges(mode string, existing map[string]int, desired map[string]int, previouslyManaged []string) (toAdd []string, toRemove []string) {
+	for userId := range desired {
+		if _, found := existing[userId]; !found {
+			toAdd = append(toAdd, userId)
+		}
+	}
+
+	for userId := range existing {
+		if _, found := desired[userId]; found {
+			continue
+		}
+		if mode == modeAuthoritative || lists.ItemInSlice(userId, previouslyManaged) {
+			toRemove = append(toRemove, userId)
+		}
+	}
+
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	return toAdd, toRemove
+}
+
+// getManagedMemberIds returns the users that should be reported as managed in state.
+// In authoritative mode that is every user member of the queue. In additive mode it is the previously managed users still in the queue.
+func getManagedMemberIds(mode string, existing map[string]int, previouslyManaged *schema.Set) []string {
+	if mode == modeAuthoritative {
+		return stringmap.SortedKeys(existing)
+	}
+
+	var managed []string
+	if previouslyManaged == nil {
+		return managed
+	}
+	for _, userId := range *lists.SetToStringList(previouslyManaged) {
+		if _, found := existing[userId]; found {
+			managed = append(managed, userId)
+		}
+	}
+	sort.Strings(managed)
+	return managed
+}
+
+// postRoutingQueueMembers adds or removes members in chunks of chunkSize
+func postRoutingQueueMembers(ctx context.Context, proxy *routingQueueMembersProxy, queueId string, userIds []string, remove bool, chunkSize int) diag.Diagnostics {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	chunks := chunksProcess.ChunkItems(userIds, func(userId string) platformclientv2.Writableentity {
+		return platformclientv2.Writableentity{Id: &userId}
+	}, chunkSize)
+
+	return chunksProcess.ProcessChunks(chunks, func(chunk []platformclientv2.Writableentity) diag.Diagnostics {
+		resp, err := proxy.addOrRemoveRoutingQueueMembers(ctx, queueId, chunk, remove)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to update members in queue %s error: %s", queueId, err), resp)
+		}
+		return nil
+	})
+}
+
+// updateRingNumbers patches the ring number of every desired member whose ring number differs from the queue
+func updateRingNumbers(ctx context.Context, proxy *routingQueueMembersProxy, queueId string, existing map[string]int, desired map[string]int) diag.Diagnostics {
+	for _, userId := range stringmap.SortedKeys(desired) {
+		ringNum := desired[userId]
+		if current, found := existing[userId]; found && current == ringNum {
+			continue
+		}
+		if _, found := existing[userId]; !found && ringNum == 1 {
+			// Newly added members default to ring 1
+			continue
+		}
+		resp, err := proxy.updateRoutingQueueMemberRingNum(ctx, queueId, userId, ringNum)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to update ring number for user %s in queue %s error: %s", userId, queueId, err), resp)
+		}
+	}
+	return nil
+}
+
+// queueMembersToRingNums maps the user ID of every queue member to its ring number
+func queueMembersToRingNums(members []platformclientv2.Queuemember) map[string]int {
+	ringNums := make(map[string]int)
+	for _, member := range members {
+		if member.Id == nil {
+			continue
+		}
+		ringNum := 1
+		if member.RingNumber != nil {
+			ringNum = *member.RingNumber
+		}
+		ringNums[*member.Id] = ringNum
+	}
+	return ringNums
+}
+
+// flattenConfiguredMembers returns the configured members with their ring numbers as currently found in the queue.
+// Configured members no longer in the queue are dropped so that Terraform plans to add them back.
+func flattenConfiguredMembers(membersSet *schema.Set, existing map[string]int) *schema.Set {
+	flattened := schema.NewSet(schema.HashResource(queueMemberResource), []interface{}{})
+	for _, member := range membersSet.List() {
+		userId := member.(map[string]interface{})["user_id"].(string)
+		ringNum, found := existing[userId]
+		if !found {
+			continue
+		}
+		flattened.Add(map[string]interface{}{
+			"user_id":  userId,
+			"ring_num": ringNum,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,69 @@
+package routing_queue_members
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseMembersCsv(t *testing.T) {
+	user1 := uuid.NewString()
+	user2 := uuid.NewString()
+	user3 := uuid.NewString()
+
+	content := "User_Id, ring_num\n" + user1 + ",2\n" + user2 + ",\n\n" + user3 + ",6\n"
+	members, err := parseMembersCsv(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{user1: 2, user2: 1, user3: 6}, members)
+}
+
+func TestUnitParseMembersCsvErrors(t *testing.T) {
+	_, err := parseMembersCsv(strings.NewReader(""))
+	assert.ErrorContains(t, err, "file is empty")
+
+	_, err = parseMembersCsv(strings.NewReader("id,ring_num\n" + uuid.NewString() + ",1\n"))
+	assert.ErrorContains(t, err, "user_id")
+
+	_, err = parseMembersCsv(strings.NewReader("user_id,ring_num\n" + uuid.NewString() + ",7\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestUnitGetMemberChanges(t *testing.T) {
+	existing := map[string]int{"a": 1, "b": 1, "c": 2}
+	desired := map[string]int{"a": 1, "d": 3}
+
+	toAdd, toRemove := getMemberChanges(modeAuthoritative, existing, desired, nil)
+	assert.Equal(t, []string{"d"}, toAdd)
+	assert.Equal(t, []string{"b", "c"}, toRemove)
+
+	// In additive mode members added outside of the resource are left alone
+	toAdd, toRemove = getMemberChanges(modeAdditive, existing, desired, []string{"a", "c"})
+	assert.Equal(t, []string{"d"}, toAdd)
+	assert.Equal(t, []string{"c"}, toRemove)
+}
+
+func TestUnitGetManagedMemberIds(t *testing.T) {
+	existing := map[string]int{"a": 1, "b": 1, "c": 2}
+
+	assert.Equal(t, []string{"a", "b", "c"}, getManagedMemberIds(modeAuthoritative, existing, nil))
+
+	previouslyManaged := schema.NewSet(schema.HashString, []interface{}{"c", "a", "gone"})
+	assert.Equal(t, []string{"a", "c"}, getManagedMemberIds(modeAdditive, existing, previouslyManaged))
+}
+
+func TestUnitQueueMembersToRingNums(t *testing.T) {
+	user1 := uuid.NewString()
+	user2 := uuid.NewString()
+	ringNum := 4
+
+	members := []platformclientv2.Queuemember{
+		{Id: &user1, RingNumber: &ringNum},
+		{Id: &user2},
+		{},
+	}
+	assert.Equal(t, map[string]int{user1: 4, user2: 1}, queueMembersToRingNums(members))
+}
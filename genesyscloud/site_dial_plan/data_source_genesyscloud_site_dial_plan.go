@@ -0,0 +1,81 @@
+package site_dial_plan
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_site_dial_plan.go contains the data source implementation
+   for the site dial plan test data source.
+*/
+
+// defaultCountryCode is used when neither the data source, the site nor the organization has a country
+const defaultCountryCode = "US"
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// dataSourceSiteDialPlanTestRead classifies the dialed strings with the number plans of the data source or the site
+func dataSourceSiteDialPlanTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getSiteDialPlanProxy(sdkConfig)
+	siteId := d.Get("site_id").(string)
+	countryCode := d.Get("country_code").(string)
+
+	var (
+		plans []numberPlan
+		err   error
+	)
+	if siteId == "" {
+		plans, err = buildNumberPlans(d.Get("number_plans").([]interface{}))
+		if err != nil {
+			return util.BuildDiagnosticError(resourceName, "Failed to read number_plans", err)
+		}
+	} else {
+		site, resp, getErr := proxy.getSite(ctx, siteId)
+		if getErr != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read site %s | error: %s", siteId, getErr), resp)
+		}
+		sdkNumberPlans, resp, getErr := proxy.getSiteNumberPlans(ctx, siteId)
+		if getErr != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read number plans of site %s | error: %s", siteId, getErr), resp)
+		}
+		plans, err = convertNumberPlans(*sdkNumberPlans)
+		if err != nil {
+			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to read number plans of site %s", siteId), err)
+		}
+		if countryCode == "" && site.Location != nil && site.Location.Address != nil && site.Location.Address.Country != nil {
+			countryCode = strings.ToUpper(*site.Location.Address.Country)
+		}
+	}
+	if countryCode == "" {
+		countryCode = provider.GetOrgDefaultCountryCode()
+	}
+	if countryCode == "" {
+		countryCode = defaultCountryCode
+	}
+
+	dialedStrings := d.Get("dialed_strings").([]interface{})
+	results := make([]dialResult, 0, len(dialedStrings))
+	for _, dialedString := range dialedStrings {
+		value, _ := dialedString.(string)
+		results = append(results, simulateDialPlan(plans, value, countryCode))
+	}
+	log.Printf("Classified %d dialed strings with %d number plans in country %s", len(results), len(plans), countryCode)
+
+	if siteId != "" {
+		d.SetId(siteId)
+	} else {
+		d.SetId(resourceName)
+	}
+	_ = d.Set("results", flattenDialResults(results))
+	return nil
+}
@@ -0,0 +1,57 @@
+package site_dial_plan
+
+import (
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSiteDialPlanTest(t *testing.T) {
+	var (
+		dataSourceLabel = "dial-plan"
+		fullDataName    = "data." + resourceName + "." + dataSourceLabel
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`data "%s" "%s" {
+					country_code   = "US"
+					dialed_strings = ["911", "+1 (317) 555-0100", "+442071234567", "abc"]
+					number_plans {
+						name           = "Emergency"
+						match_type     = "numberList"
+						classification = "Emergency"
+						numbers {
+							start = "911"
+						}
+					}
+					number_plans {
+						name           = "National"
+						match_type     = "intraCountryCode"
+						classification = "National"
+					}
+					number_plans {
+						name           = "International"
+						match_type     = "interCountryCode"
+						classification = "International"
+					}
+				}
+				`, resourceName, dataSourceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullDataName, "results.#", "4"),
+					resource.TestCheckResourceAttr(fullDataName, "results.0.plan_name", "Emergency"),
+					resource.TestCheckResourceAttr(fullDataName, "results.1.classification", "National"),
+					resource.TestCheckResourceAttr(fullDataName, "results.1.normalized_number", "+13175550100"),
+					resource.TestCheckResourceAttr(fullDataName, "results.2.classification", "International"),
+					resource.TestCheckResourceAttr(fullDataName, "results.3.matched", util.FalseValue),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,123 @@
+package site_dial_plan
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNumberPlan(name, matchType, classification string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":              name,
+		"match_type":        matchType,
+		"match_format":      "",
+		"normalized_format": "",
+		"classification":    classification,
+		"numbers":           []interface{}{},
+		"digit_length":      []interface{}{},
+	}
+}
+
+func TestUnitSimulateDialPlan(t *testing.T) {
+	emergency := testNumberPlan("Emergency", "numberList", "Emergency")
+	emergency["numbers"] = []interface{}{map[string]interface{}{"start": "911", "end": ""}}
+	tollFree := testNumberPlan("Toll Free", "e164NumberList", "Toll Free")
+	tollFree["numbers"] = []interface{}{map[string]interface{}{"start": "+18005550000", "end": "+18005559999"}}
+	extension := testNumberPlan("Extension", "digitLength", "Extension")
+	extension["digit_length"] = []interface{}{map[string]interface{}{"start": "3", "end": "5"}}
+	outside := testNumberPlan("Outside Line", "regex", "National")
+	outside["match_format"] = `^9(\d{10})$`
+	outside["normalized_format"] = "+1$1"
+
+	plans, err := buildNumberPlans([]interface{}{
+		emergency,
+		tollFree,
+		extension,
+		outside,
+		testNumberPlan("National", "intraCountryCode", "National"),
+		testNumberPlan("International", "interCountryCode", "International"),
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		dialed         string
+		planName       string
+		normalized     string
+		classification string
+	}{
+		{"911", "Emergency", "911", "Emergency"},
+		{"(800) 555-1234", "Toll Free", "+18005551234", "Toll Free"},
+		{"1234", "Extension", "1234", "Extension"},
+		{"93175550100", "Outside Line", "+13175550100", "National"},
+		{"+1 317 555 0100", "National", "+13175550100", "National"},
+		{"+44 20 7123 4567", "International", "+442071234567", "International"},
+		{"*67", "", "", ""},
+	}
+	for _, test := range tests {
+		result := simulateDialPlan(plans, test.dialed, "US")
+		if test.planName == "" {
+			assert.Nil(t, result.plan, test.dialed)
+			continue
+		}
+		if assert.NotNil(t, result.plan, test.dialed) {
+			assert.Equal(t, test.planName, result.plan.name, test.dialed)
+			assert.Equal(t, test.classification, result.plan.classification, test.dialed)
+		}
+		assert.Equal(t, test.normalized, result.normalizedNumber, test.dialed)
+	}
+
+	// The country of the site decides what is national
+	result := simulateDialPlan(plans, "020 7123 4567", "GB")
+	if assert.NotNil(t, result.plan) {
+		assert.Equal(t, "National", result.plan.name)
+	}
+
+	invalid := testNumberPlan("Invalid", "regex", "National")
+	invalid["match_format"] = "(["
+	_, err = buildNumberPlans([]interface{}{invalid})
+	assert.ErrorContains(t, err, "number plan Invalid has an invalid match_format")
+}
+
+func TestUnitDataSourceSiteDialPlanTestSite(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	country := "gb"
+	national, international, deleted := "National", "International", "Deleted"
+	intraCountryCode, interCountryCode, deletedState := "intraCountryCode", "interCountryCode", "deleted"
+	first, second := 1, 2
+
+	internalProxy = &siteDialPlanProxy{}
+	defer func() { internalProxy = nil }()
+	internalProxy.getSiteAttr = func(ctx context.Context, p *siteDialPlanProxy, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Site{
+			Id:       &siteId,
+			Location: &platformclientv2.Locationdefinition{Address: &platformclientv2.Locationaddress{Country: &country}},
+		}, ok, nil
+	}
+	internalProxy.getSiteNumberPlansAttr = func(ctx context.Context, p *siteDialPlanProxy, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error) {
+		// Returned out of priority order, with a deleted plan matching everything first
+		return &[]platformclientv2.Numberplan{
+			{Name: &international, MatchType: &interCountryCode, Classification: &international, Priority: &second},
+			{Name: &deleted, MatchType: &intraCountryCode, Classification: &deleted, State: &deletedState},
+			{Name: &national, MatchType: &intraCountryCode, Classification: &national, Priority: &first},
+		}, ok, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceSiteDialPlanTest().Schema, map[string]interface{}{
+		"site_id":        "site-1",
+		"dialed_strings": []interface{}{"020 7123 4567", "+1 317 555 0100"},
+	})
+	diags := dataSourceSiteDialPlanTestRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, "site-1", d.Id())
+	assert.Equal(t, 2, d.Get("results.#"))
+	assert.Equal(t, "National", d.Get("results.0.plan_name"))
+	assert.Equal(t, "+442071234567", d.Get("results.0.normalized_number"))
+	assert.Equal(t, "International", d.Get("results.1.classification"))
+	assert.Equal(t, true, d.Get("results.1.matched"))
+}
@@ -0,0 +1,71 @@
+package site_dial_plan
+
+import (
+	"context"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_site_dial_plan_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *siteDialPlanProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getSiteFunc func(ctx context.Context, p *siteDialPlanProxy, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error)
+type getSiteNumberPlansFunc func(ctx context.Context, p *siteDialPlanProxy, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error)
+
+// siteDialPlanProxy contains all of the methods that call genesys cloud APIs.
+type siteDialPlanProxy struct {
+	clientConfig           *platformclientv2.Configuration
+	edgesApi               *platformclientv2.TelephonyProvidersEdgeApi
+	getSiteAttr            getSiteFunc
+	getSiteNumberPlansAttr getSiteNumberPlansFunc
+}
+
+// newSiteDialPlanProxy initializes the site dial plan proxy with all of the data needed to communicate with Genesys Cloud
+func newSiteDialPlanProxy(clientConfig *platformclientv2.Configuration) *siteDialPlanProxy {
+	return &siteDialPlanProxy{
+		clientConfig:           clientConfig,
+		edgesApi:               platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+		getSiteAttr:            getSiteFn,
+		getSiteNumberPlansAttr: getSiteNumberPlansFn,
+	}
+}
+
+// getSiteDialPlanProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSiteDialPlanProxy(clientConfig *platformclientv2.Configuration) *siteDialPlanProxy {
+	if internalProxy == nil {
+		internalProxy = newSiteDialPlanProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getSite returns a Genesys Cloud site by Id
+func (p *siteDialPlanProxy) getSite(ctx context.Context, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error) {
+	return p.getSiteAttr(ctx, p, siteId)
+}
+
+// getSiteNumberPlans returns the number plans of a Genesys Cloud site
+func (p *siteDialPlanProxy) getSiteNumberPlans(ctx context.Context, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error) {
+	return p.getSiteNumberPlansAttr(ctx, p, siteId)
+}
+
+// getSiteFn is an implementation of the function to get a Genesys Cloud site by Id
+func getSiteFn(ctx context.Context, p *siteDialPlanProxy, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.GetTelephonyProvidersEdgesSite(siteId)
+}
+
+// getSiteNumberPlansFn is an implementation of the function to get the number plans of a Genesys Cloud site
+func getSiteNumberPlansFn(ctx context.Context, p *siteDialPlanProxy, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error) {
+	numberPlans, resp, err := p.edgesApi.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &numberPlans, resp, nil
+}
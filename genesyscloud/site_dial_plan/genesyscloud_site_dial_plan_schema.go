@@ -0,0 +1,98 @@
+package site_dial_plan
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	site "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_site_dial_plan_schema.go holds the registration code and the data source schema for the site dial plan test data source.
+*/
+const resourceName = "genesyscloud_site_dial_plan_test"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(resourceName, DataSourceSiteDialPlanTest())
+}
+
+var dialResultResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"dialed_string": {
+			Description: "The dialed string.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"matched": {
+			Description: "True if a number plan matched the dialed string.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"plan_name": {
+			Description: "Name of the first number plan that matched the dialed string.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"match_type": {
+			Description: "Match type of the number plan.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"classification": {
+			Description: "Classification of the number plan, as used by the classification_types of outbound routes.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"normalized_number": {
+			Description: "The dialed string normalized by the number plan. Numbers matched by country code or E.164 number list are normalized to E.164, regex plans apply `normalized_format`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceSiteDialPlanTest registers the genesyscloud_site_dial_plan_test data source
+func DataSourceSiteDialPlanTest() *schema.Resource {
+	// The number plans are configured like the number plans of the site resource
+	numberPlans := *site.ResourceSite().Schema["number_plans"]
+	numberPlans.Description = "Number plans to test, in order of priority. Uses the same format as the `number_plans` of `genesyscloud_telephony_providers_edges_site`."
+	numberPlans.Computed = false
+	numberPlans.ExactlyOneOf = []string{"site_id", "number_plans"}
+
+	return &schema.Resource{
+		Description: "Data source simulating how the number plans of a site classify dialed strings, without placing a test call. " +
+			"The number plans are read from a site or configured in the data source. Each dialed string is classified by the first number plan that matches it.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceSiteDialPlanTestRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description:  "ID of the site whose number plans are tested.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"site_id", "number_plans"},
+			},
+			"number_plans": &numberPlans,
+			"country_code": {
+				Description:  "ISO 3166-1 alpha-2 code of the country of the site, used to tell national from international numbers, e.g. `US`. Defaults to the country of the site location, or to the default country of the organization.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(countryCodePattern, "must be an ISO 3166-1 alpha-2 country code in upper case, e.g. US"),
+			},
+			"dialed_strings": {
+				Description: "Strings to dial, e.g. `911`, `3175550100` or `+442071234567`.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Description: "Classification of each dialed string, in the order of `dialed_strings`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dialResultResource,
+			},
+		},
+	}
+}
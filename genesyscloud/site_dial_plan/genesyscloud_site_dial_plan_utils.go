@@ -0,0 +1,247 @@
+package site_dial_plan
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// numberRange is a number or, when end is set, an inclusive range of numbers with the same count of digits
+type numberRange struct {
+	start string
+	end   string
+}
+
+// numberPlan is a site number plan as configured on the site resource or read from the API
+type numberPlan struct {
+	name             string
+	matchType        string
+	matchFormat      string
+	normalizedFormat string
+	classification   string
+	numbers          []numberRange
+	digitLength      *numberRange
+	pattern          *regexp.Regexp
+}
+
+// dialResult is the outcome of dialing a string on a site
+type dialResult struct {
+	dialedString     string
+	plan             *numberPlan
+	normalizedNumber string
+}
+
+// buildNumberPlans reads the number_plans blocks of the data source
+func buildNumberPlans(numberPlans []interface{}) ([]numberPlan, error) {
+	plans := make([]numberPlan, 0, len(numberPlans))
+	for _, planConfig := range numberPlans {
+		planMap := planConfig.(map[string]interface{})
+		plan := numberPlan{
+			name:             planMap["name"].(string),
+			matchType:        planMap["match_type"].(string),
+			matchFormat:      planMap["match_format"].(string),
+			normalizedFormat: planMap["normalized_format"].(string),
+			classification:   planMap["classification"].(string),
+		}
+		for _, numberConfig := range planMap["numbers"].([]interface{}) {
+			if numberMap, ok := numberConfig.(map[string]interface{}); ok {
+				plan.numbers = append(plan.numbers, numberRange{start: numberMap["start"].(string), end: numberMap["end"].(string)})
+			}
+		}
+		if digitLengths := planMap["digit_length"].([]interface{}); len(digitLengths) > 0 && digitLengths[0] != nil {
+			digitLengthMap := digitLengths[0].(map[string]interface{})
+			plan.digitLength = &numberRange{start: digitLengthMap["start"].(string), end: digitLengthMap["end"].(string)}
+		}
+		if err := plan.compile(); err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// convertNumberPlans converts the number plans of a site read from the API, in order of priority
+func convertNumberPlans(sdkNumberPlans []platformclientv2.Numberplan) ([]numberPlan, error) {
+	sort.SliceStable(sdkNumberPlans, func(i, j int) bool {
+		return priorityOf(sdkNumberPlans[i]) < priorityOf(sdkNumberPlans[j])
+	})
+
+	plans := make([]numberPlan, 0, len(sdkNumberPlans))
+	for _, sdkNumberPlan := range sdkNumberPlans {
+		if sdkNumberPlan.State != nil && *sdkNumberPlan.State == "deleted" {
+			continue
+		}
+		plan := numberPlan{
+			name:             util.StringValue(sdkNumberPlan.Name),
+			matchType:        util.StringValue(sdkNumberPlan.MatchType),
+			matchFormat:      util.StringValue(sdkNumberPlan.Match),
+			normalizedFormat: util.StringValue(sdkNumberPlan.NormalizedFormat),
+			classification:   util.StringValue(sdkNumberPlan.Classification),
+		}
+		if sdkNumberPlan.Numbers != nil {
+			for _, number := range *sdkNumberPlan.Numbers {
+				plan.numbers = append(plan.numbers, numberRange{start: util.StringValue(number.Start), end: util.StringValue(number.End)})
+			}
+		}
+		if sdkNumberPlan.DigitLength != nil {
+			plan.digitLength = &numberRange{start: util.StringValue(sdkNumberPlan.DigitLength.Start), end: util.StringValue(sdkNumberPlan.DigitLength.End)}
+		}
+		if err := plan.compile(); err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+func priorityOf(numberPlan platformclientv2.Numberplan) int {
+	if numberPlan.Priority == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *numberPlan.Priority
+}
+
+// compile compiles the regular expression of regex number plans
+func (p *numberPlan) compile() error {
+	if p.matchType != "regex" {
+		return nil
+	}
+	pattern, err := regexp.Compile(p.matchFormat)
+	if err != nil {
+		return fmt.Errorf("number plan %s has an invalid match_format: %s", p.name, err)
+	}
+	p.pattern = pattern
+	return nil
+}
+
+// simulateDialPlan classifies a dialed string with the first number plan that matches it
+func simulateDialPlan(plans []numberPlan, dialedString string, countryCode string) dialResult {
+	for i := range plans {
+		if normalized, ok := plans[i].match(dialedString, countryCode); ok {
+			return dialResult{dialedString: dialedString, plan: &plans[i], normalizedNumber: normalized}
+		}
+	}
+	return dialResult{dialedString: dialedString}
+}
+
+// match reports whether the number plan matches a dialed string and returns the normalized number
+func (p *numberPlan) match(dialedString string, countryCode string) (string, bool) {
+	digits := digitsOf(dialedString)
+	switch p.matchType {
+	case "numberList":
+		for _, number := range p.numbers {
+			if number.contains(digits) {
+				return digits, true
+			}
+		}
+	case "e164NumberList":
+		e164, ok := parseE164(dialedString, countryCode)
+		if !ok {
+			return "", false
+		}
+		for _, number := range p.numbers {
+			if number.contains(digitsOf(e164)) {
+				return e164, true
+			}
+		}
+	case "digitLength":
+		if p.digitLength != nil && digits != "" && inLengthRange(len(digits), *p.digitLength) {
+			return digits, true
+		}
+	case "intraCountryCode", "interCountryCode":
+		number, err := phonenumbers.Parse(dialedString, countryCode)
+		if err != nil || !phonenumbers.IsPossibleNumber(number) {
+			return "", false
+		}
+		sameCountry := int(number.GetCountryCode()) == phonenumbers.GetCountryCodeForRegion(countryCode)
+		if sameCountry == (p.matchType == "intraCountryCode") {
+			return phonenumbers.Format(number, phonenumbers.E164), true
+		}
+	case "regex":
+		if p.pattern == nil {
+			return "", false
+		}
+		submatches := p.pattern.FindStringSubmatchIndex(dialedString)
+		if submatches == nil {
+			return "", false
+		}
+		if p.normalizedFormat == "" {
+			return dialedString, true
+		}
+		return string(p.pattern.ExpandString(nil, p.normalizedFormat, dialedString, submatches)), true
+	}
+	return "", false
+}
+
+// contains reports whether a string of digits is the number or in the range. Formatting of the range is ignored.
+func (r numberRange) contains(digits string) bool {
+	start, end := digitsOf(r.start), digitsOf(r.end)
+	if digits == "" || start == "" {
+		return false
+	}
+	if end == "" {
+		return digits == start
+	}
+	// Numbers with the same count of digits compare like integers
+	return len(digits) == len(start) && len(digits) == len(end) && start <= digits && digits <= end
+}
+
+func inLengthRange(length int, r numberRange) bool {
+	var start, end int
+	if _, err := fmt.Sscanf(r.start, "%d", &start); err != nil {
+		return false
+	}
+	end = start
+	if r.end != "" {
+		if _, err := fmt.Sscanf(r.end, "%d", &end); err != nil {
+			return false
+		}
+	}
+	return start <= length && length <= end
+}
+
+// digitsOf strips everything but the digits from a string, e.g. "+1 (317) 555-0100" becomes "13175550100"
+func digitsOf(value string) string {
+	var digits strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	return digits.String()
+}
+
+// parseE164 formats a dialed string as an E.164 number, parsing national numbers in the country of the site
+func parseE164(dialedString string, countryCode string) (string, bool) {
+	number, err := phonenumbers.Parse(dialedString, countryCode)
+	if err != nil || !phonenumbers.IsPossibleNumber(number) {
+		return "", false
+	}
+	return phonenumbers.Format(number, phonenumbers.E164), true
+}
+
+func flattenDialResults(results []dialResult) []interface{} {
+	flattened := make([]interface{}, 0, len(results))
+	for _, result := range results {
+		resultMap := map[string]interface{}{
+			"dialed_string":     result.dialedString,
+			"matched":           result.plan != nil,
+			"plan_name":         "",
+			"match_type":        "",
+			"classification":    "",
+			"normalized_number": result.normalizedNumber,
+		}
+		if result.plan != nil {
+			resultMap["plan_name"] = result.plan.name
+			resultMap["match_type"] = result.plan.matchType
+			resultMap["classification"] = result.plan.classification
+		}
+		flattened = append(flattened, resultMap)
+	}
+	return flattened
+}
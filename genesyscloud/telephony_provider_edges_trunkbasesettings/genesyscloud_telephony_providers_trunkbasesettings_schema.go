@@ -57,7 +57,7 @@ func ResourceTrunkBaseSettings() *schema.Resource {
 			},
 
 			"properties": {
-				Description:      "trunk base settings properties",
+				Description:      "trunk base settings properties. Property names, types and allowed values are checked at plan time against the template of the trunk metabase set in `trunk_meta_base_id`, and instance values are normalized to the type of the property.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
@@ -0,0 +1,42 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_telephony_providers_edges_did_inventory.go contains the data source implementation
+   for the DID inventory data source.
+*/
+
+// dataSourceDidInventoryRead lists the numbers of the DID pools with their assignments
+func dataSourceDidInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getDidInventoryProxy(sdkConfig)
+	didPoolIds := *lists.SetToStringList(d.Get("did_pool_ids").(*schema.Set))
+	sort.Strings(didPoolIds)
+
+	inventory, resp, err := loadDidInventory(ctx, proxy, didPoolIds, nil)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read the numbers of DID pools %v | error: %s", didPoolIds, err), resp)
+	}
+	log.Printf("Read %d numbers, %d conflicting and %d outside any DID pool", len(inventory.numbers), len(inventory.conflictingNumbers), len(inventory.unpooledNumbers))
+
+	if len(didPoolIds) == 0 {
+		d.SetId("all")
+	} else {
+		d.SetId(strings.Join(didPoolIds, ","))
+	}
+	setInventory(d, inventory)
+	return nil
+}
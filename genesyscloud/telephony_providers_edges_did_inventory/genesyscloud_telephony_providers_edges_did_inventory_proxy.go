@@ -0,0 +1,133 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_providers_edges_did_inventory_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *didInventoryProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllDidPoolsFunc func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+type getDidPoolNumbersFunc func(ctx context.Context, p *didInventoryProxy, didPoolIds []string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error)
+type getAllIvrsFunc func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error)
+
+// didInventoryProxy contains all of the methods that call genesys cloud APIs.
+type didInventoryProxy struct {
+	clientConfig          *platformclientv2.Configuration
+	edgesApi              *platformclientv2.TelephonyProvidersEdgeApi
+	architectApi          *platformclientv2.ArchitectApi
+	getAllDidPoolsAttr    getAllDidPoolsFunc
+	getDidPoolNumbersAttr getDidPoolNumbersFunc
+	getAllIvrsAttr        getAllIvrsFunc
+}
+
+// newDidInventoryProxy initializes the DID inventory proxy with all of the data needed to communicate with Genesys Cloud
+func newDidInventoryProxy(clientConfig *platformclientv2.Configuration) *didInventoryProxy {
+	return &didInventoryProxy{
+		clientConfig:          clientConfig,
+		edgesApi:              platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+		architectApi:          platformclientv2.NewArchitectApiWithConfig(clientConfig),
+		getAllDidPoolsAttr:    getAllDidPoolsFn,
+		getDidPoolNumbersAttr: getDidPoolNumbersFn,
+		getAllIvrsAttr:        getAllIvrsFn,
+	}
+}
+
+// getDidInventoryProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getDidInventoryProxy(clientConfig *platformclientv2.Configuration) *didInventoryProxy {
+	if internalProxy == nil {
+		internalProxy = newDidInventoryProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllDidPools returns every DID pool in the organization
+func (p *didInventoryProxy) getAllDidPools(ctx context.Context) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return p.getAllDidPoolsAttr(ctx, p)
+}
+
+// getDidPoolNumbers returns the assigned and unassigned numbers of a set of DID pools
+func (p *didInventoryProxy) getDidPoolNumbers(ctx context.Context, didPoolIds []string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	return p.getDidPoolNumbersAttr(ctx, p, didPoolIds)
+}
+
+// getAllIvrs returns every IVR configuration in the organization
+func (p *didInventoryProxy) getAllIvrs(ctx context.Context) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+	return p.getAllIvrsAttr(ctx, p)
+}
+
+// getAllDidPoolsFn is an implementation of the function to get every DID pool in Genesys Cloud
+func getAllDidPoolsFn(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allDidPools []platformclientv2.Didpool
+
+	for pageNum := 1; ; pageNum++ {
+		didPools, resp, err := p.edgesApi.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get DID pools: %s", err)
+		}
+		if didPools.Entities == nil || len(*didPools.Entities) == 0 {
+			return &allDidPools, resp, nil
+		}
+		for _, didPool := range *didPools.Entities {
+			if didPool.State != nil && *didPool.State == "deleted" {
+				continue
+			}
+			allDidPools = append(allDidPools, didPool)
+		}
+		if didPools.PageCount == nil || pageNum >= *didPools.PageCount {
+			return &allDidPools, resp, nil
+		}
+	}
+}
+
+// getDidPoolNumbersFn is an implementation of the function to list the numbers of a set of Genesys Cloud DID pools
+func getDidPoolNumbersFn(ctx context.Context, p *didInventoryProxy, didPoolIds []string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allNumbers []platformclientv2.Didnumber
+
+	for pageNum := 1; ; pageNum++ {
+		numbers, resp, err := p.edgesApi.GetTelephonyProvidersEdgesDidpoolsDids("ASSIGNED_AND_UNASSIGNED", didPoolIds, "", pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get the numbers of DID pools %v: %s", didPoolIds, err)
+		}
+		if numbers.Entities == nil || len(*numbers.Entities) == 0 {
+			return &allNumbers, resp, nil
+		}
+		allNumbers = append(allNumbers, *numbers.Entities...)
+		if numbers.PageCount == nil || pageNum >= *numbers.PageCount {
+			return &allNumbers, resp, nil
+		}
+	}
+}
+
+// getAllIvrsFn is an implementation of the function to get every Genesys Cloud IVR configuration
+func getAllIvrsFn(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allIvrs []platformclientv2.Ivr
+
+	for pageNum := 1; ; pageNum++ {
+		ivrs, resp, err := p.architectApi.GetArchitectIvrs(pageNum, pageSize, "", "", "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get IVR configurations: %s", err)
+		}
+		if ivrs.Entities == nil || len(*ivrs.Entities) == 0 {
+			return &allIvrs, resp, nil
+		}
+		allIvrs = append(allIvrs, *ivrs.Entities...)
+		if ivrs.PageCount == nil || pageNum >= *ivrs.PageCount {
+			return &allIvrs, resp, nil
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_did_inventory.go contains all the methods that perform the core logic for a resource.
+*/
+
+// createDidInventory is used by the did_inventory resource to reserve numbers of a set of DID pools
+func createDidInventory(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diagErr := checkReservations(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+	d.SetId(uuid.NewString())
+	log.Printf("Created DID inventory %s", d.Id())
+	return readDidInventory(ctx, d, meta)
+}
+
+// readDidInventory is used by the did_inventory resource to list the numbers of the DID pools with their assignments.
+// Reserved numbers that were assigned outside Terraform are reported as warnings.
+func readDidInventory(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getDidInventoryProxy(sdkConfig)
+	didPoolIds := *lists.SetToStringList(d.Get("did_pool_ids").(*schema.Set))
+	reservedNumbers := *lists.SetToStringList(d.Get("reserved_numbers").(*schema.Set))
+
+	log.Printf("Reading DID inventory %s", d.Id())
+
+	var warnings diag.Diagnostics
+	diags := util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		inventory, resp, err := loadDidInventory(ctx, proxy, didPoolIds, reservedNumbers)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read DID inventory %s | error: %s", d.Id(), err), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read DID inventory %s | error: %s", d.Id(), err), resp))
+		}
+
+		setInventory(d, inventory)
+		if err := inventory.checkReservedNumbers(reservedNumbers); err != nil {
+			warnings = diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Reserved numbers of DID inventory %s are no longer available", d.Id()),
+				Detail:   err.Error(),
+			}}
+		}
+		log.Printf("Read DID inventory %s with %d numbers", d.Id(), len(inventory.numbers))
+		return nil
+	})
+	return append(warnings, diags...)
+}
+
+// updateDidInventory is used by the did_inventory resource to reserve and release numbers
+func updateDidInventory(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diagErr := checkReservations(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Updated DID inventory %s", d.Id())
+	return readDidInventory(ctx, d, meta)
+}
+
+// deleteDidInventory releases the reservations. The DID pools and their numbers are left unchanged.
+func deleteDidInventory(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Removing DID inventory %s from state. Its reservations are released", d.Id())
+	return nil
+}
+
+// checkReservations checks that every number in reserved_numbers belongs to the DID pools and is unassigned
+func checkReservations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getDidInventoryProxy(sdkConfig)
+	didPoolIds := *lists.SetToStringList(d.Get("did_pool_ids").(*schema.Set))
+	reservedNumbers := *lists.SetToStringList(d.Get("reserved_numbers").(*schema.Set))
+
+	inventory, resp, err := loadDidInventory(ctx, proxy, didPoolIds, reservedNumbers)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read the numbers of DID pools %v | error: %s", didPoolIds, err), resp)
+	}
+	if err := inventory.checkReservedNumbers(reservedNumbers); err != nil {
+		return util.BuildDiagnosticError(resourceName, "Failed to reserve numbers", err)
+	}
+	return nil
+}
+
+// loadDidInventory reads the DID pools, their numbers and the IVR configurations and builds the inventory of the DID
+// pools. Every DID pool of the organization is inventoried when didPoolIds is empty.
+func loadDidInventory(ctx context.Context, proxy *didInventoryProxy, didPoolIds []string, reservedNumbers []string) (didInventory, *platformclientv2.APIResponse, error) {
+	allDidPools, resp, err := proxy.getAllDidPools(ctx)
+	if err != nil {
+		return didInventory{}, resp, err
+	}
+
+	if len(didPoolIds) == 0 {
+		for _, didPool := range *allDidPools {
+			didPoolIds = append(didPoolIds, *didPool.Id)
+		}
+	} else {
+		for _, didPoolId := range didPoolIds {
+			if !containsDidPool(*allDidPools, didPoolId) {
+				return didInventory{}, &platformclientv2.APIResponse{StatusCode: 404}, fmt.Errorf("DID pool %s does not exist", didPoolId)
+			}
+		}
+	}
+
+	didNumbers := &[]platformclientv2.Didnumber{}
+	if len(didPoolIds) > 0 {
+		didNumbers, resp, err = proxy.getDidPoolNumbers(ctx, didPoolIds)
+		if err != nil {
+			return didInventory{}, resp, err
+		}
+	}
+
+	ivrs, resp, err := proxy.getAllIvrs(ctx)
+	if err != nil {
+		return didInventory{}, resp, err
+	}
+
+	return buildDidInventory(*allDidPools, *didNumbers, *ivrs, reservedNumbers), resp, nil
+}
+
+func containsDidPool(didPools []platformclientv2.Didpool, didPoolId string) bool {
+	for _, didPool := range didPools {
+		if didPool.Id != nil && *didPool.Id == didPoolId {
+			return true
+		}
+	}
+	return false
+}
+
+// setInventory sets the attributes computed by both the resource and the data source
+func setInventory(d *schema.ResourceData, inventory didInventory) {
+	_ = d.Set("numbers", flattenInventoryNumbers(inventory.numbers))
+	_ = d.Set("conflicting_numbers", inventory.conflictingNumbers)
+	_ = d.Set("unpooled_numbers", inventory.unpooledNumbers)
+	_ = d.Set("available_numbers", inventory.availableNumbers())
+}
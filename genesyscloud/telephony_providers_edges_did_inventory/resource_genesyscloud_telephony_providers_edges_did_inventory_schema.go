@@ -0,0 +1,155 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesyscloud_telephony_providers_edges_did_inventory_schema.go holds the registration code and the schemas of the
+DID inventory resource and data source. There is no exporter as the inventory reads numbers managed by other resources.
+*/
+const resourceName = "genesyscloud_telephony_providers_edges_did_inventory"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceDidInventory())
+	regInstance.RegisterDataSource(resourceName, DataSourceDidInventory())
+}
+
+var (
+	numberAssignmentResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "Type of the entity the number is assigned to, e.g. `USER`, `PHONE`, `GROUP` or `IVR`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"id": {
+				Description: "ID of the entity the number is assigned to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the entity the number is assigned to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	inventoryNumberResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Description: "Phone number in E.164 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"did_pool_id": {
+				Description: "ID of the DID pool the number belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"assigned": {
+				Description: "True if the number is assigned to at least one entity.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"reserved": {
+				Description: "True if the number is in `reserved_numbers`.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"assignments": {
+				Description: "Entities the number is assigned to. The owner of the DID is listed together with every IVR configuration with the number in its DNIS.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        numberAssignmentResource,
+			},
+		},
+	}
+)
+
+// inventorySchema returns the attributes computed by both the resource and the data source
+func inventorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"numbers": {
+			Description: "Every number of the DID pools with its assignments, ordered by number.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        inventoryNumberResource,
+		},
+		"conflicting_numbers": {
+			Description: "Numbers assigned to more than one entity, e.g. to a user and in the DNIS of an IVR configuration.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"unpooled_numbers": {
+			Description: "Numbers in the DNIS of IVR configurations that are not in the range of any DID pool of the organization.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"available_numbers": {
+			Description: "Numbers of the DID pools that are neither assigned nor reserved.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// ResourceDidInventory registers the genesyscloud_telephony_providers_edges_did_inventory resource with Terraform
+func ResourceDidInventory() *schema.Resource {
+	resourceSchema := inventorySchema()
+	resourceSchema["did_pool_ids"] = &schema.Schema{
+		Description: "IDs of the DID pools to inventory.",
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	resourceSchema["reserved_numbers"] = &schema.Schema{
+		Description: "Numbers of the DID pools held back from assignment, e.g. while they are ported. A number can only be reserved while it is unassigned and is released by removing it from the set. " +
+			"Genesys Cloud has no reservation of its own, so reserved numbers are excluded from `available_numbers` but can still be assigned outside Terraform. Such numbers are reported as warnings whenever the inventory is read, e.g. when Terraform plans, and fail the next apply that changes the inventory.",
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validators.ValidatePhoneNumber,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Genesys Cloud DID inventory. Lists every number of a set of DID pools with its current assignment, flags numbers assigned in two places or outside any DID pool, and reserves numbers for planned work such as number porting. " +
+			"Deleting the resource releases all reservations and does not change the DID pools or the numbers.",
+
+		CreateContext: provider.CreateWithPooledClient(createDidInventory),
+		ReadContext:   provider.ReadWithPooledClient(readDidInventory),
+		UpdateContext: provider.UpdateWithPooledClient(updateDidInventory),
+		DeleteContext: provider.DeleteWithPooledClient(deleteDidInventory),
+		SchemaVersion: 1,
+		Schema:        resourceSchema,
+	}
+}
+
+// DataSourceDidInventory registers the genesyscloud_telephony_providers_edges_did_inventory data source with Terraform
+func DataSourceDidInventory() *schema.Resource {
+	dataSourceSchema := inventorySchema()
+	dataSourceSchema["did_pool_ids"] = &schema.Schema{
+		Description: "IDs of the DID pools to inventory. Defaults to every DID pool of the organization.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Description: "Data source listing every number of a set of DID pools with its current assignment. Numbers assigned in two places or outside any DID pool are flagged.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceDidInventoryRead),
+		Schema:      dataSourceSchema,
+	}
+}
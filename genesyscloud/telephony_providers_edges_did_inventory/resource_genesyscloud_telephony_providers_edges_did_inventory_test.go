@@ -0,0 +1,109 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	architectIvr "terraform-provider-genesyscloud/genesyscloud/architect_ivr"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDidInventory(t *testing.T) {
+	var (
+		didPoolResource   = "inventory-pool"
+		ivrResource       = "inventory-ivr"
+		inventoryResource = "inventory"
+		inventoryData     = "inventory-data"
+		startNumber       = "+45465550020"
+		endNumber         = "+45465550023"
+		ivrNumber         = "+45465550021"
+		reservedNumber    = "+45465550023"
+		fullResourceName  = resourceName + "." + inventoryResource
+		fullDataName      = "data." + resourceName + "." + inventoryData
+	)
+
+	// did pool cleanup
+	defer func() {
+		if _, err := provider.AuthorizeSdk(); err != nil {
+			return
+		}
+		_, _ = didPool.DeleteDidPoolWithStartAndEndNumber(context.TODO(), startNumber, endNumber)
+	}()
+
+	config := didPool.GenerateDidPoolResource(&didPool.DidPoolStruct{
+		ResourceID:       didPoolResource,
+		StartPhoneNumber: startNumber,
+		EndPhoneNumber:   endNumber,
+		Description:      util.NullValue,
+		Comments:         util.NullValue,
+		PoolProvider:     util.NullValue,
+	}) + architectIvr.GenerateIvrConfigResource(&architectIvr.IvrConfigStruct{
+		ResourceID: ivrResource,
+		Name:       "Terraform DID inventory " + uuid.NewString(),
+		Dnis:       []string{ivrNumber},
+		DependsOn:  "genesyscloud_telephony_providers_edges_did_pool." + didPoolResource,
+	})
+	pool := "genesyscloud_telephony_providers_edges_did_pool." + didPoolResource + ".id"
+	ivrDependency := "depends_on = [genesyscloud_architect_ivr." + ivrResource + "]"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Reserve a number
+				Config: config + generateDidInventoryResource(inventoryResource, []string{pool}, []string{reservedNumber}, ivrDependency),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "numbers.#", "4"),
+					resource.TestCheckResourceAttr(fullResourceName, "numbers.1.number", ivrNumber),
+					resource.TestCheckResourceAttr(fullResourceName, "numbers.1.assigned", util.TrueValue),
+					resource.TestCheckResourceAttr(fullResourceName, "numbers.3.reserved", util.TrueValue),
+					resource.TestCheckResourceAttr(fullResourceName, "conflicting_numbers.#", "0"),
+					resource.TestCheckResourceAttr(fullResourceName, "available_numbers.#", "2"),
+				),
+			},
+			{
+				// Release the number and read the inventory with the data source
+				Config: config + generateDidInventoryResource(inventoryResource, []string{pool}, nil, ivrDependency) +
+					generateDidInventoryDataSource(inventoryData, []string{pool}, ivrDependency),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "available_numbers.#", "3"),
+					resource.TestCheckResourceAttr(fullDataName, "numbers.#", "4"),
+					resource.TestCheckResourceAttr(fullDataName, "numbers.1.assignments.0.type", "IVR"),
+					resource.TestCheckResourceAttrPair(fullDataName, "numbers.1.assignments.0.id", "genesyscloud_architect_ivr."+ivrResource, "id"),
+				),
+			},
+		},
+	})
+}
+
+func generateDidInventoryResource(resourceLabel string, didPoolIds []string, reservedNumbers []string, extras ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		did_pool_ids     = [%s]
+		reserved_numbers = %s
+		%s
+	}
+	`, resourceName, resourceLabel, strings.Join(didPoolIds, ", "), quotedList(reservedNumbers), strings.Join(extras, "\n"))
+}
+
+func generateDidInventoryDataSource(resourceLabel string, didPoolIds []string, extras ...string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		did_pool_ids = [%s]
+		%s
+	}
+	`, resourceName, resourceLabel, strings.Join(didPoolIds, ", "), strings.Join(extras, "\n"))
+}
+
+func quotedList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
@@ -0,0 +1,129 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDidNumber(number, didPoolId, ownerType, ownerId, ownerName string) platformclientv2.Didnumber {
+	didNumber := platformclientv2.Didnumber{
+		Number:  &number,
+		DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId},
+	}
+	if ownerId != "" {
+		didNumber.OwnerType = &ownerType
+		didNumber.Owner = &platformclientv2.Domainentityref{Id: &ownerId, Name: &ownerName}
+	}
+	return didNumber
+}
+
+func testDidInventoryProxy() *didInventoryProxy {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	poolId, otherPoolId := "pool-1", "pool-2"
+	start, end := "+13175550100", "+13175550104"
+	otherStart, otherEnd := "+13175550200", "+13175550299"
+	ivrId, ivrName := "ivr-1", "Main Menu"
+	dnis := []string{"+1 317-555-0101", "+13175550103", "+13175550250", "+13175559999"}
+
+	inventoryProxy := &didInventoryProxy{}
+	inventoryProxy.getAllDidPoolsAttr = func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Didpool{
+			{Id: &poolId, StartPhoneNumber: &start, EndPhoneNumber: &end},
+			{Id: &otherPoolId, StartPhoneNumber: &otherStart, EndPhoneNumber: &otherEnd},
+		}, ok, nil
+	}
+	inventoryProxy.getDidPoolNumbersAttr = func(ctx context.Context, p *didInventoryProxy, didPoolIds []string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Didnumber{
+			testDidNumber("+13175550100", poolId, "USER", "user-1", "Jane Doe"),
+			testDidNumber("+13175550101", poolId, "USER", "user-2", "John Doe"),
+			testDidNumber("+13175550102", poolId, "", "", ""),
+			testDidNumber("+13175550103", poolId, "IVR", ivrId, ivrName),
+			testDidNumber("+13175550104", poolId, "", "", ""),
+		}, ok, nil
+	}
+	inventoryProxy.getAllIvrsAttr = func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Ivr{{Id: &ivrId, Name: &ivrName, Dnis: &dnis}}, ok, nil
+	}
+	return inventoryProxy
+}
+
+func TestUnitDidInventoryRead(t *testing.T) {
+	internalProxy = testDidInventoryProxy()
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":     []interface{}{"pool-1"},
+		"reserved_numbers": []interface{}{"+13175550104"},
+	})
+	d.SetId("inventory")
+
+	diags := readDidInventory(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, 5, d.Get("numbers.#"))
+	// The number owned by a user and listed in the DNIS of an IVR is assigned twice
+	assert.Equal(t, []interface{}{"+13175550101"}, d.Get("conflicting_numbers"))
+	assert.Equal(t, 2, d.Get("numbers.1.assignments.#"))
+	assert.Equal(t, "IVR", d.Get("numbers.1.assignments.1.type"))
+	assert.Equal(t, "Main Menu", d.Get("numbers.1.assignments.1.name"))
+	// The IVR owning a DID and listing it in its DNIS is a single assignment
+	assert.Equal(t, 1, d.Get("numbers.3.assignments.#"))
+	// Numbers in another DID pool are not reported, numbers outside every DID pool are
+	assert.Equal(t, []interface{}{"+13175559999"}, d.Get("unpooled_numbers"))
+	assert.Equal(t, []interface{}{"+13175550102"}, d.Get("available_numbers"))
+	assert.Equal(t, true, d.Get("numbers.4.reserved"))
+	assert.Equal(t, false, d.Get("numbers.4.assigned"))
+	assert.Empty(t, diags)
+
+	// A reserved number assigned outside Terraform is reported when the inventory is read
+	d = schema.TestResourceDataRaw(t, ResourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":     []interface{}{"pool-1"},
+		"reserved_numbers": []interface{}{"+13175550100"},
+	})
+	d.SetId("assigned-inventory")
+	diags = readDidInventory(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "+13175550100 cannot be reserved because it is assigned to USER Jane Doe (user-1)", diags[0].Detail)
+}
+
+func TestUnitDidInventoryReserve(t *testing.T) {
+	internalProxy = testDidInventoryProxy()
+	defer func() { internalProxy = nil }()
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":     []interface{}{"pool-1"},
+		"reserved_numbers": []interface{}{"+13175550102", "+13175550104"},
+	})
+	diags := createDidInventory(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 0, d.Get("available_numbers.#"))
+
+	d = schema.TestResourceDataRaw(t, ResourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":     []interface{}{"pool-1"},
+		"reserved_numbers": []interface{}{"+13175550100", "+13175550250"},
+	})
+	diags = createDidInventory(context.Background(), d, meta)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "+13175550100 cannot be reserved because it is assigned to USER Jane Doe (user-1)")
+	assert.Contains(t, diags[0].Detail, "+13175550250 is not in any of the DID pools")
+	assert.Empty(t, d.Id())
+}
+
+func TestUnitDidInventoryUnknownPool(t *testing.T) {
+	internalProxy = testDidInventoryProxy()
+	defer func() { internalProxy = nil }()
+
+	_, resp, err := loadDidInventory(context.Background(), internalProxy, []string{"pool-3"}, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,223 @@
+package telephony_providers_edges_did_inventory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+// ivrOwnerType is the assignment type of numbers listed in the DNIS of an IVR configuration
+const ivrOwnerType = "IVR"
+
+// numberAssignment is an entity a number is assigned to
+type numberAssignment struct {
+	ownerType string
+	ownerId   string
+	ownerName string
+}
+
+// inventoryNumber is a number of a DID pool together with everything it is assigned to
+type inventoryNumber struct {
+	number      string
+	didPoolId   string
+	assignments []numberAssignment
+	reserved    bool
+}
+
+// didInventory holds the numbers of a set of DID pools
+type didInventory struct {
+	numbers []inventoryNumber
+	// conflictingNumbers are assigned to more than one entity
+	conflictingNumbers []string
+	// unpooledNumbers are assigned to an IVR but are not in the range of any DID pool
+	unpooledNumbers []string
+}
+
+// normalizeNumber strips the formatting of a phone number so numbers can be compared, e.g. "+1 (317) 555-0100" becomes
+// "+13175550100"
+func normalizeNumber(number string) string {
+	var digits strings.Builder
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	if digits.Len() == 0 {
+		return ""
+	}
+	return "+" + digits.String()
+}
+
+// inDidPoolRange reports whether a normalized number is in the range of a DID pool
+func inDidPoolRange(number string, didPool platformclientv2.Didpool) bool {
+	if didPool.StartPhoneNumber == nil || didPool.EndPhoneNumber == nil {
+		return false
+	}
+	start, end := normalizeNumber(*didPool.StartPhoneNumber), normalizeNumber(*didPool.EndPhoneNumber)
+	// Numbers with the same count of digits compare like integers
+	return len(number) == len(start) && len(number) == len(end) && start <= number && number <= end
+}
+
+// buildDidInventory lists the numbers of the DID pools with the entities they are assigned to. The owner of each DID is
+// taken from the DID pool listing and the IVR configurations are scanned for numbers in their DNIS, so numbers
+// assigned both to a DID owner and to an IVR are reported as conflicts. allDidPools is used to find IVR numbers outside
+// any DID pool.
+func buildDidInventory(allDidPools []platformclientv2.Didpool, didNumbers []platformclientv2.Didnumber, ivrs []platformclientv2.Ivr, reservedNumbers []string) didInventory {
+	numbersByValue := make(map[string]*inventoryNumber)
+	for _, didNumber := range didNumbers {
+		if didNumber.Number == nil {
+			continue
+		}
+		number := normalizeNumber(*didNumber.Number)
+		entry, ok := numbersByValue[number]
+		if !ok {
+			entry = &inventoryNumber{number: number}
+			numbersByValue[number] = entry
+		}
+		if didNumber.DidPool != nil && didNumber.DidPool.Id != nil {
+			entry.didPoolId = *didNumber.DidPool.Id
+		}
+		if didNumber.Owner != nil && didNumber.Owner.Id != nil {
+			assignment := numberAssignment{ownerId: *didNumber.Owner.Id}
+			if didNumber.OwnerType != nil {
+				assignment.ownerType = *didNumber.OwnerType
+			}
+			if didNumber.Owner.Name != nil {
+				assignment.ownerName = *didNumber.Owner.Name
+			}
+			entry.addAssignment(assignment)
+		}
+	}
+
+	unpooled := make(map[string]bool)
+	for _, ivr := range ivrs {
+		if ivr.Id == nil || ivr.Dnis == nil || (ivr.State != nil && *ivr.State == "deleted") {
+			continue
+		}
+		assignment := numberAssignment{ownerType: ivrOwnerType, ownerId: *ivr.Id}
+		if ivr.Name != nil {
+			assignment.ownerName = *ivr.Name
+		}
+		for _, dnis := range *ivr.Dnis {
+			number := normalizeNumber(dnis)
+			if entry, ok := numbersByValue[number]; ok {
+				entry.addAssignment(assignment)
+				continue
+			}
+			if !inAnyDidPool(number, allDidPools) {
+				unpooled[number] = true
+			}
+		}
+	}
+
+	for _, reserved := range reservedNumbers {
+		if entry, ok := numbersByValue[normalizeNumber(reserved)]; ok {
+			entry.reserved = true
+		}
+	}
+
+	inventory := didInventory{
+		conflictingNumbers: []string{},
+		unpooledNumbers:    []string{},
+	}
+	for _, entry := range numbersByValue {
+		inventory.numbers = append(inventory.numbers, *entry)
+		if len(entry.assignments) > 1 {
+			inventory.conflictingNumbers = append(inventory.conflictingNumbers, entry.number)
+		}
+	}
+	for number := range unpooled {
+		inventory.unpooledNumbers = append(inventory.unpooledNumbers, number)
+	}
+	sort.Slice(inventory.numbers, func(i, j int) bool {
+		return inventory.numbers[i].number < inventory.numbers[j].number
+	})
+	sort.Strings(inventory.conflictingNumbers)
+	sort.Strings(inventory.unpooledNumbers)
+	return inventory
+}
+
+// addAssignment adds an assignment unless the number is already assigned to the same entity
+func (n *inventoryNumber) addAssignment(assignment numberAssignment) {
+	for _, existing := range n.assignments {
+		if existing.ownerId == assignment.ownerId {
+			return
+		}
+	}
+	n.assignments = append(n.assignments, assignment)
+}
+
+func inAnyDidPool(number string, didPools []platformclientv2.Didpool) bool {
+	for _, didPool := range didPools {
+		if inDidPoolRange(number, didPool) {
+			return true
+		}
+	}
+	return false
+}
+
+// availableNumbers returns the numbers that are neither assigned nor reserved
+func (i didInventory) availableNumbers() []string {
+	available := []string{}
+	for _, entry := range i.numbers {
+		if len(entry.assignments) == 0 && !entry.reserved {
+			available = append(available, entry.number)
+		}
+	}
+	return available
+}
+
+// checkReservedNumbers checks that the reserved numbers belong to the inventoried DID pools and are not assigned
+func (i didInventory) checkReservedNumbers(reservedNumbers []string) error {
+	numbersByValue := make(map[string]inventoryNumber, len(i.numbers))
+	for _, entry := range i.numbers {
+		numbersByValue[entry.number] = entry
+	}
+
+	var problems []string
+	for _, reserved := range reservedNumbers {
+		entry, ok := numbersByValue[normalizeNumber(reserved)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not in any of the DID pools", reserved))
+			continue
+		}
+		for _, assignment := range entry.assignments {
+			problems = append(problems, fmt.Sprintf("%s cannot be reserved because it is assigned to %s", reserved, assignment.describe()))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+func (a numberAssignment) describe() string {
+	if a.ownerName == "" {
+		return fmt.Sprintf("%s %s", a.ownerType, a.ownerId)
+	}
+	return fmt.Sprintf("%s %s (%s)", a.ownerType, a.ownerName, a.ownerId)
+}
+
+func flattenInventoryNumbers(numbers []inventoryNumber) []interface{} {
+	flattened := make([]interface{}, 0, len(numbers))
+	for _, entry := range numbers {
+		assignments := make([]interface{}, 0, len(entry.assignments))
+		for _, assignment := range entry.assignments {
+			assignments = append(assignments, map[string]interface{}{
+				"type": assignment.ownerType,
+				"id":   assignment.ownerId,
+				"name": assignment.ownerName,
+			})
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"number":      entry.number,
+			"did_pool_id": entry.didPoolId,
+			"assigned":    len(entry.assignments) > 0,
+			"reserved":    entry.reserved,
+			"assignments": assignments,
+		})
+	}
+	return flattened
+}
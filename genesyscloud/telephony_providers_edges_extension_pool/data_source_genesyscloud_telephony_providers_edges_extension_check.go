@@ -0,0 +1,55 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceExtensionCheckRead checks the extension pools and extension assignments of the configuration
+func dataSourceExtensionCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	extensionPoolProxy := getExtensionPoolProxy(sdkConfig)
+
+	var ranges []extensionRange
+	for _, pool := range d.Get("extension_pools").([]interface{}) {
+		poolMap := pool.(map[string]interface{})
+		r, err := parseExtensionRange("", poolMap["start_number"].(string), poolMap["end_number"].(string))
+		if err != nil {
+			return util.BuildDiagnosticError(extensionCheckDataSourceName, "Invalid extension_pools", err)
+		}
+		ranges = append(ranges, r)
+	}
+	if d.Get("include_organization_pools").(bool) {
+		extensionPools, resp, err := extensionPoolProxy.getAllExtensionPools(ctx)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(extensionCheckDataSourceName, fmt.Sprintf("Failed to get extension pools | error: %s", err), resp)
+		}
+		ranges = append(ranges, buildExtensionRanges(*extensionPools)...)
+	}
+
+	var assignments []extensionAssignment
+	for _, extension := range d.Get("extensions").([]interface{}) {
+		extensionMap := extension.(map[string]interface{})
+		assignments = append(assignments, extensionAssignment{
+			extension: extensionMap["extension"].(string),
+			owner:     extensionMap["owner"].(string),
+		})
+	}
+
+	result := checkExtensionAssignments(ranges, assignments)
+	log.Printf("Checked %d extensions against %d extension pools: %d overlapping pools, %d unpooled and %d duplicate extensions",
+		len(assignments), len(ranges), len(result.overlappingPools), len(result.unpooledExtensions), len(result.duplicateExtensions))
+
+	d.SetId(extensionCheckDataSourceName)
+	_ = d.Set("overlapping_pools", result.overlappingPools)
+	_ = d.Set("unpooled_extensions", result.unpooledExtensions)
+	_ = d.Set("duplicate_extensions", flattenExtensionDuplicates(result.duplicateExtensions))
+	_ = d.Set("valid", result.valid())
+	return nil
+}
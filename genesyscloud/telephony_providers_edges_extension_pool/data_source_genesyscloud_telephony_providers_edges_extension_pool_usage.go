@@ -0,0 +1,79 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+// dataSourceExtensionPoolUsageRead counts the used and free extensions of the extension pools
+func dataSourceExtensionPoolUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	extensionPoolProxy := getExtensionPoolProxy(sdkConfig)
+	extensionPoolIds := *lists.SetToStringList(d.Get("extension_pool_ids").(*schema.Set))
+	sort.Strings(extensionPoolIds)
+
+	extensionPools, resp, err := extensionPoolProxy.getAllExtensionPools(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(usageDataSourceName, fmt.Sprintf("Failed to get extension pools | error: %s", err), resp)
+	}
+	selectedPools, err := selectExtensionPools(*extensionPools, extensionPoolIds)
+	if err != nil {
+		return util.BuildDiagnosticError(usageDataSourceName, "Failed to select extension pools", err)
+	}
+
+	extensions, resp, err := extensionPoolProxy.getAllExtensions(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(usageDataSourceName, fmt.Sprintf("Failed to get extensions | error: %s", err), resp)
+	}
+
+	descriptions := make(map[string]string, len(selectedPools))
+	for _, extensionPool := range selectedPools {
+		if extensionPool.Id != nil && extensionPool.Description != nil {
+			descriptions[*extensionPool.Id] = *extensionPool.Description
+		}
+	}
+	usage := buildExtensionPoolUsage(buildExtensionRanges(selectedPools), descriptions, *extensions)
+	log.Printf("Counted the extensions of %d extension pools", len(usage))
+
+	if len(extensionPoolIds) == 0 {
+		d.SetId("all")
+	} else {
+		d.SetId(strings.Join(extensionPoolIds, ","))
+	}
+	_ = d.Set("pools", flattenExtensionPoolUsage(usage))
+	return nil
+}
+
+// selectExtensionPools returns the extension pools with the given IDs, or every extension pool if no IDs are given
+func selectExtensionPools(extensionPools []platformclientv2.Extensionpool, extensionPoolIds []string) ([]platformclientv2.Extensionpool, error) {
+	if len(extensionPoolIds) == 0 {
+		return extensionPools, nil
+	}
+
+	poolsById := make(map[string]platformclientv2.Extensionpool, len(extensionPools))
+	for _, extensionPool := range extensionPools {
+		if extensionPool.Id != nil {
+			poolsById[*extensionPool.Id] = extensionPool
+		}
+	}
+
+	selected := make([]platformclientv2.Extensionpool, 0, len(extensionPoolIds))
+	for _, id := range extensionPoolIds {
+		extensionPool, ok := poolsById[id]
+		if !ok {
+			return nil, fmt.Errorf("extension pool %s not found", id)
+		}
+		selected = append(selected, extensionPool)
+	}
+	return selected, nil
+}
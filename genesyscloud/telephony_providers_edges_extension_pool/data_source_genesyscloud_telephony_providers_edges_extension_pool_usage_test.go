@@ -0,0 +1,82 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceExtensionPoolUsageAndCheck(t *testing.T) {
+	t.Parallel()
+	var (
+		extensionPoolStartNumber = "2700"
+		extensionPoolEndNumber   = "2709"
+		extensionPoolRes         = "extensionPoolUsage"
+		usageDataRes             = "usage"
+		checkDataRes             = "check"
+		extensionPoolResource    = GenerateExtensionPoolResource(&ExtensionPoolStruct{
+			extensionPoolRes,
+			extensionPoolStartNumber,
+			extensionPoolEndNumber,
+			util.NullValue, // No description
+		})
+	)
+	_, err := provider.AuthorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	DeleteExtensionPoolWithNumber(extensionPoolStartNumber)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// No extensions of the new pool are assigned
+				Config: extensionPoolResource + fmt.Sprintf(`data "%s" "%s" {
+		extension_pool_ids = [%s.%s.id]
+	}
+	`, usageDataSourceName, usageDataRes, ResourceName, extensionPoolRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+usageDataSourceName+"."+usageDataRes, "pools.#", "1"),
+					resource.TestCheckResourceAttrPair("data."+usageDataSourceName+"."+usageDataRes, "pools.0.extension_pool_id", ResourceName+"."+extensionPoolRes, "id"),
+					resource.TestCheckResourceAttr("data."+usageDataSourceName+"."+usageDataRes, "pools.0.size", "10"),
+					resource.TestCheckResourceAttr("data."+usageDataSourceName+"."+usageDataRes, "pools.0.used", "0"),
+					resource.TestCheckResourceAttr("data."+usageDataSourceName+"."+usageDataRes, "pools.0.free", "10"),
+				),
+			},
+			{
+				// Check extensions against the configured pool
+				Config: extensionPoolResource + fmt.Sprintf(`data "%s" "%s" {
+		extension_pools {
+			start_number = %s.%s.start_number
+			end_number   = %s.%s.end_number
+		}
+		extensions {
+			extension = "2701"
+			owner     = "user jane"
+		}
+		extensions {
+			extension = "2701"
+			owner     = "group sales"
+		}
+		extensions {
+			extension = "2790"
+			owner     = "user john"
+		}
+	}
+	`, extensionCheckDataSourceName, checkDataRes, ResourceName, extensionPoolRes, ResourceName, extensionPoolRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+extensionCheckDataSourceName+"."+checkDataRes, "valid", "false"),
+					resource.TestCheckResourceAttr("data."+extensionCheckDataSourceName+"."+checkDataRes, "unpooled_extensions.#", "1"),
+					resource.TestCheckResourceAttr("data."+extensionCheckDataSourceName+"."+checkDataRes, "unpooled_extensions.0", "2790"),
+					resource.TestCheckResourceAttr("data."+extensionCheckDataSourceName+"."+checkDataRes, "duplicate_extensions.0.extension", "2701"),
+					resource.TestCheckResourceAttr("data."+extensionCheckDataSourceName+"."+checkDataRes, "duplicate_extensions.0.owners.#", "2"),
+				),
+			},
+		},
+	})
+}
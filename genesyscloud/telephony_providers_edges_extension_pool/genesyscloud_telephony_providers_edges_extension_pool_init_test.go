@@ -25,6 +25,8 @@ func (r *registerTestInstance) registerTestDataSources() {
 	r.datasourceMapMutex.Lock()
 	defer r.datasourceMapMutex.Unlock()
 	providerDataSources["genesyscloud_telephony_providers_edges_extension_pool"] = DataSourceExtensionPool()
+	providerDataSources[usageDataSourceName] = DataSourceExtensionPoolUsage()
+	providerDataSources[extensionCheckDataSourceName] = DataSourceExtensionCheck()
 }
 
 func initTestResources() {
@@ -13,6 +13,7 @@ type deleteExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, ex
 type updateExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, extensionPoolId string, body platformclientv2.Extensionpool) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
 type createExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, body platformclientv2.Extensionpool) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
 type getAllExtensionPoolsFunc func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
+type getAllExtensionsFunc func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error)
 
 // ExtensionPoolProxy represents the interface required to access the extension pool custom resource
 type extensionPoolProxy struct {
@@ -24,6 +25,7 @@ type extensionPoolProxy struct {
 	updateExtensionPoolAttr  updateExtensionPoolFunc
 	createExtensionPoolAttr  createExtensionPoolFunc
 	getAllExtensionPoolsAttr getAllExtensionPoolsFunc
+	getAllExtensionsAttr     getAllExtensionsFunc
 }
 
 func newExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extensionPoolProxy {
@@ -38,6 +40,7 @@ func newExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extens
 		updateExtensionPoolAttr:  updateExtensionPoolFn,
 		createExtensionPoolAttr:  createExtensionPoolFn,
 		getAllExtensionPoolsAttr: getAllExtensionPoolsFn,
+		getAllExtensionsAttr:     getAllExtensionsFn,
 	}
 }
 
@@ -68,6 +71,11 @@ func (p *extensionPoolProxy) getAllExtensionPools(ctx context.Context) (*[]platf
 	return p.getAllExtensionPoolsAttr(ctx, p)
 }
 
+// getAllExtensions returns the extensions of the organization that are assigned to users, groups or stations
+func (p *extensionPoolProxy) getAllExtensions(ctx context.Context) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+	return p.getAllExtensionsAttr(ctx, p)
+}
+
 func getExtensionPoolFn(ctx context.Context, p *extensionPoolProxy, extensionPoolId string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
 	extensionPool, resp, err := p.edgesApi.GetTelephonyProvidersEdgesExtensionpool(extensionPoolId)
 	if err != nil {
@@ -140,3 +148,33 @@ func getAllExtensionPoolsFn(ctx context.Context, p *extensionPoolProxy) (*[]plat
 
 	return &allExtensionPools, resp, nil
 }
+
+func getAllExtensionsFn(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var (
+		allExtensions []platformclientv2.Extension
+		resp          *platformclientv2.APIResponse
+	)
+
+	for pageNum := 1; ; pageNum++ {
+		extensions, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesExtensions(pageSize, pageNum, "", "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, err
+		}
+		if extensions.Entities == nil || len(*extensions.Entities) == 0 {
+			break
+		}
+		for _, extension := range *extensions.Entities {
+			if extension.State != nil && *extension.State == "deleted" {
+				continue
+			}
+			allExtensions = append(allExtensions, extension)
+		}
+		if extensions.PageCount == nil || pageNum >= *extensions.PageCount {
+			break
+		}
+	}
+
+	return &allExtensions, resp, nil
+}
@@ -10,7 +10,9 @@ import (
 )
 
 const (
-	ResourceName = "genesyscloud_telephony_providers_edges_extension_pool"
+	ResourceName                 = "genesyscloud_telephony_providers_edges_extension_pool"
+	usageDataSourceName          = "genesyscloud_telephony_providers_edges_extension_pool_usage"
+	extensionCheckDataSourceName = "genesyscloud_telephony_providers_edges_extension_check"
 )
 
 func ResourceTelephonyExtensionPool() *schema.Resource {
@@ -23,10 +25,11 @@ func ResourceTelephonyExtensionPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeExtensionPoolDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"start_number": {
-				Description:      "Starting phone number of the Extension Pool range. Changing the start_number attribute will cause the extension object to be dropped and recreated with a new ID.",
+				Description:      "Starting phone number of the Extension Pool range. The range must not overlap another extension pool of the organization. Changing the start_number attribute will cause the extension object to be dropped and recreated with a new ID.",
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
@@ -69,6 +72,162 @@ func DataSourceExtensionPool() *schema.Resource {
 	}
 }
 
+var extensionPoolUsageResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"extension_pool_id": {
+			Description: "ID of the extension pool.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_number": {
+			Description: "Starting number of the extension pool range.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end_number": {
+			Description: "Ending number of the extension pool range.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "Description of the extension pool.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"size": {
+			Description: "Number of extensions in the range.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"used": {
+			Description: "Number of extensions of the range assigned to users, groups or stations.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"free": {
+			Description: "Number of extensions of the range that are not assigned.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceExtensionPoolUsage registers the genesyscloud_telephony_providers_edges_extension_pool_usage data source
+func DataSourceExtensionPoolUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source reporting how many extensions of each extension pool are used and free.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceExtensionPoolUsageRead),
+		Schema: map[string]*schema.Schema{
+			"extension_pool_ids": {
+				Description: "IDs of the extension pools to report on. Defaults to every extension pool of the organization.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"pools": {
+				Description: "Usage of each extension pool, ordered by start number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        extensionPoolUsageResource,
+			},
+		},
+	}
+}
+
+// DataSourceExtensionCheck registers the genesyscloud_telephony_providers_edges_extension_check data source
+func DataSourceExtensionCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source checking the extension pools and the extensions assigned to users and groups in a configuration, for use in `check` blocks or `precondition`s. " +
+			"Extension pools must not overlap, and every extension must fall inside an extension pool and be assigned only once.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceExtensionCheckRead),
+		Schema: map[string]*schema.Schema{
+			"extension_pools": {
+				Description: "Extension pools of the configuration, e.g. the ranges of `genesyscloud_telephony_providers_edges_extension_pool` resources that are not created yet.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_number": {
+							Description:      "Starting number of the extension pool range.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidateExtensionPool,
+						},
+						"end_number": {
+							Description:      "Ending number of the extension pool range.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidateExtensionPool,
+						},
+					},
+				},
+			},
+			"include_organization_pools": {
+				Description: "Whether the extension pools of the organization are checked along with `extension_pools`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"extensions": {
+				Description: "Extensions assigned to users and groups in the configuration, e.g. the `extension` of the `addresses.phone_numbers` of `genesyscloud_user` and `genesyscloud_group` resources.",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension": {
+							Description: "The extension.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"owner": {
+							Description: "Label of the user or group the extension is assigned to, reported when the extension is assigned more than once.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"overlapping_pools": {
+				Description: "Pairs of extension pools whose ranges overlap, e.g. `1000-1999 overlaps 1500-2499`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unpooled_extensions": {
+				Description: "Extensions that do not fall inside any extension pool, in ascending order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"duplicate_extensions": {
+				Description: "Extensions assigned more than once, in ascending order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension": {
+							Description: "The extension.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"owners": {
+							Description: "Owner of each assignment of the extension.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"valid": {
+				Description: "True if no extension pools overlap and every extension is pooled and assigned once.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
 func TelephonyExtensionPoolExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllExtensionPools),
@@ -78,6 +237,8 @@ func TelephonyExtensionPoolExporter() *resourceExporter.ResourceExporter {
 
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(ResourceName, DataSourceExtensionPool())
+	l.RegisterDataSource(usageDataSourceName, DataSourceExtensionPoolUsage())
+	l.RegisterDataSource(extensionCheckDataSourceName, DataSourceExtensionCheck())
 	l.RegisterResource(ResourceName, ResourceTelephonyExtensionPool())
 	l.RegisterExporter(ResourceName, TelephonyExtensionPoolExporter())
 }
@@ -0,0 +1,129 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseExtensionRange(t *testing.T) {
+	r, err := parseExtensionRange("pool-1", "1000", "1999")
+	assert.NoError(t, err)
+	assert.Equal(t, "1000-1999", r.String())
+	assert.Equal(t, 1000, r.size())
+	assert.True(t, r.contains("1000"))
+	assert.True(t, r.contains("1999"))
+	assert.False(t, r.contains("2000"))
+	assert.False(t, r.contains("x100"))
+
+	_, err = parseExtensionRange("", "2000", "1999")
+	assert.EqualError(t, err, "start_number 2000 is greater than end_number 1999")
+}
+
+func TestUnitValidateExtensionRangeOverlap(t *testing.T) {
+	ranges := buildExtensionRanges([]platformclientv2.Extensionpool{
+		{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1999")},
+		{Id: platformclientv2.String("pool-2"), StartNumber: platformclientv2.String("3000"), EndNumber: platformclientv2.String("3099")},
+		// Pools without a range are skipped
+		{Id: platformclientv2.String("pool-3")},
+	})
+	assert.Len(t, ranges, 2)
+
+	newRange, _ := parseExtensionRange("", "2000", "2999")
+	assert.NoError(t, validateExtensionRangeOverlap(newRange, ranges))
+
+	newRange, _ = parseExtensionRange("", "1500", "3049")
+	err := validateExtensionRangeOverlap(newRange, ranges)
+	assert.Error(t, err)
+	messages := strings.Split(err.Error(), "\n")
+	assert.Equal(t, []string{
+		"extension pool 1500-3049 overlaps extension pool 1000-1999 (pool-1)",
+		"extension pool 1500-3049 overlaps extension pool 3000-3099 (pool-2)",
+	}, messages)
+
+	// An existing pool does not overlap itself
+	newRange, _ = parseExtensionRange("pool-1", "1000", "1999")
+	assert.NoError(t, validateExtensionRangeOverlap(newRange, ranges))
+}
+
+func TestUnitBuildExtensionPoolUsage(t *testing.T) {
+	ranges := buildExtensionRanges([]platformclientv2.Extensionpool{
+		{Id: platformclientv2.String("pool-2"), StartNumber: platformclientv2.String("2000"), EndNumber: platformclientv2.String("2009")},
+		{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1001")},
+	})
+	extensions := []platformclientv2.Extension{
+		{Number: platformclientv2.String("1000"), ExtensionPool: &platformclientv2.Domainentityref{Id: platformclientv2.String("pool-1")}},
+		{Number: platformclientv2.String("1001"), ExtensionPool: &platformclientv2.Domainentityref{Id: platformclientv2.String("pool-1")}},
+		// Extensions without an extension pool are counted in the range that contains them
+		{Number: platformclientv2.String("2005")},
+		{Number: platformclientv2.String("5000")},
+	}
+
+	pools := flattenExtensionPoolUsage(buildExtensionPoolUsage(ranges, map[string]string{"pool-1": "Agents"}, extensions))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"extension_pool_id": "pool-1", "start_number": "1000", "end_number": "1001", "description": "Agents", "size": 2, "used": 2, "free": 0},
+		map[string]interface{}{"extension_pool_id": "pool-2", "start_number": "2000", "end_number": "2009", "description": "", "size": 10, "used": 1, "free": 9},
+	}, pools)
+
+	_, err := selectExtensionPools([]platformclientv2.Extensionpool{{Id: platformclientv2.String("pool-1")}}, []string{"pool-1", "pool-9"})
+	assert.EqualError(t, err, "extension pool pool-9 not found")
+}
+
+func TestUnitCheckExtensionAssignments(t *testing.T) {
+	configured, _ := parseExtensionRange("", "1000", "1999")
+	existing, _ := parseExtensionRange("pool-1", "1000", "1999")
+	overlapping, _ := parseExtensionRange("pool-2", "1900", "2099")
+
+	// A configured pool that already exists does not overlap itself
+	result := checkExtensionAssignments([]extensionRange{configured, existing}, []extensionAssignment{
+		{extension: "1001", owner: "user jane"},
+		{extension: "1002", owner: "group sales"},
+	})
+	assert.True(t, result.valid())
+
+	result = checkExtensionAssignments([]extensionRange{configured, existing, overlapping}, []extensionAssignment{
+		{extension: "1001", owner: "user jane"},
+		{extension: "3000", owner: "user john"},
+		{extension: "1001", owner: "group sales"},
+	})
+	assert.False(t, result.valid())
+	assert.Equal(t, []string{"1000-1999 overlaps 1900-2099"}, result.overlappingPools)
+	assert.Equal(t, []string{"3000"}, result.unpooledExtensions)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"extension": "1001", "owners": []string{"user jane", "group sales"}},
+	}, flattenExtensionDuplicates(result.duplicateExtensions))
+}
+
+func TestUnitDataSourceExtensionCheckRead(t *testing.T) {
+	internalProxy = &extensionPoolProxy{}
+	defer func() { internalProxy = nil }()
+	internalProxy.getAllExtensionPoolsAttr = func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Extensionpool{
+			{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1999")},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceExtensionCheck().Schema, map[string]interface{}{
+		"extension_pools": []interface{}{
+			map[string]interface{}{"start_number": "2000", "end_number": "2999"},
+		},
+		"extensions": []interface{}{
+			map[string]interface{}{"extension": "1500", "owner": "user jane"},
+			map[string]interface{}{"extension": "2500", "owner": "group sales"},
+			map[string]interface{}{"extension": "4000", "owner": "user john"},
+		},
+	})
+	diagErr := dataSourceExtensionCheckRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, []interface{}{"4000"}, d.Get("unpooled_extensions"))
+	assert.Equal(t, 0, d.Get("duplicate_extensions.#"))
+	assert.Equal(t, 0, d.Get("overlapping_pools.#"))
+	assert.Equal(t, false, d.Get("valid"))
+}
@@ -1,10 +1,15 @@
 package telephony_providers_edges_extension_pool
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
 )
 
@@ -15,6 +20,240 @@ type ExtensionPoolStruct struct {
 	Description string
 }
 
+// extensionRange is the range of extensions of an extension pool
+type extensionRange struct {
+	id          string
+	startNumber string
+	endNumber   string
+	first       int
+	last        int
+}
+
+func parseExtensionRange(id string, startNumber string, endNumber string) (extensionRange, error) {
+	first, err := strconv.Atoi(startNumber)
+	if err != nil {
+		return extensionRange{}, fmt.Errorf("start_number %s is not a number", startNumber)
+	}
+	last, err := strconv.Atoi(endNumber)
+	if err != nil {
+		return extensionRange{}, fmt.Errorf("end_number %s is not a number", endNumber)
+	}
+	if first > last {
+		return extensionRange{}, fmt.Errorf("start_number %s is greater than end_number %s", startNumber, endNumber)
+	}
+	return extensionRange{id: id, startNumber: startNumber, endNumber: endNumber, first: first, last: last}, nil
+}
+
+func (r extensionRange) String() string {
+	return r.startNumber + "-" + r.endNumber
+}
+
+func (r extensionRange) size() int {
+	return r.last - r.first + 1
+}
+
+func (r extensionRange) contains(extension string) bool {
+	number, err := strconv.Atoi(extension)
+	return err == nil && number >= r.first && number <= r.last
+}
+
+func (r extensionRange) overlaps(other extensionRange) bool {
+	return r.first <= other.last && other.first <= r.last
+}
+
+// buildExtensionRanges parses the ranges of the extension pools of the organization. Pools without a valid range are skipped.
+func buildExtensionRanges(extensionPools []platformclientv2.Extensionpool) []extensionRange {
+	ranges := make([]extensionRange, 0, len(extensionPools))
+	for _, extensionPool := range extensionPools {
+		if extensionPool.Id == nil || extensionPool.StartNumber == nil || extensionPool.EndNumber == nil {
+			continue
+		}
+		r, err := parseExtensionRange(*extensionPool.Id, *extensionPool.StartNumber, *extensionPool.EndNumber)
+		if err != nil {
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// customizeExtensionPoolDiff rejects ranges that are reversed or that overlap another extension pool of the organization
+func customizeExtensionPoolDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("start_number", "end_number") || !diff.NewValueKnown("start_number") || !diff.NewValueKnown("end_number") {
+		return nil
+	}
+
+	newRange, err := parseExtensionRange(diff.Id(), diff.Get("start_number").(string), diff.Get("end_number").(string))
+	if err != nil {
+		return err
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	extensionPoolProxy := getExtensionPoolProxy(sdkConfig)
+	extensionPools, _, getErr := extensionPoolProxy.getAllExtensionPools(ctx)
+	if getErr != nil {
+		return fmt.Errorf("failed to get extension pools to check extension pool %s for overlaps: %s", newRange, getErr)
+	}
+	return validateExtensionRangeOverlap(newRange, buildExtensionRanges(*extensionPools))
+}
+
+// validateExtensionRangeOverlap returns an error for each range that overlaps the new range, other than the range being replaced
+func validateExtensionRangeOverlap(newRange extensionRange, ranges []extensionRange) error {
+	var errs []error
+	for _, r := range ranges {
+		if r.id != "" && r.id == newRange.id {
+			continue
+		}
+		if newRange.overlaps(r) {
+			errs = append(errs, fmt.Errorf("extension pool %s overlaps extension pool %s (%s)", newRange, r, r.id))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// extensionPoolUsage is the number of extensions of an extension pool that are assigned
+type extensionPoolUsage struct {
+	extensionRange
+	description string
+	used        int
+}
+
+// buildExtensionPoolUsage counts the assigned extensions of each range. Extensions without an extension pool are counted in the range that contains them.
+func buildExtensionPoolUsage(ranges []extensionRange, descriptions map[string]string, extensions []platformclientv2.Extension) []extensionPoolUsage {
+	usage := make([]extensionPoolUsage, 0, len(ranges))
+	indexById := make(map[string]int, len(ranges))
+	for _, r := range ranges {
+		indexById[r.id] = len(usage)
+		usage = append(usage, extensionPoolUsage{extensionRange: r, description: descriptions[r.id]})
+	}
+
+	for _, extension := range extensions {
+		if extension.ExtensionPool != nil && extension.ExtensionPool.Id != nil {
+			if i, ok := indexById[*extension.ExtensionPool.Id]; ok {
+				usage[i].used++
+			}
+			continue
+		}
+		if extension.Number == nil {
+			continue
+		}
+		for i := range usage {
+			if usage[i].contains(*extension.Number) {
+				usage[i].used++
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(usage, func(i, j int) bool {
+		return usage[i].first < usage[j].first
+	})
+	return usage
+}
+
+func flattenExtensionPoolUsage(usage []extensionPoolUsage) []interface{} {
+	pools := make([]interface{}, 0, len(usage))
+	for _, pool := range usage {
+		free := pool.size() - pool.used
+		if free < 0 {
+			free = 0
+		}
+		pools = append(pools, map[string]interface{}{
+			"extension_pool_id": pool.id,
+			"start_number":      pool.startNumber,
+			"end_number":        pool.endNumber,
+			"description":       pool.description,
+			"size":              pool.size(),
+			"used":              pool.used,
+			"free":              free,
+		})
+	}
+	return pools
+}
+
+// extensionAssignment is an extension assigned to a user or group in the configuration
+type extensionAssignment struct {
+	extension string
+	owner     string
+}
+
+// extensionDuplicate is an extension assigned more than once, with the owner of each assignment
+type extensionDuplicate struct {
+	extension string
+	owners    []string
+}
+
+// extensionCheckResult lists the problems found with the extension pools and the extension assignments of a configuration
+type extensionCheckResult struct {
+	overlappingPools    []string
+	unpooledExtensions  []string
+	duplicateExtensions []extensionDuplicate
+}
+
+func (r extensionCheckResult) valid() bool {
+	return len(r.overlappingPools) == 0 && len(r.unpooledExtensions) == 0 && len(r.duplicateExtensions) == 0
+}
+
+// checkExtensionAssignments checks that the ranges do not overlap, and that every extension is assigned once and falls inside a range
+func checkExtensionAssignments(ranges []extensionRange, assignments []extensionAssignment) extensionCheckResult {
+	var result extensionCheckResult
+
+	// A pool of the configuration that already exists in the organization is listed twice
+	seenRanges := make(map[string]bool, len(ranges))
+	uniqueRanges := make([]extensionRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !seenRanges[r.String()] {
+			seenRanges[r.String()] = true
+			uniqueRanges = append(uniqueRanges, r)
+		}
+	}
+	for i := range uniqueRanges {
+		for j := i + 1; j < len(uniqueRanges); j++ {
+			if uniqueRanges[i].overlaps(uniqueRanges[j]) {
+				result.overlappingPools = append(result.overlappingPools, fmt.Sprintf("%s overlaps %s", uniqueRanges[i], uniqueRanges[j]))
+			}
+		}
+	}
+
+	owners := make(map[string][]string)
+	var extensions []string
+	for _, assignment := range assignments {
+		if _, ok := owners[assignment.extension]; !ok {
+			extensions = append(extensions, assignment.extension)
+		}
+		owners[assignment.extension] = append(owners[assignment.extension], assignment.owner)
+	}
+	sort.Strings(extensions)
+
+	for _, extension := range extensions {
+		if len(owners[extension]) > 1 {
+			result.duplicateExtensions = append(result.duplicateExtensions, extensionDuplicate{extension: extension, owners: owners[extension]})
+		}
+		pooled := false
+		for _, r := range uniqueRanges {
+			if r.contains(extension) {
+				pooled = true
+				break
+			}
+		}
+		if !pooled {
+			result.unpooledExtensions = append(result.unpooledExtensions, extension)
+		}
+	}
+	return result
+}
+
+func flattenExtensionDuplicates(duplicates []extensionDuplicate) []interface{} {
+	flattened := make([]interface{}, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		flattened = append(flattened, map[string]interface{}{
+			"extension": duplicate.extension,
+			"owners":    duplicate.owners,
+		})
+	}
+	return flattened
+}
+
 func GenerateExtensionPoolResource(extensionPool *ExtensionPoolStruct) string {
 	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_extension_pool" "%s" {
 		start_number = "%s"
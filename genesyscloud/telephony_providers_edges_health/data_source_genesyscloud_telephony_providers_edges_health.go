@@ -0,0 +1,72 @@
+package telephony_providers_edges_health
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_telephony_providers_edges_health.go contains the data source implementations
+   for the edge health and trunk health data sources.
+*/
+
+// dataSourceEdgeHealthRead reads the live status of the edges of a site or edge group
+func dataSourceEdgeHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getEdgesHealthProxy(sdkConfig)
+	siteId := d.Get("site_id").(string)
+	edgeGroupId := d.Get("edge_group_id").(string)
+
+	edges, resp, err := proxy.getEdges(ctx, siteId, edgeGroupId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(edgeHealthDataSourceName, fmt.Sprintf("Failed to read edges of %s | error: %s", describeHealthScope(siteId, edgeGroupId), err), resp)
+	}
+	log.Printf("Read %d edges of %s", len(*edges), describeHealthScope(siteId, edgeGroupId))
+
+	d.SetId(buildHealthId(siteId, edgeGroupId))
+	_ = d.Set("edges", flattenEdgeHealth(*edges))
+	_ = d.Set("all_online", allEdgesOnline(*edges))
+	return nil
+}
+
+// dataSourceTrunkHealthRead reads the live status of the trunks running on the edges of a site or edge group
+func dataSourceTrunkHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getEdgesHealthProxy(sdkConfig)
+	siteId := d.Get("site_id").(string)
+	edgeGroupId := d.Get("edge_group_id").(string)
+	trunkType := d.Get("trunk_type").(string)
+	trunkBaseSettingsId := d.Get("trunk_base_settings_id").(string)
+
+	edges, resp, err := proxy.getEdges(ctx, siteId, edgeGroupId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(trunkHealthDataSourceName, fmt.Sprintf("Failed to read edges of %s | error: %s", describeHealthScope(siteId, edgeGroupId), err), resp)
+	}
+
+	trunks := make([]platformclientv2.Trunk, 0)
+	for _, edge := range *edges {
+		edgeTrunks, resp, err := proxy.getEdgeTrunks(ctx, *edge.Id, trunkType)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(trunkHealthDataSourceName, fmt.Sprintf("Failed to read trunks of edge %s | error: %s", *edge.Id, err), resp)
+		}
+		for _, trunk := range *edgeTrunks {
+			if trunkBaseSettingsId != "" && (trunk.TrunkBase == nil || trunk.TrunkBase.Id == nil || *trunk.TrunkBase.Id != trunkBaseSettingsId) {
+				continue
+			}
+			trunks = append(trunks, trunk)
+		}
+	}
+	log.Printf("Read %d trunks on %d edges of %s", len(trunks), len(*edges), describeHealthScope(siteId, edgeGroupId))
+
+	d.SetId(buildHealthId(siteId, edgeGroupId))
+	_ = d.Set("trunks", flattenTrunkHealth(trunks))
+	_ = d.Set("all_connected", allTrunksConnected(trunks))
+	return nil
+}
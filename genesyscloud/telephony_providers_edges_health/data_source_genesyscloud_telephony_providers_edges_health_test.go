@@ -0,0 +1,39 @@
+package telephony_providers_edges_health
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEdgesHealth(t *testing.T) {
+	var (
+		orgConfig    = `data "genesyscloud_organizations_me" "me" {}` + "\n"
+		defaultSite  = "data.genesyscloud_organizations_me.me.default_site_id"
+		edgeHealth   = "data." + edgeHealthDataSourceName + ".default-site"
+		trunkHealth  = "data." + trunkHealthDataSourceName + ".default-site"
+		healthConfig = orgConfig +
+			GenerateEdgeHealthDataSource("default-site", defaultSite) +
+			GenerateTrunkHealthDataSource("default-site", defaultSite, "EXTERNAL")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The edges and trunks of the default site depend on the org, so only the shape of the result is checked
+				Config: healthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(edgeHealth, "id", "data.genesyscloud_organizations_me.me", "default_site_id"),
+					resource.TestCheckResourceAttrSet(edgeHealth, "edges.#"),
+					resource.TestCheckResourceAttrSet(edgeHealth, "all_online"),
+					resource.TestCheckResourceAttrSet(trunkHealth, "trunks.#"),
+					resource.TestCheckResourceAttrSet(trunkHealth, "all_connected"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package telephony_providers_edges_health
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEdgeHealth(t *testing.T) {
+	edges := []platformclientv2.Edge{
+		{Id: platformclientv2.String("edge-2"), Name: platformclientv2.String("Edge B"), OnlineStatus: platformclientv2.String("OFFLINE"), StatusCode: platformclientv2.String("OUTOFSERVICE")},
+		{Id: platformclientv2.String("edge-1"), Name: platformclientv2.String("Edge A"), OnlineStatus: platformclientv2.String("ONLINE"), StatusCode: platformclientv2.String("INSERVICE"),
+			SoftwareVersion: platformclientv2.String("1.0.0.100"), Site: &platformclientv2.Site{Id: platformclientv2.String("site-1")}, EdgeGroup: &platformclientv2.Edgegroup{Id: platformclientv2.String("group-1")}},
+	}
+
+	flattened := flattenEdgeHealth(edges)
+	assert.Len(t, flattened, 2)
+	assert.Equal(t, map[string]interface{}{
+		"edge_id":          "edge-1",
+		"name":             "Edge A",
+		"site_id":          "site-1",
+		"edge_group_id":    "group-1",
+		"online_status":    "ONLINE",
+		"online":           true,
+		"status_code":      "INSERVICE",
+		"software_version": "1.0.0.100",
+		"staged_version":   "",
+	}, flattened[0])
+	assert.Equal(t, false, flattened[1].(map[string]interface{})["online"])
+
+	assert.False(t, allEdgesOnline(edges))
+	assert.True(t, allEdgesOnline(edges[1:]))
+	// A site without edges is not ready
+	assert.False(t, allEdgesOnline(nil))
+}
+
+func TestUnitTrunkHealth(t *testing.T) {
+	earlier := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	registered := platformclientv2.Trunk{
+		Name:            platformclientv2.String("Carrier A"),
+		Enabled:         platformclientv2.Bool(true),
+		InService:       platformclientv2.Bool(true),
+		ConnectedStatus: &platformclientv2.Trunkconnectedstatus{Connected: platformclientv2.Bool(true), ConnectedStateTime: &earlier},
+		RegistersStatus: &[]platformclientv2.Trunkmetricsregisters{{RegisterState: platformclientv2.Bool(true)}},
+	}
+	failing := platformclientv2.Trunk{
+		Name:            platformclientv2.String("Carrier B"),
+		Enabled:         platformclientv2.Bool(true),
+		InService:       platformclientv2.Bool(true),
+		ConnectedStatus: &platformclientv2.Trunkconnectedstatus{Connected: platformclientv2.Bool(false)},
+		OptionsStatus: &[]platformclientv2.Trunkmetricsoptions{
+			{OptionState: platformclientv2.Bool(false), OptionStateTime: &earlier, ErrorInfo: &platformclientv2.Trunkerrorinfo{Code: platformclientv2.String("408"), Text: platformclientv2.String("Request Timeout")}},
+		},
+		RegistersStatus: &[]platformclientv2.Trunkmetricsregisters{
+			{RegisterState: platformclientv2.Bool(true)},
+			{RegisterState: platformclientv2.Bool(false), RegisterStateTime: &later, ErrorInfo: &platformclientv2.Trunkerrorinfo{Details: &platformclientv2.Trunkerrorinfodetails{Message: platformclientv2.String("403 Forbidden")}}},
+		},
+	}
+	disabled := platformclientv2.Trunk{Name: platformclientv2.String("Carrier C"), Enabled: platformclientv2.Bool(false)}
+
+	assert.Equal(t, trunkRegisteredState, trunkRegistrationState(registered))
+	assert.Equal(t, trunkUnregisteredState, trunkRegistrationState(failing))
+	assert.Equal(t, "", trunkRegistrationState(disabled))
+
+	// The most recent failure is reported
+	assert.Equal(t, "403 Forbidden", trunkLastError(failing))
+	failing.RegistersStatus = nil
+	assert.Equal(t, "408: Request Timeout", trunkLastError(failing))
+	assert.Equal(t, "", trunkLastError(registered))
+
+	assert.True(t, allTrunksConnected([]platformclientv2.Trunk{registered, disabled}))
+	assert.False(t, allTrunksConnected([]platformclientv2.Trunk{registered, failing}))
+	assert.False(t, allTrunksConnected([]platformclientv2.Trunk{disabled}))
+
+	flattened := flattenTrunkHealth([]platformclientv2.Trunk{disabled, registered})
+	assert.Equal(t, "Carrier A", flattened[0].(map[string]interface{})["name"])
+	assert.Equal(t, "2024-05-01T10:00:00Z", flattened[0].(map[string]interface{})["connected_state_time"])
+}
+
+func TestUnitDataSourceTrunkHealthRead(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	var trunkTypes []string
+
+	internalProxy = &edgesHealthProxy{}
+	defer func() { internalProxy = nil }()
+	internalProxy.getEdgesAttr = func(ctx context.Context, p *edgesHealthProxy, siteId string, edgeGroupId string) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "site-1", siteId)
+		return &[]platformclientv2.Edge{{Id: platformclientv2.String("edge-1")}, {Id: platformclientv2.String("edge-2")}}, ok, nil
+	}
+	internalProxy.getEdgeTrunksAttr = func(ctx context.Context, p *edgesHealthProxy, edgeId string, trunkType string) (*[]platformclientv2.Trunk, *platformclientv2.APIResponse, error) {
+		trunkTypes = append(trunkTypes, trunkType)
+		return &[]platformclientv2.Trunk{
+			{Id: platformclientv2.String(edgeId + "-trunk-1"), Name: platformclientv2.String(edgeId + " trunk 1"), TrunkBase: &platformclientv2.Domainentityref{Id: platformclientv2.String("base-1")}},
+			{Id: platformclientv2.String(edgeId + "-trunk-2"), Name: platformclientv2.String(edgeId + " trunk 2"), TrunkBase: &platformclientv2.Domainentityref{Id: platformclientv2.String("base-2")}},
+		}, ok, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceTrunkHealth().Schema, map[string]interface{}{
+		"site_id":                "site-1",
+		"trunk_base_settings_id": "base-2",
+	})
+	diagErr := dataSourceTrunkHealthRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diagErr)
+
+	assert.Equal(t, "site-1", d.Id())
+	assert.Equal(t, []string{"EXTERNAL", "EXTERNAL"}, trunkTypes)
+	assert.Equal(t, 2, d.Get("trunks.#"))
+	assert.Equal(t, "edge-1-trunk-2", d.Get("trunks.0.trunk_id"))
+	assert.Equal(t, "edge-2-trunk-2", d.Get("trunks.1.trunk_id"))
+	assert.Equal(t, false, d.Get("all_connected"))
+}
@@ -0,0 +1,53 @@
+package telephony_providers_edges_health
+
+import (
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_telephony_providers_edges_health_init_test.go file is used to initialize the data sources and resources
+   used in testing the edge health and trunk health data sources.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[edgeHealthDataSourceName] = DataSourceEdgeHealth()
+	providerDataSources[trunkHealthDataSourceName] = DataSourceTrunkHealth()
+	providerDataSources["genesyscloud_organizations_me"] = gcloud.DataSourceOrganizationsMe()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the telephony_providers_edges_health package
+	initTestResources()
+
+	// Run the test suite for the telephony_providers_edges_health package
+	m.Run()
+}
@@ -0,0 +1,122 @@
+package telephony_providers_edges_health
+
+import (
+	"context"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_providers_edges_health_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *edgesHealthProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getEdgesFunc func(ctx context.Context, p *edgesHealthProxy, siteId string, edgeGroupId string) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error)
+type getEdgeTrunksFunc func(ctx context.Context, p *edgesHealthProxy, edgeId string, trunkType string) (*[]platformclientv2.Trunk, *platformclientv2.APIResponse, error)
+
+// edgesHealthProxy contains all of the methods that call genesys cloud APIs.
+type edgesHealthProxy struct {
+	clientConfig      *platformclientv2.Configuration
+	edgesApi          *platformclientv2.TelephonyProvidersEdgeApi
+	getEdgesAttr      getEdgesFunc
+	getEdgeTrunksAttr getEdgeTrunksFunc
+}
+
+// newEdgesHealthProxy initializes the edges health proxy with all of the data needed to communicate with Genesys Cloud
+func newEdgesHealthProxy(clientConfig *platformclientv2.Configuration) *edgesHealthProxy {
+	return &edgesHealthProxy{
+		clientConfig:      clientConfig,
+		edgesApi:          platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+		getEdgesAttr:      getEdgesFn,
+		getEdgeTrunksAttr: getEdgeTrunksFn,
+	}
+}
+
+// getEdgesHealthProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getEdgesHealthProxy(clientConfig *platformclientv2.Configuration) *edgesHealthProxy {
+	if internalProxy == nil {
+		internalProxy = newEdgesHealthProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getEdges returns the edges of a site and/or edge group
+func (p *edgesHealthProxy) getEdges(ctx context.Context, siteId string, edgeGroupId string) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	return p.getEdgesAttr(ctx, p, siteId, edgeGroupId)
+}
+
+// getEdgeTrunks returns the trunks of an edge
+func (p *edgesHealthProxy) getEdgeTrunks(ctx context.Context, edgeId string, trunkType string) (*[]platformclientv2.Trunk, *platformclientv2.APIResponse, error) {
+	return p.getEdgeTrunksAttr(ctx, p, edgeId, trunkType)
+}
+
+// getEdgesFn is an implementation of the function to get the edges of a site and/or edge group. Managed and unmanaged
+// edges are listed separately by the API, so both lists are read.
+func getEdgesFn(ctx context.Context, p *edgesHealthProxy, siteId string, edgeGroupId string) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	var (
+		allEdges []platformclientv2.Edge
+		resp     *platformclientv2.APIResponse
+		seen     = make(map[string]bool)
+	)
+	const pageSize = 100
+
+	for _, managed := range []bool{false, true} {
+		for pageNum := 1; ; pageNum++ {
+			edges, apiResp, err := p.edgesApi.GetTelephonyProvidersEdges(pageSize, pageNum, "", siteId, edgeGroupId, "", managed, true)
+			resp = apiResp
+			if err != nil {
+				return nil, resp, err
+			}
+			if edges.Entities == nil || len(*edges.Entities) == 0 {
+				break
+			}
+			for _, edge := range *edges.Entities {
+				if edge.Id == nil || seen[*edge.Id] || (edge.State != nil && *edge.State == "deleted") {
+					continue
+				}
+				seen[*edge.Id] = true
+				allEdges = append(allEdges, edge)
+			}
+			if edges.PageCount == nil || pageNum >= *edges.PageCount {
+				break
+			}
+		}
+	}
+	return &allEdges, resp, nil
+}
+
+// getEdgeTrunksFn is an implementation of the function to get the trunks of an edge
+func getEdgeTrunksFn(ctx context.Context, p *edgesHealthProxy, edgeId string, trunkType string) (*[]platformclientv2.Trunk, *platformclientv2.APIResponse, error) {
+	var (
+		allTrunks []platformclientv2.Trunk
+		resp      *platformclientv2.APIResponse
+	)
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		trunks, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", edgeId, "", trunkType)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, err
+		}
+		if trunks.Entities == nil || len(*trunks.Entities) == 0 {
+			break
+		}
+		for _, trunk := range *trunks.Entities {
+			if trunk.State != nil && *trunk.State == "deleted" {
+				continue
+			}
+			allTrunks = append(allTrunks, trunk)
+		}
+		if trunks.PageCount == nil || pageNum >= *trunks.PageCount {
+			break
+		}
+	}
+	return &allTrunks, resp, nil
+}
@@ -0,0 +1,214 @@
+package telephony_providers_edges_health
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_telephony_providers_edges_health_schema.go holds the registration code and the data source schemas for the
+edge health and trunk health data sources.
+*/
+const (
+	edgeHealthDataSourceName  = "genesyscloud_telephony_providers_edges_edge_health"
+	trunkHealthDataSourceName = "genesyscloud_telephony_providers_edges_trunk_health"
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(edgeHealthDataSourceName, DataSourceEdgeHealth())
+	regInstance.RegisterDataSource(trunkHealthDataSourceName, DataSourceTrunkHealth())
+}
+
+var edgeHealthResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"edge_id": {
+			Description: "ID of the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"site_id": {
+			Description: "ID of the site of the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"edge_group_id": {
+			Description: "ID of the edge group of the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"online_status": {
+			Description: "Online status reported by the edge, e.g. ONLINE or OFFLINE.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"online": {
+			Description: "True if the online status of the edge is ONLINE.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"status_code": {
+			Description: "Status code of the edge, e.g. INSERVICE or OUTOFSERVICE.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"software_version": {
+			Description: "Software version running on the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"staged_version": {
+			Description: "Software version staged on the edge for its next update.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+var trunkHealthResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"trunk_id": {
+			Description: "ID of the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"trunk_type": {
+			Description: "Type of the trunk (EXTERNAL | PHONE | EDGE).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"edge_id": {
+			Description: "ID of the edge the trunk runs on.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"trunk_base_settings_id": {
+			Description: "ID of the trunk base settings of the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"enabled": {
+			Description: "True if the trunk is enabled.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"in_service": {
+			Description: "True if the trunk is in service.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"connected": {
+			Description: "True if the trunk is connected.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"connected_state_time": {
+			Description: "Time the connection state of the trunk last changed, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"registration_state": {
+			Description: "Registration state of the trunk (registered | unregistered). Empty if the trunk does not register with its proxies.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_error": {
+			Description: "Most recent error reported by the OPTIONS or REGISTER checks of the trunk. Empty if the checks pass.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceEdgeHealth registers the genesyscloud_telephony_providers_edges_edge_health data source
+func DataSourceEdgeHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the live status of the edges of a Genesys Cloud site or edge group, " +
+			"for use in `check` blocks or `precondition`s before changing call routing.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceEdgeHealthRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description:  "ID of the site whose edges are read.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"site_id", "edge_group_id"},
+			},
+			"edge_group_id": {
+				Description:  "ID of the edge group whose edges are read. If `site_id` is also set, only the edges of the group in the site are read.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"site_id", "edge_group_id"},
+			},
+			"edges": {
+				Description: "Status of each edge, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        edgeHealthResource,
+			},
+			"all_online": {
+				Description: "True if there is at least one edge and all edges are online.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// DataSourceTrunkHealth registers the genesyscloud_telephony_providers_edges_trunk_health data source
+func DataSourceTrunkHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the live status of the trunks running on the edges of a Genesys Cloud site or edge group, " +
+			"for use in `check` blocks or `precondition`s before changing call routing.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceTrunkHealthRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description:  "ID of the site whose trunks are read.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"site_id", "edge_group_id"},
+			},
+			"edge_group_id": {
+				Description:  "ID of the edge group whose trunks are read. If `site_id` is also set, only the trunks of the edges of the group in the site are read.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"site_id", "edge_group_id"},
+			},
+			"trunk_type": {
+				Description:  "Type of the trunks to read (EXTERNAL | PHONE | EDGE).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "EXTERNAL",
+				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL", "PHONE", "EDGE"}, false),
+			},
+			"trunk_base_settings_id": {
+				Description: "ID of trunk base settings. If set, only the trunks created from it are read.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"trunks": {
+				Description: "Status of each trunk, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        trunkHealthResource,
+			},
+			"all_connected": {
+				Description: "True if there is at least one enabled trunk and all enabled trunks are in service, connected and not unregistered.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
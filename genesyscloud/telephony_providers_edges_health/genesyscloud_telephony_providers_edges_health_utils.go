@@ -0,0 +1,218 @@
+package telephony_providers_edges_health
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+const (
+	edgeOnlineStatus       = "ONLINE"
+	trunkRegisteredState   = "registered"
+	trunkUnregisteredState = "unregistered"
+)
+
+func buildHealthId(siteId string, edgeGroupId string) string {
+	if edgeGroupId == "" {
+		return siteId
+	}
+	if siteId == "" {
+		return edgeGroupId
+	}
+	return siteId + ":" + edgeGroupId
+}
+
+func describeHealthScope(siteId string, edgeGroupId string) string {
+	if edgeGroupId == "" {
+		return "site " + siteId
+	}
+	if siteId == "" {
+		return "edge group " + edgeGroupId
+	}
+	return fmt.Sprintf("edge group %s in site %s", edgeGroupId, siteId)
+}
+
+func isEdgeOnline(edge platformclientv2.Edge) bool {
+	return strings.EqualFold(util.StringValue(edge.OnlineStatus), edgeOnlineStatus)
+}
+
+// allEdgesOnline is false when there are no edges, so a readiness check does not pass on a site without edges
+func allEdgesOnline(edges []platformclientv2.Edge) bool {
+	for _, edge := range edges {
+		if !isEdgeOnline(edge) {
+			return false
+		}
+	}
+	return len(edges) > 0
+}
+
+func flattenEdgeHealth(edges []platformclientv2.Edge) []interface{} {
+	edges = append([]platformclientv2.Edge(nil), edges...)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return util.StringValue(edges[i].Name) < util.StringValue(edges[j].Name)
+	})
+
+	flattened := make([]interface{}, 0, len(edges))
+	for _, edge := range edges {
+		edgeMap := map[string]interface{}{
+			"edge_id":          util.StringValue(edge.Id),
+			"name":             util.StringValue(edge.Name),
+			"online_status":    util.StringValue(edge.OnlineStatus),
+			"online":           isEdgeOnline(edge),
+			"status_code":      util.StringValue(edge.StatusCode),
+			"software_version": util.StringValue(edge.SoftwareVersion),
+			"staged_version":   util.StringValue(edge.StagedVersion),
+			"site_id":          "",
+			"edge_group_id":    "",
+		}
+		if edge.Site != nil {
+			edgeMap["site_id"] = util.StringValue(edge.Site.Id)
+		}
+		if edge.EdgeGroup != nil {
+			edgeMap["edge_group_id"] = util.StringValue(edge.EdgeGroup.Id)
+		}
+		flattened = append(flattened, edgeMap)
+	}
+	return flattened
+}
+
+// trunkRegistrationState returns registered when every REGISTER check of the trunk passes, unregistered when one
+// fails, and an empty string when the trunk does not register
+func trunkRegistrationState(trunk platformclientv2.Trunk) string {
+	if trunk.RegistersStatus == nil || len(*trunk.RegistersStatus) == 0 {
+		return ""
+	}
+	for _, register := range *trunk.RegistersStatus {
+		if register.RegisterState == nil || !*register.RegisterState {
+			return trunkUnregisteredState
+		}
+	}
+	return trunkRegisteredState
+}
+
+// trunkLastError returns the error of the most recently failed OPTIONS or REGISTER check of the trunk
+func trunkLastError(trunk platformclientv2.Trunk) string {
+	var (
+		lastError     string
+		lastErrorTime time.Time
+	)
+	setLastError := func(state *bool, stateTime *time.Time, errorInfo *platformclientv2.Trunkerrorinfo) {
+		if (state != nil && *state) || errorInfo == nil {
+			return
+		}
+		var errorTime time.Time
+		if stateTime != nil {
+			errorTime = *stateTime
+		}
+		if lastError == "" || errorTime.After(lastErrorTime) {
+			lastError = formatTrunkError(errorInfo)
+			lastErrorTime = errorTime
+		}
+	}
+
+	if trunk.OptionsStatus != nil {
+		for _, options := range *trunk.OptionsStatus {
+			setLastError(options.OptionState, options.OptionStateTime, options.ErrorInfo)
+		}
+	}
+	if trunk.RegistersStatus != nil {
+		for _, register := range *trunk.RegistersStatus {
+			setLastError(register.RegisterState, register.RegisterStateTime, register.ErrorInfo)
+		}
+	}
+	return lastError
+}
+
+func formatTrunkError(errorInfo *platformclientv2.Trunkerrorinfo) string {
+	message := util.StringValue(errorInfo.Text)
+	if message == "" && errorInfo.Details != nil {
+		message = util.StringValue(errorInfo.Details.Message)
+	}
+	code := util.StringValue(errorInfo.Code)
+	if code == "" {
+		return message
+	}
+	if message == "" {
+		return code
+	}
+	return code + ": " + message
+}
+
+func isTrunkConnected(trunk platformclientv2.Trunk) bool {
+	return trunk.ConnectedStatus != nil && trunk.ConnectedStatus.Connected != nil && *trunk.ConnectedStatus.Connected
+}
+
+// allTrunksConnected ignores disabled trunks and is false when there are no enabled trunks
+func allTrunksConnected(trunks []platformclientv2.Trunk) bool {
+	enabledTrunks := 0
+	for _, trunk := range trunks {
+		if !boolValue(trunk.Enabled) {
+			continue
+		}
+		enabledTrunks++
+		if !boolValue(trunk.InService) || !isTrunkConnected(trunk) || trunkRegistrationState(trunk) == trunkUnregisteredState {
+			return false
+		}
+	}
+	return enabledTrunks > 0
+}
+
+func flattenTrunkHealth(trunks []platformclientv2.Trunk) []interface{} {
+	trunks = append([]platformclientv2.Trunk(nil), trunks...)
+	sort.SliceStable(trunks, func(i, j int) bool {
+		return util.StringValue(trunks[i].Name) < util.StringValue(trunks[j].Name)
+	})
+
+	flattened := make([]interface{}, 0, len(trunks))
+	for _, trunk := range trunks {
+		trunkMap := map[string]interface{}{
+			"trunk_id":               util.StringValue(trunk.Id),
+			"name":                   util.StringValue(trunk.Name),
+			"trunk_type":             util.StringValue(trunk.TrunkType),
+			"edge_id":                "",
+			"trunk_base_settings_id": "",
+			"enabled":                boolValue(trunk.Enabled),
+			"in_service":             boolValue(trunk.InService),
+			"connected":              isTrunkConnected(trunk),
+			"connected_state_time":   "",
+			"registration_state":     trunkRegistrationState(trunk),
+			"last_error":             trunkLastError(trunk),
+		}
+		if trunk.Edge != nil {
+			trunkMap["edge_id"] = util.StringValue(trunk.Edge.Id)
+		}
+		if trunk.TrunkBase != nil {
+			trunkMap["trunk_base_settings_id"] = util.StringValue(trunk.TrunkBase.Id)
+		}
+		if trunk.ConnectedStatus != nil && trunk.ConnectedStatus.ConnectedStateTime != nil {
+			trunkMap["connected_state_time"] = trunk.ConnectedStatus.ConnectedStateTime.Format(time.RFC3339)
+		}
+		flattened = append(flattened, trunkMap)
+	}
+	return flattened
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
+
+// GenerateEdgeHealthDataSource returns the HCL of a genesyscloud_telephony_providers_edges_edge_health data source
+func GenerateEdgeHealthDataSource(dataSourceLabel string, siteId string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		site_id = %s
+	}
+	`, edgeHealthDataSourceName, dataSourceLabel, siteId)
+}
+
+// GenerateTrunkHealthDataSource returns the HCL of a genesyscloud_telephony_providers_edges_trunk_health data source
+func GenerateTrunkHealthDataSource(dataSourceLabel string, siteId string, trunkType string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		site_id    = %s
+		trunk_type = "%s"
+	}
+	`, trunkHealthDataSourceName, dataSourceLabel, siteId, trunkType)
+}
@@ -6,6 +6,8 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"terraform-provider-genesyscloud/genesyscloud/location"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/station"
 	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
 	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
 	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
@@ -46,6 +48,7 @@ func (r *registerTestInstance) registerTestResources() {
 	defer r.resourceMapMutex.Unlock()
 
 	providerResources[resourceName] = ResourcePhone()
+	providerResources[bulkResourceName] = ResourcePhonesBulk()
 	providerResources["genesyscloud_user"] = user.ResourceUser()
 	providerResources["genesyscloud_telephony_providers_edges_phonebasesettings"] = phoneBaseSettings.ResourcePhoneBaseSettings()
 	providerResources["genesyscloud_location"] = location.ResourceLocation()
@@ -60,6 +63,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 
 	providerDataSources[resourceName] = DataSourcePhone()
 	providerDataSources["genesyscloud_organizations_me"] = gcloud.DataSourceOrganizationsMe()
+	providerDataSources[stationDataSourceName] = station.DataSourceStation()
 }
 
 // initTestResources initializes all test resources and data sources.
@@ -75,6 +79,9 @@ func initTestResources() {
 
 	regInstance.registerTestDataSources()
 	regInstance.registerTestResources()
+
+	// The phones bulk resource looks up stations with the registered station data source
+	registrar.SetResources(providerResources, providerDataSources)
 }
 
 // TestMain is a "setup" function called by the testing framework when run the test
@@ -48,6 +48,8 @@ type getStationOfUserFunc func(ctx context.Context, p *phoneProxy, userId string
 type unassignUserFromStationFunc func(ctx context.Context, p *phoneProxy, stationId string) (*platformclientv2.APIResponse, error)
 type assignUserToStationFunc func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
 type assignStationAsDefaultFunc func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+type listPhonesFunc func(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
+type getUserIdByEmailFunc func(ctx context.Context, p *phoneProxy, email string) (userId string, resp *platformclientv2.APIResponse, err error)
 
 // phoneProxy contains all of the methods that call genesys cloud APIs.
 type phoneProxy struct {
@@ -69,6 +71,8 @@ type phoneProxy struct {
 	unassignUserFromStationAttr unassignUserFromStationFunc
 	assignUserToStationAttr     assignUserToStationFunc
 	assignStationAsDefaultAttr  assignStationAsDefaultFunc
+	listPhonesAttr              listPhonesFunc
+	getUserIdByEmailAttr        getUserIdByEmailFunc
 }
 
 // newPhoneProxy initializes the Phone proxy with all of the data needed to communicate with Genesys Cloud
@@ -97,6 +101,8 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 		unassignUserFromStationAttr: unassignUserFromStationFn,
 		assignUserToStationAttr:     assignUserToStationFn,
 		assignStationAsDefaultAttr:  assignStationAsDefaultFn,
+		listPhonesAttr:              listPhonesFn,
+		getUserIdByEmailAttr:        getUserIdByEmailFn,
 	}
 }
 
@@ -167,6 +173,16 @@ func (p *phoneProxy) assignStationAsDefault(ctx context.Context, userId string,
 	return p.assignStationAsDefaultAttr(ctx, p, userId, stationId)
 }
 
+// listPhones retrieves all Genesys Cloud Phones with their lines and properties without adding them to the cache
+func (p *phoneProxy) listPhones(ctx context.Context) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.listPhonesAttr(ctx, p)
+}
+
+// getUserIdByEmail retrieves the ID of a Genesys Cloud User by email
+func (p *phoneProxy) getUserIdByEmail(ctx context.Context, email string) (string, *platformclientv2.APIResponse, error) {
+	return p.getUserIdByEmailAttr(ctx, p, email)
+}
+
 // getAllPhonesFn is an implementation function for retrieving all Genesys Cloud Phones
 func getAllPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
 	log.Printf("Entering the getAllPhonesFn method to retrieve all of the phone ids for export")
@@ -335,3 +351,49 @@ func assignUserToStationFn(ctx context.Context, p *phoneProxy, userId string, st
 func assignStationAsDefaultFn(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
 	return p.usersApi.PutUserStationDefaultstationStationId(userId, stationId)
 }
+
+// listPhonesFn is an implementation function for retrieving all non-deleted Genesys Cloud Phones. The phones are not
+// cached, as the cached versions would be stale once the phones are updated.
+func listPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	const sortBy = "id"
+	expand := []string{"lines", "properties"}
+	fields := []string{"webRtcUser"}
+
+	var allPhones []platformclientv2.Phone
+	for pageNum := 1; ; pageNum++ {
+		phones, resp, err := p.edgesApi.GetTelephonyProvidersEdgesPhones(pageNum, pageSize, sortBy, "", "", "", "", "", "", "", "", "", "", "", "", expand, fields)
+		if err != nil {
+			return nil, resp, err
+		}
+		if phones.Entities != nil {
+			for _, phone := range *phones.Entities {
+				if phone.State != nil && *phone.State != "deleted" {
+					allPhones = append(allPhones, phone)
+				}
+			}
+		}
+		if phones.PageCount == nil || pageNum >= *phones.PageCount {
+			return &allPhones, resp, nil
+		}
+	}
+}
+
+// getUserIdByEmailFn is an implementation function for retrieving the ID of a Genesys Cloud User by email
+func getUserIdByEmailFn(ctx context.Context, p *phoneProxy, email string) (string, *platformclientv2.APIResponse, error) {
+	exactSearchType := "EXACT"
+	users, resp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+		Query: &[]platformclientv2.Usersearchcriteria{{
+			VarType: &exactSearchType,
+			Fields:  &[]string{"email"},
+			Value:   &email,
+		}},
+	})
+	if err != nil {
+		return "", resp, err
+	}
+	if users.Results == nil || len(*users.Results) == 0 {
+		return "", &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("no user found with email %s", email)
+	}
+	return *(*users.Results)[0].Id, resp, nil
+}
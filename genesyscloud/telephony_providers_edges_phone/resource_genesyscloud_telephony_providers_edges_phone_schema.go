@@ -20,12 +20,14 @@ resource_genesyscloud_telephony_providers_edges_phone_schema.go should hold four
 4.  The resource exporter configuration for the telephony_providers_edges_phone exporter.
 */
 const resourceName = "genesyscloud_telephony_providers_edges_phone"
+const bulkResourceName = "genesyscloud_telephony_providers_edges_phones_bulk"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourcePhone())
 	l.RegisterResource(resourceName, ResourcePhone())
 	l.RegisterExporter(resourceName, PhoneExporter())
+	l.RegisterResource(bulkResourceName, ResourcePhonesBulk())
 }
 
 // ResourcePhone registers the genesyscloud_telephony_providers_edges_phone resource with Terraform
@@ -180,6 +182,104 @@ func ResourcePhone() *schema.Resource {
 	}
 }
 
+// ResourcePhonesBulk registers the genesyscloud_telephony_providers_edges_phones_bulk resource with Terraform
+func ResourcePhonesBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Phones Bulk
+
+Provisions the desk phones of a CSV hardware inventory file keyed by hardware ID. Phones are created or updated with the same API calls as genesyscloud_telephony_providers_edges_phone, keeping the properties of existing phones that the file does not set, and only phones whose row changed since the last apply are updated. A phone that fails to provision does not stop the others; its error is recorded in the phones attribute and it is retried on the next apply.`,
+
+		CreateContext: provider.CreateWithPooledClient(createPhonesBulk),
+		ReadContext:   provider.ReadWithPooledClient(readPhonesBulk),
+		UpdateContext: provider.UpdateWithPooledClient(updatePhonesBulk),
+		DeleteContext: provider.DeleteWithPooledClient(deletePhonesBulk),
+		CustomizeDiff: customizePhonesBulkDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description:  "Path to the CSV file listing the phones, with a header row. Columns: name, hardware_id, hardware_id_type, site_id, phone_base_settings_id, line_base_settings_id, line_address, state and user. The hardware_id_type defaults to mac, in which case hardware_id must be a MAC address. The line_address column lists the E.164 DIDs of standalone phones separated by semicolons. The user is a user ID or email; the station of the phone, found by the phone name, becomes the default station of the user.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the inventory file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"batch_size": {
+				Description:  "Number of phones provisioned at the same time. Each batch starts once the previous one is done. Value must be between 1 and 20.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"delete_missing": {
+				Description: "Delete the phones removed from the inventory file. If false, they are no longer managed by this resource.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"destroy_action": {
+				Description:  "What happens to the phones when this resource is destroyed (none | delete).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"none", "delete"}, false),
+			},
+			"phones": {
+				Description: "Outcome of the last apply for each row of the inventory file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"row": {
+							Description: "Row of the phone in the inventory file, counting the header row.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"hardware_id": {
+							Description: "Hardware ID of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"phone_id": {
+							Description: "ID of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_id": {
+							Description: "ID of the user assigned to the station of the phone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outcome": {
+							Description: "Outcome of the last apply (created | updated | unchanged | failed | missing). Missing phones were deleted outside of Terraform.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error": {
+							Description: "Error returned while provisioning the phone, if it failed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"record_hash": {
+							Description: "Hash of the phone's row in the inventory file when it was last provisioned.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // PhoneExporter returns the resourceExporter object used to hold the genesyscloud_telephony_providers_edges_phone exporter's config
 func PhoneExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
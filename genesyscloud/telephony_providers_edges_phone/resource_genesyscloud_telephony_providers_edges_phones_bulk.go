@@ -0,0 +1,317 @@
+package telephony_providers_edges_phone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_phones_bulk.go file provisions the phones of a hardware inventory
+file. Each phone is created or updated through the same proxy as the genesyscloud_telephony_providers_edges_phone
+resource, in batches of phones provisioned at the same time.
+*/
+
+func createPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	return applyPhonesBulk(ctx, d, meta)
+}
+
+func updatePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applyPhonesBulk(ctx, d, meta)
+}
+
+func readPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	pp := getPhoneProxy(sdkConfig)
+
+	log.Printf("Reading phones bulk %s", d.Id())
+	existingIds, diagErr := getBulkExistingPhoneIds(ctx, pp)
+	if diagErr != nil {
+		return diagErr
+	}
+	phoneIds := make(map[string]bool)
+	for _, id := range existingIds {
+		phoneIds[id] = true
+	}
+
+	// Phones deleted outside of Terraform are flagged so that the next apply provisions them again
+	outcomes := buildBulkPhoneOutcomes(d.Get("phones").([]interface{}))
+	for hardwareId, outcome := range outcomes {
+		if outcome.FlagMissing(phoneIds) {
+			log.Printf("Phone %s %s of phones bulk %s no longer exists", outcome.Name, outcome.Id, d.Id())
+			outcomes[hardwareId] = outcome
+		}
+	}
+	_ = d.Set("phones", flattenBulkPhoneOutcomes(outcomes))
+
+	log.Printf("Read phones bulk %s", d.Id())
+	return nil
+}
+
+func deletePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("destroy_action").(string) == "none" {
+		log.Printf("Removing phones bulk %s from state, its phones are left unchanged", d.Id())
+		return nil
+	}
+
+	var outcomes []bulkPhoneOutcome
+	for _, outcome := range buildBulkPhoneOutcomes(d.Get("phones").([]interface{})) {
+		if outcome.Id != "" && outcome.Outcome != bulk.OutcomeMissing {
+			outcomes = append(outcomes, outcome)
+		}
+	}
+
+	log.Printf("Destroying phones bulk %s, deleting %d phones", d.Id(), len(outcomes))
+
+	var (
+		diags      diag.Diagnostics
+		diagsMutex sync.Mutex
+	)
+	bulk.ForEachInBatches(outcomes, d.Get("batch_size").(int), func(outcome bulkPhoneOutcome) {
+		if diagErr := deleteBulkPhone(ctx, meta, outcome.Id); diagErr != nil {
+			diagsMutex.Lock()
+			diags = append(diags, diagErr...)
+			diagsMutex.Unlock()
+		}
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Destroyed phones bulk %s", d.Id())
+	return nil
+}
+
+// customizePhonesBulkDiff plans an update when the inventory file changed or when phones failed or went missing, so
+// that they are provisioned again even though the configuration did not change
+func customizePhonesBulkDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return bulk.CustomizeDiff(diff, "phones", "filepath", "file_content_hash", "delete_missing")
+}
+
+// applyPhonesBulk provisions every phone of the inventory file and deletes the phones removed from it when requested.
+// A phone that fails to provision does not stop the others, its error is recorded in the phones attribute instead.
+func applyPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	pp := getPhoneProxy(sdkConfig)
+	path := d.Get("filepath").(string)
+	batchSize := d.Get("batch_size").(int)
+
+	records, err := readBulkPhoneRecords(path)
+	if err != nil {
+		return util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Invalid inventory file %s", path), err)
+	}
+
+	existingIds, diagErr := getBulkExistingPhoneIds(ctx, pp)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Provisioning %d phones from %s", len(records), path)
+
+	previous := buildBulkPhoneOutcomes(d.Get("phones").([]interface{}))
+	outcomes := make(map[string]bulkPhoneOutcome)
+	var outcomesMutex sync.Mutex
+
+	bulk.ForEachInBatches(records, batchSize, func(record bulkPhoneRecord) {
+		outcome := provisionBulkPhone(ctx, meta, pp, record, existingIds[record.key()], previous[record.key()])
+		outcomesMutex.Lock()
+		outcomes[record.key()] = outcome
+		outcomesMutex.Unlock()
+	})
+
+	// Phones removed from the inventory file are deleted, or simply no longer managed
+	var removed []bulkPhoneOutcome
+	for hardwareId, outcome := range previous {
+		if _, inFile := outcomes[hardwareId]; inFile || outcome.Id == "" || !d.Get("delete_missing").(bool) {
+			continue
+		}
+		if existingIds[hardwareId] == outcome.Id {
+			removed = append(removed, outcome)
+		}
+	}
+	bulk.ForEachInBatches(removed, batchSize, func(outcome bulkPhoneOutcome) {
+		if diagErr := deleteBulkPhone(ctx, meta, outcome.Id); diagErr != nil {
+			outcome.Fail(diagErr)
+			outcomesMutex.Lock()
+			outcomes[normalizeHardwareId(outcome.HardwareId)] = outcome
+			outcomesMutex.Unlock()
+		}
+	})
+
+	_ = d.Set("phones", flattenBulkPhoneOutcomes(outcomes))
+
+	var failed []string
+	for _, outcome := range outcomes {
+		if outcome.Outcome == bulk.OutcomeFailed {
+			failed = append(failed, fmt.Sprintf("%s (row %d)", outcome.HardwareId, outcome.Row))
+		}
+	}
+	if len(failed) > 0 {
+		return bulk.FailureWarning(bulkResourceName, d.Id(), "phones", failed, len(outcomes))
+	}
+
+	log.Printf("Provisioned %d phones from %s", len(records), path)
+	return nil
+}
+
+// provisionBulkPhone creates or updates a single phone and assigns its user, skipping phones that have not changed
+// since the last apply
+func provisionBulkPhone(ctx context.Context, meta interface{}, pp *phoneProxy, record bulkPhoneRecord, existingId string, previous bulkPhoneOutcome) bulkPhoneOutcome {
+	outcome := bulkPhoneOutcome{
+		Row:        record.Row,
+		HardwareId: record.HardwareId,
+		Name:       record.Name,
+		UserId:     previous.UserId,
+		Result:     bulk.Result{Id: existingId, RecordHash: bulk.RecordHash(record)},
+	}
+
+	if previous.IsUnchanged(existingId, outcome.RecordHash) {
+		outcome.Outcome = bulk.OutcomeUnchanged
+		return outcome
+	}
+
+	var diagErr diag.Diagnostics
+	if existingId != "" {
+		outcome.Outcome = bulk.OutcomeUpdated
+		diagErr = updateBulkPhone(ctx, pp, existingId, record)
+	} else {
+		outcome.Outcome = bulk.OutcomeCreated
+		outcome.Id, diagErr = createBulkPhone(ctx, pp, record)
+	}
+
+	if !diagErr.HasError() && record.User != "" {
+		outcome.UserId, diagErr = assignUserToBulkPhone(ctx, meta, pp, record)
+	}
+	if record.User == "" {
+		outcome.UserId = ""
+	}
+
+	if diagErr.HasError() {
+		log.Printf("Failed to provision phone %s in row %d: %s", record.Name, record.Row, bulk.DiagnosticsMessage(diagErr))
+		outcome.Fail(diagErr)
+	}
+	return outcome
+}
+
+// createBulkPhone creates the phone of a record and returns its ID
+func createBulkPhone(ctx context.Context, pp *phoneProxy, record bulkPhoneRecord) (string, diag.Diagnostics) {
+	phoneConfig, err := buildBulkPhone(ctx, pp, record, nil)
+	if err != nil {
+		return "", util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to build phone %s", record.Name), err)
+	}
+
+	log.Printf("Creating phone %s", record.Name)
+	var phoneId string
+	diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		phone, resp, err := pp.createPhone(ctx, phoneConfig)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to create phone %s error: %s", record.Name, err), resp)
+		}
+		phoneId = *phone.Id
+		return resp, nil
+	})
+	return phoneId, diagErr
+}
+
+// updateBulkPhone updates an existing phone to match its record. The phone is read first, as the update replaces the
+// attributes the record does not manage.
+func updateBulkPhone(ctx context.Context, pp *phoneProxy, phoneId string, record bulkPhoneRecord) diag.Diagnostics {
+	existing, resp, err := pp.getPhoneById(ctx, phoneId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to read phone %s error: %s", phoneId, err), resp)
+	}
+	phoneConfig, err := buildBulkPhone(ctx, pp, record, existing)
+	if err != nil {
+		return util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to build phone %s", record.Name), err)
+	}
+
+	log.Printf("Updating phone %s", record.Name)
+	if _, resp, err := pp.updatePhone(ctx, phoneId, phoneConfig); err != nil {
+		return util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to update phone %s error: %s", record.Name, err), resp)
+	}
+	return nil
+}
+
+// assignUserToBulkPhone makes the station of a phone the default station of its user
+func assignUserToBulkPhone(ctx context.Context, meta interface{}, pp *phoneProxy, record bulkPhoneRecord) (string, diag.Diagnostics) {
+	userId := record.User
+	if strings.Contains(userId, "@") {
+		id, resp, err := pp.getUserIdByEmail(ctx, record.User)
+		if err != nil {
+			return "", util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to find user %s: %s", record.User, err), resp)
+		}
+		userId = id
+	}
+
+	stationId, diagErr := getBulkPhoneStationId(ctx, meta, record.Name)
+	if diagErr != nil {
+		return "", diagErr
+	}
+
+	log.Printf("Assigning user %s to station %s of phone %s", userId, stationId, record.Name)
+	diagErr = util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := pp.assignUserToStation(ctx, userId, stationId)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to assign user %s to the station %s: %s", userId, stationId, err), resp)
+		}
+		resp, err = pp.assignStationAsDefault(ctx, userId, stationId)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to assign station %s as the default station for user %s: %s", stationId, userId, err), resp)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return "", diagErr
+	}
+	return userId, nil
+}
+
+// getBulkPhoneStationId looks up the station of a phone by the name of the phone with the genesyscloud_station data
+// source. The data source is reached through the registrar as the station package depends on this one in its tests.
+func getBulkPhoneStationId(ctx context.Context, meta interface{}, phoneName string) (string, diag.Diagnostics) {
+	_, dataSources := registrar.GetResources()
+	stationDataSource := dataSources[stationDataSourceName]
+	if stationDataSource == nil {
+		return "", util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to find the station of phone %s", phoneName), fmt.Errorf("data source %s is not registered", stationDataSourceName))
+	}
+
+	stationData := stationDataSource.Data(nil)
+	_ = stationData.Set("name", phoneName)
+	if diagErr := stationDataSource.ReadContext(ctx, stationData, meta); diagErr.HasError() {
+		return "", diagErr
+	}
+	return stationData.Id(), nil
+}
+
+func deleteBulkPhone(ctx context.Context, meta interface{}, phoneId string) diag.Diagnostics {
+	phoneData := ResourcePhone().Data(nil)
+	phoneData.SetId(phoneId)
+	return deletePhone(ctx, phoneData, meta)
+}
+
+// getBulkExistingPhoneIds maps the normalized hardware ID of every phone of the org to its ID
+func getBulkExistingPhoneIds(ctx context.Context, pp *phoneProxy) (map[string]string, diag.Diagnostics) {
+	phones, resp, err := pp.listPhones(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to get phones error: %s", err), resp)
+	}
+	existingIds := make(map[string]string)
+	for _, phone := range *phones {
+		if hardwareId := phoneHardwareId(phone); phone.Id != nil && hardwareId != "" {
+			existingIds[hardwareId] = *phone.Id
+		}
+	}
+	return existingIds, nil
+}
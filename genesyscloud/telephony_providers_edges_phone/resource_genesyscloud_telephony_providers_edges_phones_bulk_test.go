@@ -0,0 +1,98 @@
+package telephony_providers_edges_phone
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
+	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourcePhonesBulk(t *testing.T) {
+	var (
+		bulkResource          = "test-phones-bulk"
+		fullName              = bulkResourceName + "." + bulkResource
+		phoneBaseSettingsRes  = "phones-bulk-base"
+		phoneBaseSettingsName = "phoneBaseSettings " + uuid.NewString()
+		inventoryFile         = filepath.Join(t.TempDir(), "phones.csv")
+		phoneName1            = "test-phone_" + uuid.NewString()
+		phoneName2            = "test-phone_" + uuid.NewString()
+		phoneBaseSettingsId   string
+	)
+
+	siteId, err := edgeSite.GetOrganizationDefaultSiteId(sdkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeInventory := func(rows ...string) func() {
+		return func() {
+			content := "name,hardware_id,site_id,phone_base_settings_id\n"
+			for _, row := range rows {
+				content += fmt.Sprintf("%s,%s,%s\n", row, siteId, phoneBaseSettingsId)
+			}
+			if err := os.WriteFile(inventoryFile, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	phone1 := phoneName1 + ",00:04:F2:" + randomMacSuffix()
+	phone2 := phoneName2 + ",00:04:F2:" + randomMacSuffix()
+
+	baseSettings := phoneBaseSettings.GeneratePhoneBaseSettingsResourceWithCustomAttrs(
+		phoneBaseSettingsRes,
+		phoneBaseSettingsName,
+		"phoneBaseSettings description",
+		"generic_sip.json",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The inventory file references the phone base settings by ID
+				Config: baseSettings,
+				Check: func(state *terraform.State) error {
+					phoneBaseSettingsId = state.RootModule().Resources["genesyscloud_telephony_providers_edges_phonebasesettings."+phoneBaseSettingsRes].Primary.ID
+					return nil
+				},
+			},
+			{
+				// Create both phones
+				PreConfig: writeInventory(phone1, phone2),
+				Config:    baseSettings + GeneratePhonesBulkResource(bulkResource, inventoryFile, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "phones.#", "2"),
+					resource.TestCheckResourceAttr(fullName, "phones.0.name", phoneName1),
+					resource.TestCheckResourceAttr(fullName, "phones.0.row", "2"),
+					resource.TestCheckResourceAttr(fullName, "phones.0.outcome", bulk.OutcomeCreated),
+					resource.TestCheckResourceAttr(fullName, "phones.1.outcome", bulk.OutcomeCreated),
+				),
+			},
+			{
+				// Remove the second phone from the file
+				PreConfig: writeInventory(phone1),
+				Config:    baseSettings + GeneratePhonesBulkResource(bulkResource, inventoryFile, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "phones.#", "1"),
+					resource.TestCheckResourceAttr(fullName, "phones.0.outcome", bulk.OutcomeUnchanged),
+				),
+			},
+		},
+		CheckDestroy: TestVerifyWebRtcPhoneDestroyed,
+	})
+}
+
+func randomMacSuffix() string {
+	id := uuid.New()
+	return fmt.Sprintf("%02X:%02X:%02X", id[0], id[1], id[2])
+}
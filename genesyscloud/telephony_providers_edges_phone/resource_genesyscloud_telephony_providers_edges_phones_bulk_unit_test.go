@@ -0,0 +1,253 @@
+package telephony_providers_edges_phone
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseBulkPhoneCsv(t *testing.T) {
+	content := `name,hardware_id,site_id,phone_base_settings_id,line_address,user
+Desk 101,00:04:F2:AB:CD:EF,site-1,base-1,+13175550101;+13175550102,jane@example.com
+Desk 102,0004f2abcd00,site-1,base-1,,
+`
+	records, err := parseBulkPhoneCsv(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, bulkPhoneRecord{
+		Row:                 2,
+		Name:                "Desk 101",
+		HardwareId:          "00:04:F2:AB:CD:EF",
+		SiteId:              "site-1",
+		PhoneBaseSettingsId: "base-1",
+		LineAddress:         []string{"+13175550101", "+13175550102"},
+		User:                "jane@example.com",
+	}, records[0])
+	assert.Equal(t, "0004f2abcdef", records[0].key())
+	assert.Equal(t, 3, records[1].Row)
+	assert.Nil(t, records[1].LineAddress)
+
+	_, err = parseBulkPhoneCsv(strings.NewReader("name,mac\n"))
+	assert.ErrorContains(t, err, "unknown column mac")
+
+	_, err = parseBulkPhoneCsv(strings.NewReader(""))
+	assert.EqualError(t, err, "missing header row")
+}
+
+func TestUnitValidateBulkPhoneRecords(t *testing.T) {
+	valid := bulkPhoneRecord{Row: 2, Name: "Desk 101", HardwareId: "00-04-f2-ab-cd-ef", SiteId: "site-1", PhoneBaseSettingsId: "base-1", User: "jane@example.com"}
+	assert.NoError(t, validateBulkPhoneRecords([]bulkPhoneRecord{valid}))
+
+	err := validateBulkPhoneRecords([]bulkPhoneRecord{
+		valid,
+		{Row: 3, Name: "Desk 101", HardwareId: "0004F2ABCDEF", SiteId: "site-1", PhoneBaseSettingsId: "base-1"},
+		{Row: 4, Name: "Desk 103", HardwareId: "not-a-mac", State: "deleted", LineAddress: []string{"3175550101"}, User: "JANE@example.com"},
+		{Row: 5, Name: "Desk 104", HardwareId: "SN-1234", HardwareIdType: "serial", SiteId: "site-1", PhoneBaseSettingsId: "base-1"},
+		{Row: 6, SiteId: "site-1", PhoneBaseSettingsId: "base-1"},
+	})
+	assert.Error(t, err)
+	messages := strings.Split(err.Error(), "\n")
+	assert.Contains(t, messages, "row 3 has the same hardware_id 0004F2ABCDEF as row 2")
+	assert.Contains(t, messages, "row 3 has the same name Desk 101 as row 2")
+	assert.Contains(t, messages, "row 4 has hardware_id not-a-mac, expected a MAC address")
+	assert.Contains(t, messages, "row 4 has no site_id")
+	assert.Contains(t, messages, "row 4 has no phone_base_settings_id")
+	assert.Contains(t, messages, "row 4 has state deleted, expected active or inactive")
+	assert.Contains(t, messages, "row 4 assigns user JANE@example.com, already assigned in row 2")
+	assert.Contains(t, messages, "row 6 has no hardware_id")
+	assert.Contains(t, messages, "row 6 has no name")
+	// Serial numbers are not checked against the MAC address format
+	for _, message := range messages {
+		assert.NotContains(t, message, "row 5")
+	}
+	assert.Len(t, messages, 10)
+}
+
+// testBulkPhoneBaseProxy returns a phone proxy resolving the line base settings and phone meta base of base-1
+func testBulkPhoneBaseProxy(t *testing.T) *phoneProxy {
+	pp := &phoneProxy{}
+	pp.getPhoneBaseSettingAttr = func(ctx context.Context, p *phoneProxy, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "base-1", phoneBaseSettingsId)
+		return &platformclientv2.Phonebase{
+			Lines:         &[]platformclientv2.Linebase{{Id: platformclientv2.String("line-base-1")}},
+			PhoneMetaBase: &platformclientv2.Domainentityref{Id: platformclientv2.String("meta-1")},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	return pp
+}
+
+func TestUnitBuildBulkPhone(t *testing.T) {
+	pp := testBulkPhoneBaseProxy(t)
+	record := bulkPhoneRecord{
+		Name:                "Desk 101",
+		HardwareId:          "0004f2abcdef",
+		SiteId:              "site-1",
+		PhoneBaseSettingsId: "base-1",
+		LineAddress:         []string{"+13175550101"},
+	}
+
+	phone, err := buildBulkPhone(context.Background(), pp, record, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "active", *phone.State)
+	assert.Equal(t, "site-1", *phone.Site.Id)
+	assert.Equal(t, "line-base-1", *phone.LineBaseSettings.Id)
+	assert.Equal(t, "meta-1", *phone.PhoneMetaBase.Id)
+	assert.Equal(t, "0004f2abcdef", phoneHardwareId(*phone))
+	assert.Contains(t, *phone.Properties, "phone_standalone")
+	if assert.Len(t, *phone.Lines, 1) {
+		address := (*(*phone.Lines)[0].Properties)["station_identity_address"].(*map[string]interface{})
+		assert.Equal(t, &map[string]interface{}{"instance": "+13175550101"}, (*address)["value"])
+	}
+
+	// An existing phone keeps its line and the properties the record does not manage
+	existing := &platformclientv2.Phone{
+		Properties: &map[string]interface{}{
+			"phone_hardwareId": map[string]interface{}{"value": map[string]interface{}{"instance": "0004f2abcd00"}},
+			"phone_label":      map[string]interface{}{"value": map[string]interface{}{"instance": "Reception"}},
+			"phone_standalone": map[string]interface{}{"value": map[string]interface{}{"instance": true}},
+		},
+		Lines:        &[]platformclientv2.Line{{Id: platformclientv2.String("line-1")}},
+		Capabilities: &platformclientv2.Phonecapabilities{Provisions: platformclientv2.Bool(true)},
+	}
+	record.LineAddress = nil
+	record.LineBaseSettingsId = "line-base-2"
+	phone, err = buildBulkPhone(context.Background(), pp, record, existing)
+	assert.NoError(t, err)
+	assert.Equal(t, "0004f2abcdef", phoneHardwareId(*phone))
+	assert.Equal(t, (*existing.Properties)["phone_label"], (*phone.Properties)["phone_label"])
+	assert.NotContains(t, *phone.Properties, "phone_standalone")
+	assert.Equal(t, existing.Capabilities, phone.Capabilities)
+	assert.Equal(t, "line-base-2", *phone.LineBaseSettings.Id)
+	if assert.Len(t, *phone.Lines, 1) {
+		assert.Equal(t, "line-1", *(*phone.Lines)[0].Id)
+	}
+	// The existing phone is left as it was
+	assert.Equal(t, "0004f2abcd00", phoneHardwareId(*existing))
+}
+
+func TestUnitProvisionBulkPhoneUpdated(t *testing.T) {
+	pp := testBulkPhoneBaseProxy(t)
+	pp.getPhoneByIdAttr = func(ctx context.Context, p *phoneProxy, phoneId string) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Phone{
+			Id: &phoneId,
+			Properties: &map[string]interface{}{
+				"phone_label": map[string]interface{}{"value": map[string]interface{}{"instance": "Reception"}},
+			},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	var updated *platformclientv2.Phone
+	pp.updatePhoneAttr = func(ctx context.Context, p *phoneProxy, phoneId string, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "phone-id", phoneId)
+		updated = phoneConfig
+		return phoneConfig, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	record := bulkPhoneRecord{Row: 2, Name: "Desk 101", HardwareId: "0004f2abcdef", SiteId: "site-1", PhoneBaseSettingsId: "base-1"}
+	outcome := provisionBulkPhone(context.Background(), nil, pp, record, "phone-id", bulkPhoneOutcome{})
+	assert.Equal(t, bulk.OutcomeUpdated, outcome.Outcome, outcome.Error)
+	assert.Equal(t, "phone-id", outcome.Id)
+	assert.Equal(t, bulk.RecordHash(record), outcome.RecordHash)
+	if assert.NotNil(t, updated) {
+		assert.Equal(t, "Desk 101", *updated.Name)
+		assert.Contains(t, *updated.Properties, "phone_label")
+		assert.Equal(t, "0004f2abcdef", phoneHardwareId(*updated))
+	}
+}
+
+func TestUnitProvisionBulkPhoneUnchanged(t *testing.T) {
+	record := bulkPhoneRecord{Row: 2, Name: "Desk 101", HardwareId: "0004f2abcdef", SiteId: "site-1", PhoneBaseSettingsId: "base-1"}
+	previous := bulkPhoneOutcome{Row: 4, HardwareId: record.HardwareId, Result: bulk.Result{Id: "phone-id", Outcome: bulk.OutcomeCreated, RecordHash: bulk.RecordHash(record)}}
+
+	// No API calls are made for a phone whose row has not changed, so no proxy is needed
+	outcome := provisionBulkPhone(context.Background(), nil, nil, record, "phone-id", previous)
+	assert.Equal(t, bulk.OutcomeUnchanged, outcome.Outcome)
+	assert.Equal(t, "phone-id", outcome.Id)
+	assert.Equal(t, 2, outcome.Row)
+
+	// Moving a row in the file does not change its hash
+	record.Row = 10
+	assert.Equal(t, previous.RecordHash, bulk.RecordHash(record))
+	record.SiteId = "site-2"
+	assert.NotEqual(t, previous.RecordHash, bulk.RecordHash(record))
+}
+
+func TestUnitBulkPhoneOutcomesRoundTrip(t *testing.T) {
+	outcomes := map[string]bulkPhoneOutcome{
+		"0004f2abcd00": {Row: 3, HardwareId: "0004f2abcd00", Name: "Desk 102", Result: bulk.Result{Outcome: bulk.OutcomeFailed, Error: "site not found"}},
+		"0004f2abcdef": {Row: 2, HardwareId: "00:04:F2:AB:CD:EF", Name: "Desk 101", UserId: "user-id", Result: bulk.Result{Id: "phone-id", Outcome: bulk.OutcomeCreated, RecordHash: "hash"}},
+	}
+	phones := flattenBulkPhoneOutcomes(outcomes)
+	assert.Equal(t, "Desk 101", phones[0].(map[string]interface{})["name"])
+	assert.Equal(t, outcomes, buildBulkPhoneOutcomes(phones))
+}
+
+func TestUnitAssignUserToBulkPhone(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	var assigned, defaulted []string
+
+	pp := &phoneProxy{}
+	pp.getUserIdByEmailAttr = func(ctx context.Context, p *phoneProxy, email string) (string, *platformclientv2.APIResponse, error) {
+		return "user-1", ok, nil
+	}
+	pp.assignUserToStationAttr = func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+		assigned = append(assigned, userId+"/"+stationId)
+		return ok, nil
+	}
+	pp.assignStationAsDefaultAttr = func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+		defaulted = append(defaulted, userId+"/"+stationId)
+		return ok, nil
+	}
+
+	// The station is looked up with the registered genesyscloud_station data source
+	resources, dataSources := registrar.GetResources()
+	defer registrar.SetResources(resources, dataSources)
+	registrar.SetResources(nil, map[string]*schema.Resource{
+		stationDataSourceName: {
+			Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				d.SetId("station-of-" + d.Get("name").(string))
+				return nil
+			},
+		},
+	})
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	userId, diagErr := assignUserToBulkPhone(context.Background(), meta, pp, bulkPhoneRecord{Name: "Desk 101", User: "jane@example.com"})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "user-1", userId)
+	assert.Equal(t, []string{"user-1/station-of-Desk 101"}, assigned)
+	assert.Equal(t, []string{"user-1/station-of-Desk 101"}, defaulted)
+
+	// User IDs are used as they are
+	userId, diagErr = assignUserToBulkPhone(context.Background(), meta, pp, bulkPhoneRecord{Name: "Desk 102", User: "user-2"})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, "user-2", userId)
+}
+
+func TestUnitGetBulkExistingPhoneIds(t *testing.T) {
+	phoneId, otherPhoneId := "phone-1", "phone-2"
+	pp := &phoneProxy{}
+	pp.listPhonesAttr = func(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Phone{
+			{Id: &phoneId, Properties: &map[string]interface{}{
+				"phone_hardwareId": map[string]interface{}{"value": map[string]interface{}{"instance": "00:04:F2:AB:CD:EF"}},
+			}},
+			// WebRTC phones have no hardware ID
+			{Id: &otherPhoneId},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	existingIds, diagErr := getBulkExistingPhoneIds(context.Background(), pp)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, map[string]string{"0004f2abcdef": phoneId}, existingIds)
+}
@@ -0,0 +1,349 @@
+package telephony_providers_edges_phone
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_phones_bulk_utils.go file reads the phones of a
+genesyscloud_telephony_providers_edges_phones_bulk inventory file.
+*/
+
+const (
+	hardwareIdTypeMac = "mac"
+
+	stationDataSourceName = "genesyscloud_station"
+)
+
+var (
+	bulkPhoneColumns  = []string{"name", "hardware_id", "hardware_id_type", "site_id", "phone_base_settings_id", "line_base_settings_id", "line_address", "state", "user"}
+	macAddressPattern = regexp.MustCompile(`^[0-9a-f]{12}$`)
+)
+
+// bulkPhoneRecord is a single row of a genesyscloud_telephony_providers_edges_phones_bulk inventory file
+type bulkPhoneRecord struct {
+	Row                 int      `json:"-"`
+	Name                string   `json:"name"`
+	HardwareId          string   `json:"hardware_id"`
+	HardwareIdType      string   `json:"hardware_id_type,omitempty"`
+	SiteId              string   `json:"site_id"`
+	PhoneBaseSettingsId string   `json:"phone_base_settings_id"`
+	LineBaseSettingsId  string   `json:"line_base_settings_id,omitempty"`
+	LineAddress         []string `json:"line_address,omitempty"`
+	State               string   `json:"state,omitempty"`
+	User                string   `json:"user,omitempty"`
+}
+
+// bulkPhoneOutcome is the result of provisioning a phone, stored in the phones attribute. The ID of the result is the
+// phone ID.
+type bulkPhoneOutcome struct {
+	Row        int
+	HardwareId string
+	Name       string
+	UserId     string
+	bulk.Result
+}
+
+// key identifies the phone of a record across applies
+func (r bulkPhoneRecord) key() string {
+	return normalizeHardwareId(r.HardwareId)
+}
+
+// normalizeHardwareId lower cases a hardware ID and strips the separators of MAC addresses, so that 00:04:F2:AB:CD:EF
+// and 0004f2abcdef identify the same phone
+func normalizeHardwareId(hardwareId string) string {
+	return strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.ToLower(strings.TrimSpace(hardwareId)))
+}
+
+// readBulkPhoneRecords reads the phones of a CSV inventory file
+func readBulkPhoneRecords(path string) ([]bulkPhoneRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open inventory file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	records, err := parseBulkPhoneCsv(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse inventory file %s: %v", path, err)
+	}
+	return records, validateBulkPhoneRecords(records)
+}
+
+// parseBulkPhoneCsv reads a CSV file with a header row naming the columns. The line_address column lists the DIDs of
+// the phone separated by semicolons.
+func parseBulkPhoneCsv(reader io.Reader) ([]bulkPhoneRecord, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	rows, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("missing header row")
+	}
+
+	header := rows[0]
+	for _, column := range header {
+		if !isBulkPhoneColumn(column) {
+			return nil, fmt.Errorf("unknown column %s, expected one of %s", column, strings.Join(bulkPhoneColumns, ", "))
+		}
+	}
+
+	var records []bulkPhoneRecord
+	for i, row := range rows[1:] {
+		record := bulkPhoneRecord{Row: i + 2}
+		for j, column := range header {
+			setBulkPhoneColumn(&record, column, strings.TrimSpace(row[j]))
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func isBulkPhoneColumn(column string) bool {
+	for _, bulkPhoneColumn := range bulkPhoneColumns {
+		if column == bulkPhoneColumn {
+			return true
+		}
+	}
+	return false
+}
+
+func setBulkPhoneColumn(record *bulkPhoneRecord, column string, value string) {
+	switch column {
+	case "name":
+		record.Name = value
+	case "hardware_id":
+		record.HardwareId = value
+	case "hardware_id_type":
+		record.HardwareIdType = strings.ToLower(value)
+	case "site_id":
+		record.SiteId = value
+	case "phone_base_settings_id":
+		record.PhoneBaseSettingsId = value
+	case "line_base_settings_id":
+		record.LineBaseSettingsId = value
+	case "line_address":
+		record.LineAddress = bulk.SplitList(value)
+	case "state":
+		record.State = value
+	case "user":
+		record.User = value
+	}
+}
+
+// validateBulkPhoneRecords checks the rows for the mistakes that would otherwise fail every phone they affect
+func validateBulkPhoneRecords(records []bulkPhoneRecord) error {
+	var errs []error
+	hardwareIds := make(map[string]int)
+	names := make(map[string]int)
+	users := make(map[string]int)
+	for _, record := range records {
+		if record.HardwareId == "" {
+			errs = append(errs, fmt.Errorf("row %d has no hardware_id", record.Row))
+		} else if row, ok := hardwareIds[record.key()]; ok {
+			errs = append(errs, fmt.Errorf("row %d has the same hardware_id %s as row %d", record.Row, record.HardwareId, row))
+		} else {
+			hardwareIds[record.key()] = record.Row
+		}
+
+		hardwareIdType := record.HardwareIdType
+		if hardwareIdType == "" {
+			hardwareIdType = hardwareIdTypeMac
+		}
+		if hardwareIdType == hardwareIdTypeMac && record.HardwareId != "" && !macAddressPattern.MatchString(record.key()) {
+			errs = append(errs, fmt.Errorf("row %d has hardware_id %s, expected a MAC address", record.Row, record.HardwareId))
+		}
+
+		// The station of a phone is found by the name of the phone
+		if record.Name == "" {
+			errs = append(errs, fmt.Errorf("row %d has no name", record.Row))
+		} else if row, ok := names[record.Name]; ok {
+			errs = append(errs, fmt.Errorf("row %d has the same name %s as row %d", record.Row, record.Name, row))
+		} else {
+			names[record.Name] = record.Row
+		}
+
+		if record.SiteId == "" {
+			errs = append(errs, fmt.Errorf("row %d has no site_id", record.Row))
+		}
+		if record.PhoneBaseSettingsId == "" {
+			errs = append(errs, fmt.Errorf("row %d has no phone_base_settings_id", record.Row))
+		}
+		if record.State != "" && record.State != "active" && record.State != "inactive" {
+			errs = append(errs, fmt.Errorf("row %d has state %s, expected active or inactive", record.Row, record.State))
+		}
+		for _, lineAddress := range record.LineAddress {
+			if diagErr := validators.ValidatePhoneNumber(lineAddress, nil); diagErr.HasError() {
+				errs = append(errs, fmt.Errorf("row %d has line_address %s: %s", record.Row, lineAddress, diagErr[0].Summary))
+			}
+		}
+		if record.User != "" {
+			user := strings.ToLower(record.User)
+			if row, ok := users[user]; ok {
+				errs = append(errs, fmt.Errorf("row %d assigns user %s, already assigned in row %d", record.Row, record.User, row))
+			} else {
+				users[user] = record.Row
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// buildBulkPhone builds the request body provisioning the phone of a record. Like the phone resource, the line base
+// settings default to those of the phone base settings and a phone with line addresses is a standalone phone. An update
+// replaces the whole phone, so an existing phone keeps the properties and capabilities the record does not manage.
+func buildBulkPhone(ctx context.Context, pp *phoneProxy, record bulkPhoneRecord, existing *platformclientv2.Phone) (*platformclientv2.Phone, error) {
+	state := record.State
+	if state == "" {
+		state = "active"
+	}
+	phone := &platformclientv2.Phone{
+		Name:              platformclientv2.String(record.Name),
+		State:             &state,
+		Site:              &platformclientv2.Domainentityref{Id: platformclientv2.String(record.SiteId)},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: platformclientv2.String(record.PhoneBaseSettingsId)},
+	}
+
+	properties := make(map[string]interface{})
+	if existing != nil {
+		phone.Capabilities = existing.Capabilities
+		if existing.Properties != nil {
+			for name, value := range *existing.Properties {
+				properties[name] = value
+			}
+		}
+	}
+	properties["phone_hardwareId"] = map[string]interface{}{
+		"value": map[string]interface{}{
+			"instance": record.HardwareId,
+		},
+	}
+	delete(properties, "phone_standalone")
+
+	lineBaseSettingsId := record.LineBaseSettingsId
+	if lineBaseSettingsId == "" {
+		var err error
+		lineBaseSettingsId, err = getLineBaseSettingsID(ctx, pp, record.PhoneBaseSettingsId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get line base settings for %s: %s", record.Name, err)
+		}
+	}
+	lineBaseSettings := &platformclientv2.Domainentityref{Id: &lineBaseSettingsId}
+	phone.LineBaseSettings = lineBaseSettings
+
+	phoneMetaBaseId, err := getPhoneMetaBaseId(ctx, pp, record.PhoneBaseSettingsId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get phone meta base for %s: %s", record.Name, err)
+	}
+	phone.PhoneMetaBase = &platformclientv2.Domainentityref{Id: &phoneMetaBaseId}
+
+	if len(record.LineAddress) > 0 {
+		phone.Lines = createStandalonePhoneLines(lists.StringListToInterfaceList(record.LineAddress), &[]platformclientv2.Line{}, lineBaseSettings)
+		properties["phone_standalone"] = map[string]interface{}{
+			"value": map[string]interface{}{
+				"instance": true,
+			},
+		}
+	} else {
+		line := platformclientv2.Line{
+			Name:             platformclientv2.String("line_" + lineBaseSettingsId + util.GetUniqueString()),
+			LineBaseSettings: lineBaseSettings,
+		}
+		// The line of an existing phone is kept, as with the phone resource
+		if existing != nil && existing.Lines != nil && len(*existing.Lines) > 0 {
+			line.Id = (*existing.Lines)[0].Id
+		}
+		phone.Lines = &[]platformclientv2.Line{line}
+	}
+	phone.Properties = &properties
+
+	return phone, nil
+}
+
+// phoneHardwareId returns the normalized hardware ID of an existing phone, read from its phone_hardwareId property
+func phoneHardwareId(phone platformclientv2.Phone) string {
+	if phone.Properties == nil {
+		return ""
+	}
+	property, _ := (*phone.Properties)["phone_hardwareId"].(map[string]interface{})
+	value, _ := property["value"].(map[string]interface{})
+	instance, _ := value["instance"].(string)
+	return normalizeHardwareId(instance)
+}
+
+func buildBulkPhoneOutcomes(phones []interface{}) map[string]bulkPhoneOutcome {
+	outcomes := make(map[string]bulkPhoneOutcome)
+	for _, phone := range phones {
+		phoneMap := phone.(map[string]interface{})
+		outcome := bulkPhoneOutcome{
+			Row:        phoneMap["row"].(int),
+			HardwareId: phoneMap["hardware_id"].(string),
+			Name:       phoneMap["name"].(string),
+			UserId:     phoneMap["user_id"].(string),
+			Result: bulk.Result{
+				Id:         phoneMap["phone_id"].(string),
+				Outcome:    phoneMap["outcome"].(string),
+				Error:      phoneMap["error"].(string),
+				RecordHash: phoneMap["record_hash"].(string),
+			},
+		}
+		outcomes[normalizeHardwareId(outcome.HardwareId)] = outcome
+	}
+	return outcomes
+}
+
+// flattenBulkPhoneOutcomes lists the outcomes in the order of the rows of the inventory file
+func flattenBulkPhoneOutcomes(outcomes map[string]bulkPhoneOutcome) []interface{} {
+	sorted := make([]bulkPhoneOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		sorted = append(sorted, outcome)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Row != sorted[j].Row {
+			return sorted[i].Row < sorted[j].Row
+		}
+		return sorted[i].HardwareId < sorted[j].HardwareId
+	})
+
+	phones := make([]interface{}, 0, len(sorted))
+	for _, outcome := range sorted {
+		phones = append(phones, map[string]interface{}{
+			"row":         outcome.Row,
+			"hardware_id": outcome.HardwareId,
+			"name":        outcome.Name,
+			"phone_id":    outcome.Id,
+			"user_id":     outcome.UserId,
+			"outcome":     outcome.Outcome,
+			"error":       outcome.Error,
+			"record_hash": outcome.RecordHash,
+		})
+	}
+	return phones
+}
+
+func GeneratePhonesBulkResource(resourceID string, filepath string, deleteMissing bool, extras ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_phones_bulk" "%s" {
+		filepath          = "%s"
+		file_content_hash = filesha256("%s")
+		delete_missing    = %v
+		%s
+	}
+	`, resourceID, filepath, filepath, deleteMissing, strings.Join(extras, "\n"))
+}
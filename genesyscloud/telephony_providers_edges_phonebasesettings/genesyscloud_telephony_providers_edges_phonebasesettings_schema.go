@@ -98,7 +98,7 @@ func ResourcePhoneBaseSettings() *schema.Resource {
 				Required:    true,
 			},
 			"properties": {
-				Description:      "phone base settings properties",
+				Description:      "phone base settings properties. Property names, types and allowed values are checked at plan time against the template of the phone metabase set in `phone_meta_base_id`, and instance values are normalized to the type of the property.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
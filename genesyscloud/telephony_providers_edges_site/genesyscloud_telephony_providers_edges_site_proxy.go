@@ -53,6 +53,8 @@ type getTelephonyMediaregionsFunc func(ctx context.Context, p *SiteProxy) (*plat
 type setDefaultSiteFunc func(ctx context.Context, p *SiteProxy, siteId string) (*platformclientv2.APIResponse, error)
 type getDefaultSiteIdFunc func(ctx context.Context, p *SiteProxy) (siteId string, resp *platformclientv2.APIResponse, err error)
 
+type getTrunkBaseSettingByIdFunc func(ctx context.Context, p *SiteProxy, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error)
+
 // SiteProxy contains all of the methods that call genesys cloud APIs.
 type SiteProxy struct {
 	clientConfig    *platformclientv2.Configuration
@@ -81,6 +83,8 @@ type SiteProxy struct {
 	setDefaultSiteAttr           setDefaultSiteFunc
 	getDefaultSiteIdAttr         getDefaultSiteIdFunc
 
+	getTrunkBaseSettingByIdAttr getTrunkBaseSettingByIdFunc
+
 	unmanagedSiteCache rc.CacheInterface[platformclientv2.Site]
 	managedSiteCache   rc.CacheInterface[platformclientv2.Site]
 }
@@ -122,6 +126,8 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 		setDefaultSiteAttr:           setDefaultSiteFn,
 		getDefaultSiteIdAttr:         getDefaultSiteIdFn,
 
+		getTrunkBaseSettingByIdAttr: getTrunkBaseSettingByIdFn,
+
 		unmanagedSiteCache: unmanagedSiteCache,
 		managedSiteCache:   managedSiteCache,
 	}
@@ -216,6 +222,11 @@ func (p *SiteProxy) getDefaultSiteId(ctx context.Context) (siteId string, resp *
 	return p.getDefaultSiteIdAttr(ctx, p)
 }
 
+// getTrunkBaseSettingById gets the trunk base settings used by an outbound route
+func (p *SiteProxy) getTrunkBaseSettingById(ctx context.Context, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
+	return p.getTrunkBaseSettingByIdAttr(ctx, p, trunkBaseSettingId)
+}
+
 // getAllManagedSitesFn is an implementation function for retrieving all Genesys Cloud Outbound managed Sites
 func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error) {
 	var allSites []platformclientv2.Site
@@ -483,3 +494,8 @@ func getDefaultSiteIdFn(ctx context.Context, p *SiteProxy) (string, *platformcli
 
 	return *org.DefaultSiteId, resp, nil
 }
+
+// getTrunkBaseSettingByIdFn is an implementation function for getting Genesys Cloud trunk base settings by ID
+func getTrunkBaseSettingByIdFn(ctx context.Context, p *SiteProxy, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.GetTelephonyProvidersEdgesTrunkbasesetting(trunkBaseSettingId, true)
+}
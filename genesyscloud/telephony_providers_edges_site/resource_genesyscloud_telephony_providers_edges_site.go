@@ -191,6 +191,10 @@ func readSite(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			return retryErr
 		}
 
+		if retryErr := readSiteOutboundRouteCoverage(ctx, sp, d); retryErr != nil {
+			return retryErr
+		}
+
 		if !featureToggles.OutboundRoutesToggleExists() {
 			if retryErr := readSiteOutboundRoutes(ctx, sp, d); retryErr != nil {
 				return retryErr
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,7 +63,7 @@ var (
 				ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
 			},
 			"external_trunk_base_ids": {
-				Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
+				Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\". The trunk base settings must be active, which is checked at plan time.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -237,7 +238,7 @@ func ResourceSite() *schema.Resource {
 				Elem:        edgeAutoUpdateConfigSchema,
 			},
 			"number_plans": {
-				Description: "Number plans for the site. The order of the plans in the resource file determines the priority of the plans. Specifying number plans will not result in the default plans being overwritten.",
+				Description: "Number plans for the site. The order of the plans in the resource file determines the priority of the plans. Specifying number plans will not result in the default plans being overwritten. When `outbound_routes` is set and " + featureToggles.OutboundRoutesToggleName() + " is not, every classification of the configured plans other than Extension and Network must be used by an enabled outbound route, which is checked at plan time.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    true,
@@ -252,6 +253,31 @@ func ResourceSite() *schema.Resource {
 				Elem:        outboundRouteSchema,
 				Deprecated:  fmt.Sprintf("The outbound routes property is deprecated in %s, please use independent outbound routes resource instead, genesyscloud_telephony_providers_edges_site_outbound_route", resourceName),
 			},
+			"outbound_route_coverage": {
+				Description: "The enabled outbound routes used for each classification of the site's number plans, including routes managed by genesyscloud_telephony_providers_edges_site_outbound_route. Extension and Network calls are not sent to outbound routes and are not listed.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classification": {
+							Description: "Classification of one or more number plans.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outbound_routes": {
+							Description: "Names of the enabled outbound routes used for the classification.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"covered": {
+							Description: "Whether at least one enabled outbound route is used for the classification.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"primary_sites": {
 				Description: `Used for primary phone edge assignment on physical edges only.  List of primary sites the phones can be assigned to. If no primary_sites are defined, the site id for this site will be used as the primary site id.`,
 				Optional:    true,
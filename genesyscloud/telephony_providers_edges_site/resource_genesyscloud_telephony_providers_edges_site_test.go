@@ -341,6 +341,11 @@ func TestAccResourceSiteoutboundRoute(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.0.enabled", util.TrueValue),
 					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.0.external_trunk_base_ids.0", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings1", "id"),
 					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.0.external_trunk_base_ids.1", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings3", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_route_coverage.*", map[string]string{
+						"classification":    "International",
+						"covered":           util.TrueValue,
+						"outbound_routes.0": "outboundRoute name 1",
+					}),
 				),
 			},
 			{
@@ -0,0 +1,109 @@
+package telephony_providers_edges_site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitOutboundRouteCoverage(t *testing.T) {
+	numberPlans := []interface{}{
+		map[string]interface{}{"name": "Emergency", "classification": "Emergency"},
+		map[string]interface{}{"name": "Extension", "classification": "Extension"},
+		map[string]interface{}{"name": "National", "classification": "National"},
+		map[string]interface{}{"name": "Toll Free", "classification": "National"},
+		map[string]interface{}{"name": "International", "classification": "International"},
+	}
+	classifications := numberPlanClassifications(numberPlans)
+	assert.Equal(t, []string{"Emergency", "National", "International"}, classifications)
+
+	outboundRoutes := []platformclientv2.Outboundroutebase{
+		{Name: platformclientv2.String("Secondary"), Enabled: platformclientv2.Bool(true), ClassificationTypes: &[]string{"National"}},
+		{Name: platformclientv2.String("Primary"), Enabled: platformclientv2.Bool(true), ClassificationTypes: &[]string{"National", "Emergency"}},
+		// Disabled routes do not cover their classifications
+		{Name: platformclientv2.String("Overseas"), Enabled: platformclientv2.Bool(false), ClassificationTypes: &[]string{"International"}},
+	}
+
+	coverage := flattenOutboundRouteCoverage(classifications, outboundRoutes)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"classification": "Emergency", "outbound_routes": []interface{}{"Primary"}, "covered": true},
+		map[string]interface{}{"classification": "National", "outbound_routes": []interface{}{"Primary", "Secondary"}, "covered": true},
+		map[string]interface{}{"classification": "International", "outbound_routes": []interface{}{}, "covered": false},
+	}, coverage)
+
+	assert.EqualError(t, validateOutboundRouteCoverage(classifications, outboundRoutes), "number plan classification International is not covered by an enabled outbound route")
+
+	outboundRoutes[2].Enabled = platformclientv2.Bool(true)
+	assert.NoError(t, validateOutboundRouteCoverage(classifications, outboundRoutes))
+}
+
+func TestUnitValidateOutboundRouteTrunkBases(t *testing.T) {
+	trunkBases := map[string]platformclientv2.Trunkbase{
+		"external":  {TrunkType: platformclientv2.String("EXTERNAL"), State: platformclientv2.String("active")},
+		"phone":     {TrunkType: platformclientv2.String("PHONE"), State: platformclientv2.String("active")},
+		"suspended": {TrunkType: platformclientv2.String("EXTERNAL"), State: platformclientv2.String("inactive")},
+	}
+	var lookups []string
+
+	sp := &SiteProxy{}
+	sp.getTrunkBaseSettingByIdAttr = func(ctx context.Context, p *SiteProxy, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
+		lookups = append(lookups, trunkBaseSettingId)
+		trunkBase, ok := trunkBases[trunkBaseSettingId]
+		if !ok {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+		}
+		return &trunkBase, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	assert.NoError(t, ValidateOutboundRouteTrunkBases(context.Background(), sp, []string{"external", "external"}))
+	// Each trunk base settings is only looked up once
+	assert.Equal(t, []string{"external"}, lookups)
+
+	err := ValidateOutboundRouteTrunkBases(context.Background(), sp, []string{"external", "phone", "suspended", "missing"})
+	assert.Equal(t, []string{
+		"trunk base settings phone has trunk type PHONE, expected EXTERNAL",
+		"trunk base settings suspended is inactive, expected active",
+		"trunk base settings missing not found",
+	}, strings.Split(err.Error(), "\n"))
+}
+
+func TestUnitValidateOutboundRouteChange(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	sp := &SiteProxy{}
+	sp.getSiteNumberPlansAttr = func(ctx context.Context, p *SiteProxy, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Numberplan{
+			{Name: platformclientv2.String("National"), Classification: platformclientv2.String("National")},
+			{Name: platformclientv2.String("International"), Classification: platformclientv2.String("International")},
+			{Name: platformclientv2.String("Emergency"), Classification: platformclientv2.String("Emergency")},
+		}, ok, nil
+	}
+	sp.getSiteOutboundRoutesAttr = func(ctx context.Context, p *SiteProxy, siteId string) (*[]platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Outboundroutebase{
+			{Id: platformclientv2.String("route-1"), Name: platformclientv2.String("Primary"), Enabled: platformclientv2.Bool(true), ClassificationTypes: &[]string{"National", "International"}},
+			{Id: platformclientv2.String("route-2"), Name: platformclientv2.String("Backup"), Enabled: platformclientv2.Bool(true), ClassificationTypes: &[]string{"National"}},
+		}, ok, nil
+	}
+	route := func(enabled bool, classificationTypes ...string) platformclientv2.Outboundroutebase {
+		return platformclientv2.Outboundroutebase{Id: platformclientv2.String("route-1"), Enabled: &enabled, ClassificationTypes: &classificationTypes}
+	}
+
+	// National is still covered by the backup route, and Emergency was not covered before the change
+	assert.NoError(t, ValidateOutboundRouteChange(context.Background(), sp, "site-1", "route-1", route(true, "National", "International")))
+	assert.NoError(t, ValidateOutboundRouteChange(context.Background(), sp, "site-1", "route-1", route(true, "International")))
+
+	assert.EqualError(t, ValidateOutboundRouteChange(context.Background(), sp, "site-1", "route-1", route(true, "National")),
+		"number plan classification International of site site-1 would no longer be covered by an enabled outbound route")
+	assert.EqualError(t, ValidateOutboundRouteChange(context.Background(), sp, "site-1", "route-1", route(false, "National", "International")),
+		"number plan classification International of site site-1 would no longer be covered by an enabled outbound route")
+
+	// A deleted site has nothing left to cover
+	sp.getSiteNumberPlansAttr = func(ctx context.Context, p *SiteProxy, siteId string) (*[]platformclientv2.Numberplan, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+	assert.NoError(t, ValidateOutboundRouteChange(context.Background(), sp, "site-1", "route-1", route(false)))
+}
@@ -2,11 +2,14 @@ package telephony_providers_edges_site
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,9 +25,23 @@ import (
 
 var (
 	defaultPlans = []string{"Emergency", "Extension", "National", "International", "Network", "Suicide Prevention"}
+
+	// Extension and Network calls stay within Genesys Cloud and are never sent to the trunks of an outbound route
+	unroutedClassifications = []string{"Extension", "Network"}
 )
 
 func customizeSiteDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeSiteNumberPlansDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if diff.HasChange("number_plans") || diff.HasChange("outbound_routes") {
+		_ = diff.SetNewComputed("outbound_route_coverage")
+	}
+	return validateSiteOutboundRoutesDiff(ctx, diff, meta)
+}
+
+func customizeSiteNumberPlansDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if diff.HasChange("number_plans") {
 		oldNumberPlans, newNumberPlans := diff.GetChange("number_plans")
 		oldNumberPlansList := oldNumberPlans.([]interface{})
@@ -61,6 +78,191 @@ func customizeSiteDiff(ctx context.Context, diff *schema.ResourceDiff, meta inte
 	return nil
 }
 
+// validateSiteOutboundRoutesDiff checks that the deprecated outbound_routes of the site cover every routed
+// classification of the number plans set in the configuration and only use active external trunks. It is skipped until
+// both attributes are known, so routes using trunk base settings created in the same apply are checked on the next plan.
+// Routes managed by genesyscloud_telephony_providers_edges_site_outbound_route are checked by ValidateOutboundRouteChange.
+func validateSiteOutboundRoutesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if featureToggles.OutboundRoutesToggleExists() {
+		return nil
+	}
+	if !diff.HasChange("number_plans") && !diff.HasChange("outbound_routes") {
+		return nil
+	}
+	if !diff.NewValueKnown("number_plans") || !diff.NewValueKnown("outbound_routes") {
+		return nil
+	}
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || rawConfig.GetAttr("outbound_routes").IsNull() {
+		return nil
+	}
+
+	// The default plans the API adds to every site are not validated. customizeSiteNumberPlansDiff appends the removed
+	// default plans after the configured ones, so the configured plans are the first ones.
+	numberPlans := diff.Get("number_plans").([]interface{})
+	configuredPlanCount := 0
+	if rawNumberPlans := rawConfig.GetAttr("number_plans"); !rawNumberPlans.IsNull() {
+		configuredPlanCount = rawNumberPlans.LengthInt()
+	}
+	if configuredPlanCount < len(numberPlans) {
+		numberPlans = numberPlans[:configuredPlanCount]
+	}
+
+	outboundRoutes := make([]platformclientv2.Outboundroutebase, 0)
+	if ors, ok := diff.Get("outbound_routes").(*schema.Set); ok && ors != nil {
+		for _, or := range ors.List() {
+			outboundRoutes = append(outboundRoutes, buildSdkOutboundRoute(or.(map[string]interface{})))
+		}
+	}
+
+	classifications := numberPlanClassifications(numberPlans)
+	if err := validateOutboundRouteCoverage(classifications, outboundRoutes); err != nil {
+		return err
+	}
+
+	trunkBaseIds := make([]string, 0)
+	for _, outboundRoute := range outboundRoutes {
+		if outboundRoute.ExternalTrunkBases == nil {
+			continue
+		}
+		for _, trunkBase := range *outboundRoute.ExternalTrunkBases {
+			trunkBaseIds = append(trunkBaseIds, util.StringValue(trunkBase.Id))
+		}
+	}
+	if len(trunkBaseIds) == 0 {
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	return ValidateOutboundRouteTrunkBases(ctx, GetSiteProxy(sdkConfig), trunkBaseIds)
+}
+
+// ValidateOutboundRouteTrunkBases checks that the trunk base settings used by outbound routes are active external trunks
+func ValidateOutboundRouteTrunkBases(ctx context.Context, sp *SiteProxy, trunkBaseIds []string) error {
+	var errs []error
+	checked := make(map[string]bool)
+	for _, trunkBaseId := range trunkBaseIds {
+		if checked[trunkBaseId] {
+			continue
+		}
+		checked[trunkBaseId] = true
+
+		trunkBase, resp, err := sp.getTrunkBaseSettingById(ctx, trunkBaseId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				errs = append(errs, fmt.Errorf("trunk base settings %s not found", trunkBaseId))
+				continue
+			}
+			return fmt.Errorf("failed to get trunk base settings %s: %s", trunkBaseId, err)
+		}
+
+		if trunkType := util.StringValue(trunkBase.TrunkType); trunkType != "EXTERNAL" {
+			errs = append(errs, fmt.Errorf("trunk base settings %s has trunk type %s, expected EXTERNAL", trunkBaseId, trunkType))
+		}
+		if state := util.StringValue(trunkBase.State); state != "active" {
+			errs = append(errs, fmt.Errorf("trunk base settings %s is %s, expected active", trunkBaseId, state))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateOutboundRouteChange checks that replacing the outbound route routeId of a site with outboundRoute does not
+// leave a classification of the site's number plans without an enabled outbound route. Only the coverage lost by the
+// change is checked, as routes created by other resources in the same apply are not known yet.
+func ValidateOutboundRouteChange(ctx context.Context, sp *SiteProxy, siteId string, routeId string, outboundRoute platformclientv2.Outboundroutebase) error {
+	numberPlans, resp, err := sp.getSiteNumberPlans(ctx, siteId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return nil
+		}
+		return fmt.Errorf("failed to get number plans of site %s: %s", siteId, err)
+	}
+	outboundRoutes, resp, err := sp.getSiteOutboundRoutes(ctx, siteId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return nil
+		}
+		return fmt.Errorf("failed to get outbound routes of site %s: %s", siteId, err)
+	}
+
+	plannedRoutes := make([]platformclientv2.Outboundroutebase, 0, len(*outboundRoutes))
+	for _, route := range *outboundRoutes {
+		if util.StringValue(route.Id) != routeId {
+			plannedRoutes = append(plannedRoutes, route)
+		}
+	}
+	plannedRoutes = append(plannedRoutes, outboundRoute)
+
+	flattenedPlans := make([]interface{}, 0, len(*numberPlans))
+	for i := range *numberPlans {
+		flattenedPlans = append(flattenedPlans, flattenNumberPlan(&(*numberPlans)[i]))
+	}
+
+	var errs []error
+	for _, classification := range numberPlanClassifications(flattenedPlans) {
+		if len(coveringOutboundRoutes(classification, *outboundRoutes)) > 0 && len(coveringOutboundRoutes(classification, plannedRoutes)) == 0 {
+			errs = append(errs, fmt.Errorf("number plan classification %s of site %s would no longer be covered by an enabled outbound route", classification, siteId))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// numberPlanClassifications returns the classifications of the number_plans attribute that need an outbound route,
+// in the order of the plans and without duplicates
+func numberPlanClassifications(numberPlans []interface{}) []string {
+	classifications := make([]string, 0)
+	for _, numberPlan := range numberPlans {
+		numberPlanMap, ok := numberPlan.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		classification, _ := numberPlanMap["classification"].(string)
+		if classification == "" || lists.ItemInSlice(classification, unroutedClassifications) || lists.ItemInSlice(classification, classifications) {
+			continue
+		}
+		classifications = append(classifications, classification)
+	}
+	return classifications
+}
+
+// coveringOutboundRoutes returns the names of the enabled outbound routes used for a classification, sorted by name
+func coveringOutboundRoutes(classification string, outboundRoutes []platformclientv2.Outboundroutebase) []string {
+	names := make([]string, 0)
+	for _, outboundRoute := range outboundRoutes {
+		if outboundRoute.Enabled == nil || !*outboundRoute.Enabled || outboundRoute.ClassificationTypes == nil {
+			continue
+		}
+		if lists.ItemInSlice(classification, *outboundRoute.ClassificationTypes) {
+			names = append(names, util.StringValue(outboundRoute.Name))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func validateOutboundRouteCoverage(classifications []string, outboundRoutes []platformclientv2.Outboundroutebase) error {
+	var errs []error
+	for _, classification := range classifications {
+		if len(coveringOutboundRoutes(classification, outboundRoutes)) == 0 {
+			errs = append(errs, fmt.Errorf("number plan classification %s is not covered by an enabled outbound route", classification))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func flattenOutboundRouteCoverage(classifications []string, outboundRoutes []platformclientv2.Outboundroutebase) []interface{} {
+	coverage := make([]interface{}, 0)
+	for _, classification := range classifications {
+		routeNames := coveringOutboundRoutes(classification, outboundRoutes)
+		coverage = append(coverage, map[string]interface{}{
+			"classification":  classification,
+			"outbound_routes": lists.StringListToInterfaceList(routeNames),
+			"covered":         len(routeNames) > 0,
+		})
+	}
+	return coverage
+}
+
 func validateMediaRegions(ctx context.Context, sp *SiteProxy, regions *[]string) error {
 	telephonyRegions, _, err := sp.getTelephonyMediaregions(ctx)
 	if err != nil {
@@ -259,34 +461,7 @@ func updateSiteOutboundRoutes(ctx context.Context, sp *SiteProxy, d *schema.Reso
 
 	outboundRoutesFromTf := make([]platformclientv2.Outboundroutebase, 0)
 	for _, or := range orsList {
-		orMap := or.(map[string]interface{})
-		outboundRouteFromTf := platformclientv2.Outboundroutebase{}
-
-		resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Name, orMap, "name")
-		resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Description, orMap, "description")
-
-		if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
-			cts := make([]string, 0)
-			for _, classificationType := range classificationTypes {
-				cts = append(cts, classificationType.(string))
-			}
-			outboundRouteFromTf.ClassificationTypes = &cts
-		}
-		if enabled, ok := orMap["enabled"].(bool); ok {
-			outboundRouteFromTf.Enabled = &enabled
-		}
-		resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Distribution, orMap, "distribution")
-
-		if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
-			ids := make([]platformclientv2.Domainentityref, 0)
-			for _, externalTrunkBaseId := range externalTrunkBaseIds {
-				externalTrunkBaseIdStr := externalTrunkBaseId.(string)
-				ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
-			}
-			outboundRouteFromTf.ExternalTrunkBases = &ids
-		}
-
-		outboundRoutesFromTf = append(outboundRoutesFromTf, outboundRouteFromTf)
+		outboundRoutesFromTf = append(outboundRoutesFromTf, buildSdkOutboundRoute(or.(map[string]interface{})))
 	}
 
 	// The default outbound routes won't be assigned yet if there isn't a wait
@@ -426,6 +601,50 @@ func readSiteOutboundRoutes(ctx context.Context, sp *SiteProxy, d *schema.Resour
 	return nil
 }
 
+// buildSdkOutboundRoute builds an outbound route from an element of the outbound_routes attribute
+func buildSdkOutboundRoute(orMap map[string]interface{}) platformclientv2.Outboundroutebase {
+	outboundRouteFromTf := platformclientv2.Outboundroutebase{}
+
+	resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Name, orMap, "name")
+	resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Description, orMap, "description")
+
+	if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
+		cts := make([]string, 0)
+		for _, classificationType := range classificationTypes {
+			cts = append(cts, classificationType.(string))
+		}
+		outboundRouteFromTf.ClassificationTypes = &cts
+	}
+	if enabled, ok := orMap["enabled"].(bool); ok {
+		outboundRouteFromTf.Enabled = &enabled
+	}
+	resourcedata.BuildSDKStringValueIfNotNil(&outboundRouteFromTf.Distribution, orMap, "distribution")
+
+	if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
+		ids := make([]platformclientv2.Domainentityref, 0)
+		for _, externalTrunkBaseId := range externalTrunkBaseIds {
+			externalTrunkBaseIdStr := externalTrunkBaseId.(string)
+			ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
+		}
+		outboundRouteFromTf.ExternalTrunkBases = &ids
+	}
+
+	return outboundRouteFromTf
+}
+
+// readSiteOutboundRouteCoverage sets the outbound_route_coverage attribute from the number plans already read and all
+// outbound routes of the site, including the ones managed by genesyscloud_telephony_providers_edges_site_outbound_route
+func readSiteOutboundRouteCoverage(ctx context.Context, sp *SiteProxy, d *schema.ResourceData) *retry.RetryError {
+	outboundRoutes, resp, err := sp.getSiteOutboundRoutes(ctx, d.Id())
+	if err != nil {
+		return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to get outbound routes for site %s | error: %s", d.Id(), err), resp))
+	}
+
+	classifications := numberPlanClassifications(d.Get("number_plans").([]interface{}))
+	_ = d.Set("outbound_route_coverage", flattenOutboundRouteCoverage(classifications, *outboundRoutes))
+	return nil
+}
+
 func flattenSdkEdgeAutoUpdateConfig(edgeAutoUpdateConfig *platformclientv2.Edgeautoupdateconfig) []interface{} {
 	if edgeAutoUpdateConfig == nil {
 		return nil
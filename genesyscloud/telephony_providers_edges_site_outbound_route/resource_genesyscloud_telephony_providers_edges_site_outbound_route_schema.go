@@ -38,6 +38,7 @@ func ResourceSiteOutboundRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeSiteOutboundRouteDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"site_id": {
@@ -62,7 +63,7 @@ func ResourceSiteOutboundRoute() *schema.Resource {
 				Optional:    true,
 			},
 			"classification_types": {
-				Description: "Used to classify this outbound route.",
+				Description: "Used to classify this outbound route. The plan fails if removing a classification type, or disabling the route, leaves a classification of the site's number plans that is covered today without an enabled outbound route. Deleting the route is not checked.",
 				Type:        schema.TypeList,
 				Required:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -81,7 +82,7 @@ func ResourceSiteOutboundRoute() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
 			},
 			"external_trunk_base_ids": {
-				Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
+				Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\". The trunk base settings must be active, which is checked at plan time.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -1,8 +1,12 @@
 package telephony_providers_edges_site_outbound_route
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	telephonyProvidersEdgesSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
@@ -43,6 +47,64 @@ func buildOutboundRoutes(d *schema.ResourceData) *platformclientv2.Outboundroute
 	return &outboundRouteSdk
 }
 
+// customizeSiteOutboundRouteDiff checks at plan time that the route only uses active external trunks and that a change
+// to the route does not leave a classification of the site's number plans without an enabled outbound route
+func customizeSiteOutboundRouteDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateOutboundRouteTrunkBasesDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+	return validateOutboundRouteCoverageDiff(ctx, diff, meta)
+}
+
+// validateOutboundRouteTrunkBasesDiff checks that the route only uses active external trunks. Trunk base settings
+// created in the same apply are checked on the next plan.
+func validateOutboundRouteTrunkBasesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("external_trunk_base_ids") || !diff.NewValueKnown("external_trunk_base_ids") {
+		return nil
+	}
+
+	trunkBaseIds := lists.InterfaceListToStrings(diff.Get("external_trunk_base_ids").([]interface{}))
+	if len(trunkBaseIds) == 0 {
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getSiteOutboundRouteProxy(sdkConfig)
+	if err := telephonyProvidersEdgesSite.ValidateOutboundRouteTrunkBases(ctx, proxy.siteProxy, trunkBaseIds); err != nil {
+		return fmt.Errorf("invalid external_trunk_base_ids for outbound route %s: %w", diff.Get("name").(string), err)
+	}
+	return nil
+}
+
+// validateOutboundRouteCoverageDiff checks the routes of the site as they will be once the route is disabled or its
+// classification types change. Creating a route cannot remove coverage, and removing one is not planned through
+// CustomizeDiff, so only updates are checked.
+func validateOutboundRouteCoverageDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange("site_id") || !diff.HasChanges("enabled", "classification_types") {
+		return nil
+	}
+	if !diff.NewValueKnown("enabled") || !diff.NewValueKnown("classification_types") {
+		return nil
+	}
+
+	siteId, routeId := splitSiteAndOutboundRoute(diff.Id())
+	name := diff.Get("name").(string)
+	classificationTypes := lists.InterfaceListToStrings(diff.Get("classification_types").([]interface{}))
+	outboundRoute := platformclientv2.Outboundroutebase{
+		Id:                  &routeId,
+		Name:                &name,
+		Enabled:             platformclientv2.Bool(diff.Get("enabled").(bool)),
+		ClassificationTypes: &classificationTypes,
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getSiteOutboundRouteProxy(sdkConfig)
+	if err := telephonyProvidersEdgesSite.ValidateOutboundRouteChange(ctx, proxy.siteProxy, siteId, routeId, outboundRoute); err != nil {
+		return fmt.Errorf("invalid change to outbound route %s: %w", name, err)
+	}
+	return nil
+}
+
 func buildSiteAndOutboundRouteId(siteId string, outboundRouteId string) string {
 	fullOutboundRouteId := fmt.Sprintf("%s:%s", siteId, outboundRouteId)
 	return fullOutboundRouteId
@@ -33,6 +33,12 @@ type deleteUserFunc func(ctx context.Context, p *userProxy, id string) (*interfa
 type patchUserWithStateFunc func(ctx context.Context, p *userProxy, id string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
 type hydrateUserCacheFunc func(ctx context.Context, p *userProxy, pageSize int, pageNum int) (*platformclientv2.Userentitylisting, *platformclientv2.APIResponse, error)
 type getUserByNameFunc func(ctx context.Context, p *userProxy, searchUser platformclientv2.Usersearchrequest) (*platformclientv2.Userssearchresponse, *platformclientv2.APIResponse, error)
+type getUsersByStateFunc func(ctx context.Context, p *userProxy, state string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getUserQueueIdsFunc func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error)
+type removeUserFromQueueFunc func(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error)
+type removeUserRolesFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error)
+type removeUserStationsFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error)
+type updateUserDivisionFunc func(ctx context.Context, p *userProxy, id string, divisionId string) (*platformclientv2.APIResponse, error)
 
 /*
 The userProxy struct holds all the methods responsible for making calls to
@@ -42,19 +48,25 @@ enabling this terraform provider software to perform tasks like retrieving data,
 or triggering actions within the Genesys Cloud environment.
 */
 type userProxy struct {
-	clientConfig           *platformclientv2.Configuration
-	userApi                *platformclientv2.UsersApi
-	routingApi             *platformclientv2.RoutingApi
-	createUserAttr         createUserFunc
-	getAllUserAttr         getAllUserFunc
-	getUserIdByNameAttr    getUserIdByNameFunc
-	getUserByIdAttr        getUserByIdFunc
-	updateUserAttr         updateUserFunc
-	deleteUserAttr         deleteUserFunc
-	patchUserWithStateAttr patchUserWithStateFunc
-	hydrateUserCacheAttr   hydrateUserCacheFunc
-	getUserByNameAttr      getUserByNameFunc
-	userCache              rc.CacheInterface[platformclientv2.User] //Define the cache for user resource
+	clientConfig            *platformclientv2.Configuration
+	userApi                 *platformclientv2.UsersApi
+	routingApi              *platformclientv2.RoutingApi
+	createUserAttr          createUserFunc
+	getAllUserAttr          getAllUserFunc
+	getUserIdByNameAttr     getUserIdByNameFunc
+	getUserByIdAttr         getUserByIdFunc
+	updateUserAttr          updateUserFunc
+	deleteUserAttr          deleteUserFunc
+	patchUserWithStateAttr  patchUserWithStateFunc
+	hydrateUserCacheAttr    hydrateUserCacheFunc
+	getUserByNameAttr       getUserByNameFunc
+	getUsersByStateAttr     getUsersByStateFunc
+	getUserQueueIdsAttr     getUserQueueIdsFunc
+	removeUserFromQueueAttr removeUserFromQueueFunc
+	removeUserRolesAttr     removeUserRolesFunc
+	removeUserStationsAttr  removeUserStationsFunc
+	updateUserDivisionAttr  updateUserDivisionFunc
+	userCache               rc.CacheInterface[platformclientv2.User] //Define the cache for user resource
 }
 
 /*
@@ -68,19 +80,25 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 	routingApi := platformclientv2.NewRoutingApiWithConfig(clientConfig) // NewRoutingApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	userCache := rc.NewResourceCache[platformclientv2.User]()            // Create Cache for User resource
 	return &userProxy{
-		clientConfig:           clientConfig,
-		userApi:                userApi,
-		routingApi:             routingApi,
-		userCache:              userCache,
-		createUserAttr:         createUserFn,
-		getAllUserAttr:         getAllUserFn,
-		getUserIdByNameAttr:    getUserIdByNameFn,
-		getUserByIdAttr:        getUserByIdFn,
-		updateUserAttr:         updateUserFn,
-		deleteUserAttr:         deleteUserFn,
-		patchUserWithStateAttr: patchUserWithStateFn,
-		hydrateUserCacheAttr:   hydrateUserCacheFn,
-		getUserByNameAttr:      getUserByNameFn,
+		clientConfig:            clientConfig,
+		userApi:                 userApi,
+		routingApi:              routingApi,
+		userCache:               userCache,
+		createUserAttr:          createUserFn,
+		getAllUserAttr:          getAllUserFn,
+		getUserIdByNameAttr:     getUserIdByNameFn,
+		getUserByIdAttr:         getUserByIdFn,
+		updateUserAttr:          updateUserFn,
+		deleteUserAttr:          deleteUserFn,
+		patchUserWithStateAttr:  patchUserWithStateFn,
+		hydrateUserCacheAttr:    hydrateUserCacheFn,
+		getUserByNameAttr:       getUserByNameFn,
+		getUsersByStateAttr:     getUsersByStateFn,
+		getUserQueueIdsAttr:     getUserQueueIdsFn,
+		removeUserFromQueueAttr: removeUserFromQueueFn,
+		removeUserRolesAttr:     removeUserRolesFn,
+		removeUserStationsAttr:  removeUserStationsFn,
+		updateUserDivisionAttr:  updateUserDivisionFn,
 	}
 }
 
@@ -146,6 +164,36 @@ func (p *userProxy) getUserByName(ctx context.Context, searchUser platformclient
 	return p.getUserByNameAttr(ctx, p, searchUser)
 }
 
+// getUsersByState returns all Genesys Cloud Users in a state without adding them to the cache
+func (p *userProxy) getUsersByState(ctx context.Context, state string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUsersByStateAttr(ctx, p, state)
+}
+
+// getUserQueueIds returns the IDs of the queues a Genesys Cloud User is a member of, joined or not
+func (p *userProxy) getUserQueueIds(ctx context.Context, id string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getUserQueueIdsAttr(ctx, p, id)
+}
+
+// removeUserFromQueue removes a Genesys Cloud User from the members of a queue
+func (p *userProxy) removeUserFromQueue(ctx context.Context, id string, queueId string) (*platformclientv2.APIResponse, error) {
+	return p.removeUserFromQueueAttr(ctx, p, id, queueId)
+}
+
+// removeUserRoles removes every role granted to a Genesys Cloud User
+func (p *userProxy) removeUserRoles(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.removeUserRolesAttr(ctx, p, id)
+}
+
+// removeUserStations removes the associated and default stations of a Genesys Cloud User
+func (p *userProxy) removeUserStations(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.removeUserStationsAttr(ctx, p, id)
+}
+
+// updateUserDivision moves a Genesys Cloud User to a division
+func (p *userProxy) updateUserDivision(ctx context.Context, id string, divisionId string) (*platformclientv2.APIResponse, error) {
+	return p.updateUserDivisionAttr(ctx, p, id, divisionId)
+}
+
 // createUserFn is an implementation function for creating a Genesys Cloud user
 func createUserFn(ctx context.Context, p *userProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
 	return p.userApi.PostUsers(*createUser)
@@ -176,6 +224,89 @@ func deleteUserFn(ctx context.Context, p *userProxy, id string) (*interface{}, *
 	return data, nil, nil
 }
 
+// getUsersByStateFn is an implementation of the function to get all Genesys Cloud users in a state, without expands
+func getUsersByStateFn(ctx context.Context, p *userProxy, state string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var users []platformclientv2.User
+	for pageNum := 1; ; pageNum++ {
+		usersList, resp, err := p.userApi.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", state)
+		if err != nil {
+			// The API stops paging inactive users after 10,000, as when exporting all users
+			if state == "inactive" && pageNum > 1 && resp != nil && resp.StatusCode == http.StatusBadRequest {
+				log.Printf("WARNING!!: The maximum number of inactive users (10,000) have been retrieved from the API.")
+				return &users, resp, nil
+			}
+			return nil, resp, err
+		}
+		if usersList.Entities != nil {
+			users = append(users, *usersList.Entities...)
+		}
+		if usersList.PageCount == nil || pageNum >= *usersList.PageCount {
+			return &users, resp, nil
+		}
+	}
+}
+
+// getUserQueueIdsFn is an implementation of the function to get the queues of a Genesys Cloud user
+func getUserQueueIdsFn(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var queueIds []string
+	for _, joined := range []bool{true, false} {
+		for pageNum := 1; ; pageNum++ {
+			queues, resp, err := p.userApi.GetUserQueues(id, pageSize, pageNum, joined, nil)
+			if err != nil {
+				return nil, resp, err
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				break
+			}
+			for _, queue := range *queues.Entities {
+				queueIds = append(queueIds, *queue.Id)
+			}
+			if queues.PageCount == nil || pageNum >= *queues.PageCount {
+				break
+			}
+		}
+	}
+	return queueIds, nil, nil
+}
+
+// removeUserFromQueueFn is an implementation of the function to remove a Genesys Cloud user from a queue
+func removeUserFromQueueFn(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.DeleteRoutingQueueMember(queueId, id)
+}
+
+// removeUserRolesFn is an implementation of the function to remove the roles of a Genesys Cloud user
+func removeUserRolesFn(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.userApi.PutUserRoles(id, []string{})
+	return resp, err
+}
+
+// removeUserStationsFn is an implementation of the function to remove the stations of a Genesys Cloud user
+func removeUserStationsFn(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+	stations, resp, err := p.userApi.GetUserStation(id)
+	if err != nil {
+		return resp, err
+	}
+	if stations.AssociatedStation != nil {
+		if resp, err := p.userApi.DeleteUserStationAssociatedstation(id); err != nil {
+			return resp, err
+		}
+	}
+	if stations.DefaultStation != nil {
+		if resp, err := p.userApi.DeleteUserStationDefaultstation(id); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// updateUserDivisionFn is an implementation of the function to move a Genesys Cloud user to a division
+func updateUserDivisionFn(ctx context.Context, p *userProxy, id string, divisionId string) (*platformclientv2.APIResponse, error) {
+	authApi := platformclientv2.NewAuthorizationApiWithConfig(p.clientConfig)
+	return authApi.PostAuthorizationDivisionObject(divisionId, "USER", []string{id})
+}
+
 func patchUserWithStateFn(ctx context.Context, p *userProxy, id string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
 	return p.userApi.PatchUser(id, *updateUser)
 }
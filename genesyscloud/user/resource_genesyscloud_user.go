@@ -99,6 +99,18 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 				d.SetId(*id)
 				return restoreDeletedUser(ctx, d, meta, proxy)
 			}
+
+			// Reactivate an inactive user, such as one previously inactivated on destroy, only when requested
+			if d.Get("reactivate_inactive_user").(bool) {
+				id, diagErr = getUserIdByState(email, "inactive", proxy)
+				if diagErr != nil {
+					return diagErr
+				}
+				if id != nil {
+					d.SetId(*id)
+					return restoreUser(ctx, d, meta, proxy, "inactive")
+				}
+			}
 		}
 		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to create user %s error: %s", email, postErr), proxyPostResponse)
 	}
@@ -202,8 +214,10 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating user %s", email)
 
-	// If state changes, it is the only modifiable field, so it must be updated separately
-	if d.HasChange("state") {
+	// If state changes, it is the only modifiable field, so it must be updated separately. A user being inactivated is
+	// updated first and inactivated last, so that the rest of the update is not applied to an inactive user.
+	inactivating := d.HasChange("state") && d.Get("state").(string) == "inactive"
+	if d.HasChange("state") && !inactivating {
 		log.Printf("Updating state for user %s", email)
 		updateUser := platformclientv2.Updateuser{
 			State: platformclientv2.String(d.Get("state").(string)),
@@ -236,6 +250,13 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
+	if inactivating {
+		diagErr = inactivateUser(ctx, d, proxy)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Finished updating user %s", email)
 	return readUser(ctx, d, meta)
 }
@@ -246,6 +267,15 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	email := d.Get("email").(string)
 
+	switch d.Get("lifecycle_on_destroy").(string) {
+	case "keep":
+		log.Printf("Keeping user %s, removing it from state only", email)
+		return nil
+	case "inactivate":
+		log.Printf("Inactivating user %s instead of deleting it", email)
+		return inactivateUser(ctx, d, proxy)
+	}
+
 	log.Printf("Deleting user %s", email)
 	err := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
@@ -13,12 +13,14 @@ import (
 )
 
 const resourceName = "genesyscloud_user"
+const bulkResourceName = "genesyscloud_users_bulk"
 
 // SetRegistrar registers all the resources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceUser())
 	l.RegisterResource(resourceName, ResourceUser())
 	l.RegisterExporter(resourceName, UserExporter())
+	l.RegisterResource(bulkResourceName, ResourceUsersBulk())
 }
 
 var (
@@ -388,6 +390,127 @@ func ResourceUser() *schema.Resource {
 					},
 				},
 			},
+			"lifecycle_on_destroy": {
+				Description:  "What happens to the user when this resource is destroyed (delete | inactivate | keep). Inactivating sets the user inactive after applying the inactivation_rules, releasing its license. A user inactivated this way is only reactivated by a genesyscloud_user with the same email when reactivate_inactive_user is set. If not set, the user is deleted.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"delete", "inactivate", "keep"}, false),
+			},
+			"reactivate_inactive_user": {
+				Description: "Reactivate an inactive user with the same email when the user cannot be created because the email is taken, for instance a user inactivated by lifecycle_on_destroy. The user keeps the roles, groups, queue memberships and other settings that this resource does not manage. If not set, creating the user fails instead.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"inactivation_rules": {
+				Description: "Cleanup applied whenever this resource sets the user inactive, either on destroy with lifecycle_on_destroy set to inactivate or when state changes to inactive.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remove_queue_memberships": {
+							Description: "Remove the user from every queue it is a member of.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"remove_roles": {
+							Description: "Remove every role granted to the user.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"remove_stations": {
+							Description: "Remove the user's associated and default stations.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Users Bulk
+
+Provisions the users of a CSV or JSON source file keyed by email. Users are created, restored from the deleted state or updated with the same logic as genesyscloud_user, and only users whose record changed since the last apply are updated. A user that fails to provision does not stop the others; its error is recorded in the users attribute and it is retried on the next apply.`,
+
+		CreateContext: provider.CreateWithPooledClient(createUsersBulk),
+		ReadContext:   provider.ReadWithPooledClient(readUsersBulk),
+		UpdateContext: provider.UpdateWithPooledClient(updateUsersBulk),
+		DeleteContext: provider.DeleteWithPooledClient(deleteUsersBulk),
+		CustomizeDiff: customizeUsersBulkDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description:  "Path to the CSV or JSON file listing the users, selected by the .csv or .json extension. Columns and fields: email, name, state, division_id, department, title, manager, acd_auto_answer, profile_skills, certifications, routing_skills and routing_languages. The manager is a user ID or the email of a user in the file or in the org. In CSV files, list columns are separated by semicolons and skills and languages are written as id:proficiency.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the source file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"max_concurrency": {
+				Description:  "Maximum number of users provisioned at the same time. Value must be between 1 and 20.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"deactivate_missing": {
+				Description: "Set users removed from the source file to inactive. If false, they are no longer managed by this resource.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"destroy_action": {
+				Description:  "What happens to the users when this resource is destroyed (none | deactivate | delete).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "deactivate",
+				ValidateFunc: validation.StringInSlice([]string{"none", "deactivate", "delete"}, false),
+			},
+			"users": {
+				Description: "Outcome of the last apply for each user of the source file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Description: "User's primary email.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_id": {
+							Description: "ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outcome": {
+							Description: "Outcome of the last apply (created | restored | updated | unchanged | deactivated | failed | missing). Missing users were deleted outside of Terraform.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error": {
+							Description: "Error returned while provisioning the user, if it failed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"record_hash": {
+							Description: "Hash of the user's record in the source file when it was last provisioned.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -1418,3 +1418,80 @@ func generateUserLocation(locResource string, notes string) string {
 			}
 			`, locResource, notes)
 }
+
+func TestAccResourceUserLifecycleInactivate(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource  = "test-user-lifecycle"
+		otherResource = "test-user-other"
+		email         = "terraform-" + uuid.NewString() + "@user.com"
+		otherEmail    = "terraform-" + uuid.NewString() + "@user.com"
+		userName      = "Lifecycle Terraform"
+		userId        string
+	)
+
+	generateLifecycleUser := func(lifecycle string, reactivate bool) string {
+		return fmt.Sprintf(`resource "genesyscloud_user" "%s" {
+			email                    = "%s"
+			name                     = "%s"
+			lifecycle_on_destroy     = "%s"
+			reactivate_inactive_user = %v
+			inactivation_rules {
+				remove_queue_memberships = true
+				remove_stations          = true
+			}
+		}
+		`, userResource, email, userName, lifecycle, reactivate)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: generateLifecycleUser("inactivate", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+userResource, "state", "active"),
+					func(s *terraform.State) error {
+						userId = s.RootModule().Resources[resourceName+"."+userResource].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Destroying the user only sets it inactive
+				Config: GenerateBasicUserResource(otherResource, otherEmail, "Other Terraform"),
+				Check: func(s *terraform.State) error {
+					return checkUserState(userId, "inactive")
+				},
+			},
+			{
+				// Creating the user again with reactivate_inactive_user reactivates the inactive user rather than failing on the conflict
+				Config: GenerateBasicUserResource(otherResource, otherEmail, "Other Terraform") + generateLifecycleUser("delete", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+userResource, "state", "active"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName+"."+userResource].Primary.ID; id != userId {
+							return fmt.Errorf("expected user %s to be reactivated, got new user %s", userId, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func checkUserState(id string, state string) error {
+	sdkConfig, _ := provider.AuthorizeSdk()
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	user, resp, err := usersAPI.GetUser(id, nil, "", state)
+	if err != nil {
+		return util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to get user %s in state %s: %s", id, state, err), resp)
+	}
+	if user.State == nil || *user.State != state {
+		return fmt.Errorf("expected user %s to be %s", id, state)
+	}
+	return nil
+}
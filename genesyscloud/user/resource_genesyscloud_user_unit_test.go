@@ -0,0 +1,124 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// userLifecycleCalls records the calls made to the user proxy while destroying a user
+type userLifecycleCalls struct {
+	removedQueueIds []string
+	rolesRemoved    bool
+	stationsRemoved bool
+	patchedStates   []string
+	deleted         bool
+}
+
+func buildUserLifecycleProxy(t *testing.T, userId string, calls *userLifecycleCalls) *userProxy {
+	version := 3
+	proxy := &userProxy{}
+	proxy.getUserQueueIdsAttr = func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		return []string{"queue-1", "queue-2"}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.removeUserFromQueueAttr = func(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error) {
+		calls.removedQueueIds = append(calls.removedQueueIds, queueId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	proxy.removeUserRolesAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+		calls.rolesRemoved = true
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.removeUserStationsAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+		calls.stationsRemoved = true
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	proxy.getUserByIdAttr = func(ctx context.Context, p *userProxy, id string, expand []string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.User{Id: &id, Version: &version}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateUserAttr = func(ctx context.Context, p *userProxy, id string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, version, *updateUser.Version)
+		calls.patchedStates = append(calls.patchedStates, *updateUser.State)
+		return &platformclientv2.User{Id: &id}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.deleteUserAttr = func(ctx context.Context, p *userProxy, id string) (*interface{}, *platformclientv2.APIResponse, error) {
+		calls.deleted = true
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	return proxy
+}
+
+func TestUnitUserDestroyInactivate(t *testing.T) {
+	userId := uuid.NewString()
+	calls := &userLifecycleCalls{}
+	internalProxy = buildUserLifecycleProxy(t, userId, calls)
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":                "user@example.com",
+		"name":                 "User",
+		"lifecycle_on_destroy": "inactivate",
+		"inactivation_rules": []interface{}{map[string]interface{}{
+			"remove_queue_memberships": true,
+			"remove_roles":             true,
+		}},
+	})
+	d.SetId(userId)
+
+	diagErr := deleteUser(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diagErr.HasError(), diagErr)
+
+	assert.Equal(t, []string{"queue-1", "queue-2"}, calls.removedQueueIds)
+	assert.True(t, calls.rolesRemoved)
+	assert.False(t, calls.stationsRemoved)
+	assert.Equal(t, []string{"inactive"}, calls.patchedStates)
+	assert.False(t, calls.deleted)
+}
+
+func TestUnitUserDestroyKeep(t *testing.T) {
+	userId := uuid.NewString()
+	calls := &userLifecycleCalls{}
+	internalProxy = buildUserLifecycleProxy(t, userId, calls)
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":                "user@example.com",
+		"name":                 "User",
+		"lifecycle_on_destroy": "keep",
+		"inactivation_rules": []interface{}{map[string]interface{}{
+			"remove_roles": true,
+		}},
+	})
+	d.SetId(userId)
+
+	diagErr := deleteUser(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diagErr.HasError(), diagErr)
+
+	// The user is left untouched, the inactivation rules only apply when the user is inactivated
+	assert.Equal(t, userLifecycleCalls{}, *calls)
+}
+
+func TestUnitBuildInactivationRules(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+	})
+	assert.Equal(t, inactivationRules{}, buildInactivationRules(d))
+
+	d = schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+		"inactivation_rules": []interface{}{map[string]interface{}{
+			"remove_queue_memberships": true,
+			"remove_stations":          true,
+		}},
+	})
+	assert.Equal(t, inactivationRules{removeQueueMemberships: true, removeStations: true}, buildInactivationRules(d))
+}
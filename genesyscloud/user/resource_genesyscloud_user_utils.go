@@ -70,17 +70,21 @@ func buildSdkAddresses(d *schema.ResourceData) (*[]platformclientv2.Contact, dia
 }
 
 func executeUpdateUser(ctx context.Context, d *schema.ResourceData, proxy *userProxy, updateUser platformclientv2.Updateuser) diag.Diagnostics {
+	return executeUpdateUserById(ctx, d.Id(), proxy, updateUser)
+}
+
+func executeUpdateUserById(ctx context.Context, userId string, proxy *userProxy, updateUser platformclientv2.Updateuser) diag.Diagnostics {
 	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		currentUser, proxyResponse, errGet := proxy.getUserById(ctx, d.Id(), nil, "")
+		currentUser, proxyResponse, errGet := proxy.getUserById(ctx, userId, nil, "")
 		if errGet != nil {
-			return proxyResponse, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", d.Id(), errGet), proxyResponse)
+			return proxyResponse, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", userId, errGet), proxyResponse)
 		}
 
 		updateUser.Version = currentUser.Version
 
-		_, proxyPatchResponse, patchErr := proxy.updateUser(ctx, d.Id(), &updateUser)
+		_, proxyPatchResponse, patchErr := proxy.updateUser(ctx, userId, &updateUser)
 		if patchErr != nil {
-			return proxyPatchResponse, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Faild to update user %s | Error: %s.", d.Id(), patchErr), proxyPatchResponse)
+			return proxyPatchResponse, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Faild to update user %s | Error: %s.", userId, patchErr), proxyPatchResponse)
 		}
 		return proxyPatchResponse, nil
 	})
@@ -119,11 +123,29 @@ func executeAllUpdates(d *schema.ResourceData, proxy *userProxy, sdkConfig *plat
 }
 
 func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
-	transformFunc := func(configSkill interface{}) platformclientv2.Userroutingskillpost {
-		skillMap := configSkill.(map[string]interface{})
-		skillID := skillMap["skill_id"].(string)
-		skillProf := skillMap["proficiency"].(float64)
+	if d.HasChange("routing_skills") {
+		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
+			skillProfs := make(map[string]float64)
+			for _, configSkill := range skillsConfig.(*schema.Set).List() {
+				skillMap := configSkill.(map[string]interface{})
+				skillProfs[skillMap["skill_id"].(string)] = skillMap["proficiency"].(float64)
+			}
+			return updateUserRoutingSkills(d.Id(), skillProfs, proxy)
+		}
+	}
+	return nil
+}
 
+// updateUserRoutingSkills sets the routing skills of a user, mapping each skill ID to its proficiency
+func updateUserRoutingSkills(userID string, skillProfs map[string]float64, proxy *userProxy) diag.Diagnostics {
+	skillIds := make([]string, 0, len(skillProfs))
+	for skillID := range skillProfs {
+		skillIds = append(skillIds, skillID)
+	}
+	sort.Strings(skillIds)
+
+	transformFunc := func(skillID string) platformclientv2.Userroutingskillpost {
+		skillProf := skillProfs[skillID]
 		return platformclientv2.Userroutingskillpost{
 			Id:          &skillID,
 			Proficiency: &skillProf,
@@ -132,9 +154,9 @@ func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics
 
 	chunkProcessor := func(chunk []platformclientv2.Userroutingskillpost) diag.Diagnostics {
 		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-			_, resp, err := proxy.userApi.PatchUserRoutingskillsBulk(d.Id(), chunk)
+			_, resp, err := proxy.userApi.PatchUserRoutingskillsBulk(userID, chunk)
 			if err != nil {
-				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update skills for user %s error: %s", d.Id(), err), resp)
+				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update skills for user %s error: %s", userID, err), resp)
 			}
 			return nil, nil
 		})
@@ -144,14 +166,8 @@ func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics
 		return nil
 	}
 
-	if d.HasChange("routing_skills") {
-		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
-			skillsList := skillsConfig.(*schema.Set).List()
-			chunks := chunksProcess.ChunkItems(skillsList, transformFunc, 50)
-			return chunksProcess.ProcessChunks(chunks, chunkProcessor)
-		}
-	}
-	return nil
+	chunks := chunksProcess.ChunkItems(skillIds, transformFunc, 50)
+	return chunksProcess.ProcessChunks(chunks, chunkProcessor)
 }
 
 func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
@@ -159,59 +175,70 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 		if languages := d.Get("routing_languages"); languages != nil {
 			log.Printf("Updating languages for user %s", d.Get("email"))
 			newLangProfs := make(map[string]int)
-			langList := languages.(*schema.Set).List()
-			newLangIds := make([]string, len(langList))
-			for i, lang := range langList {
+			for _, lang := range languages.(*schema.Set).List() {
 				langMap := lang.(map[string]interface{})
-				newLangIds[i] = langMap["language_id"].(string)
-				newLangProfs[newLangIds[i]] = langMap["proficiency"].(int)
+				newLangProfs[langMap["language_id"].(string)] = langMap["proficiency"].(int)
 			}
-
-			oldSdkLangs, err := getUserRoutingLanguages(d.Id(), proxy)
-			if err != nil {
-				return err
+			if diagErr := setUserRoutingLanguages(d.Id(), newLangProfs, proxy); diagErr != nil {
+				return diagErr
 			}
+			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+	}
+	return nil
+}
 
-			oldLangIds := make([]string, len(oldSdkLangs))
-			oldLangProfs := make(map[string]int)
-			for i, lang := range oldSdkLangs {
-				oldLangIds[i] = *lang.Id
-				oldLangProfs[oldLangIds[i]] = int(*lang.Proficiency)
-			}
+// setUserRoutingLanguages sets the routing languages of a user, mapping each language ID to its proficiency. Languages
+// the user has that are missing from the map are removed.
+func setUserRoutingLanguages(userID string, newLangProfs map[string]int, proxy *userProxy) diag.Diagnostics {
+	newLangIds := make([]string, 0, len(newLangProfs))
+	for langID := range newLangProfs {
+		newLangIds = append(newLangIds, langID)
+	}
+	sort.Strings(newLangIds)
 
-			if len(oldLangIds) > 0 {
-				langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
-				for _, langID := range langsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						resp, err := proxy.userApi.DeleteUserRoutinglanguage(d.Id(), langID)
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove language from user %s error: %s", d.Id(), err), resp)
-						}
-						return nil, nil
-					})
-					if diagErr != nil {
-						return diagErr
-					}
+	oldSdkLangs, err := getUserRoutingLanguages(userID, proxy)
+	if err != nil {
+		return err
+	}
+
+	oldLangIds := make([]string, len(oldSdkLangs))
+	oldLangProfs := make(map[string]int)
+	for i, lang := range oldSdkLangs {
+		oldLangIds[i] = *lang.Id
+		oldLangProfs[oldLangIds[i]] = int(*lang.Proficiency)
+	}
+
+	if len(oldLangIds) > 0 {
+		langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
+		for _, langID := range langsToRemove {
+			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := proxy.userApi.DeleteUserRoutinglanguage(userID, langID)
+				if err != nil {
+					return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove language from user %s error: %s", userID, err), resp)
 				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
 			}
+		}
+	}
 
-			if len(newLangIds) > 0 {
-				// Languages to add
-				langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
+	if len(newLangIds) > 0 {
+		// Languages to add
+		langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
 
-				// Check for existing proficiencies to update which can be done with the same API
-				for langID, newNum := range newLangProfs {
-					if oldNum, found := oldLangProfs[langID]; found {
-						if newNum != oldNum {
-							langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
-						}
-					}
-				}
-				if diagErr := updateUserRoutingLanguages(d.Id(), langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
-					return diagErr
+		// Check for existing proficiencies to update which can be done with the same API
+		for langID, newNum := range newLangProfs {
+			if oldNum, found := oldLangProfs[langID]; found {
+				if newNum != oldNum {
+					langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
 				}
 			}
-			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+		if diagErr := updateUserRoutingLanguages(userID, langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
+			return diagErr
 		}
 	}
 	return nil
@@ -220,22 +247,22 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 func updateUserProfileSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("profile_skills") {
 		if profileSkills := d.Get("profile_skills"); profileSkills != nil {
-			profileSkills := lists.SetToStringList(profileSkills.(*schema.Set))
-			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-				_, resp, err := proxy.userApi.PutUserProfileskills(d.Id(), *profileSkills)
-				if err != nil {
-					return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update profile skills for user %s error: %s", d.Id(), err), resp)
-				}
-				return nil, nil
-			})
-			if diagErr != nil {
-				return diagErr
-			}
+			return setUserProfileSkills(d.Id(), *lists.SetToStringList(profileSkills.(*schema.Set)), proxy)
 		}
 	}
 	return nil
 }
 
+func setUserProfileSkills(userID string, profileSkills []string, proxy *userProxy) diag.Diagnostics {
+	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		_, resp, err := proxy.userApi.PutUserProfileskills(userID, profileSkills)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update profile skills for user %s error: %s", userID, err), resp)
+		}
+		return nil, nil
+	})
+}
+
 func updateUserRoutingUtilization(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("routing_utilization") {
 		if utilConfig := d.Get("routing_utilization").([]interface{}); utilConfig != nil {
@@ -340,6 +367,10 @@ func getUserRoutingLanguages(userID string, proxy *userProxy) ([]platformclientv
 }
 
 func getDeletedUserId(email string, proxy *userProxy) (*string, diag.Diagnostics) {
+	return getUserIdByState(email, "deleted", proxy)
+}
+
+func getUserIdByState(email string, state string, proxy *userProxy) (*string, diag.Diagnostics) {
 	exactType := "EXACT"
 	results, resp, getErr := proxy.userApi.PostUsersSearch(platformclientv2.Usersearchrequest{
 		Query: &[]platformclientv2.Usersearchcriteria{
@@ -350,7 +381,7 @@ func getDeletedUserId(email string, proxy *userProxy) (*string, diag.Diagnostics
 			},
 			{
 				Fields:  &[]string{"state"},
-				Values:  &[]string{"deleted"},
+				Values:  &[]string{state},
 				VarType: &exactType,
 			},
 		},
@@ -366,27 +397,93 @@ func getDeletedUserId(email string, proxy *userProxy) (*string, diag.Diagnostics
 }
 
 func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interface{}, proxy *userProxy) diag.Diagnostics {
-	email := d.Get("email").(string)
-	state := d.Get("state").(string)
+	return restoreUser(ctx, d, meta, proxy, "deleted")
+}
+
+// restoreUser moves a deleted or inactive user with the same email to the configured state and updates it to match the configuration
+func restoreUser(ctx context.Context, d *schema.ResourceData, meta interface{}, proxy *userProxy, currentState string) diag.Diagnostics {
+	if diagErr := restoreUserState(ctx, d.Id(), d.Get("email").(string), currentState, d.Get("state").(string), proxy); diagErr != nil {
+		return diagErr
+	}
+	return updateUser(ctx, d, meta)
+}
 
-	log.Printf("Restoring deleted user %s", email)
+// restoreUserState moves a deleted or inactive user to the given state
+func restoreUserState(ctx context.Context, userId string, email string, currentState string, state string, proxy *userProxy) diag.Diagnostics {
+	log.Printf("Restoring %s user %s", currentState, email)
 
 	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		currentUser, proxyResp, err := proxy.getUserById(ctx, d.Id(), nil, "deleted")
+		currentUser, proxyResp, err := proxy.getUserById(ctx, userId, nil, currentState)
 		if err != nil {
-			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", d.Id(), err), proxyResp)
+			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read user %s error: %s", userId, err), proxyResp)
 		}
 
-		_, proxyPatchResponse, patchErr := proxy.patchUserWithState(ctx, d.Id(), &platformclientv2.Updateuser{
+		_, proxyPatchResponse, patchErr := proxy.patchUserWithState(ctx, userId, &platformclientv2.Updateuser{
 			State:   &state,
 			Version: currentUser.Version,
 		})
 
 		if patchErr != nil {
-			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Faild to restored deleted user %s | Error: %s.", email, patchErr), proxyPatchResponse)
+			return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Faild to restore %s user %s | Error: %s.", currentState, email, patchErr), proxyPatchResponse)
 		}
+		return nil, nil
+	})
+}
+
+// inactivationRules are the cleanup steps applied when the provider sets a user inactive
+type inactivationRules struct {
+	removeQueueMemberships bool
+	removeRoles            bool
+	removeStations         bool
+}
+
+func buildInactivationRules(d *schema.ResourceData) inactivationRules {
+	var rules inactivationRules
+	if rulesList, ok := d.Get("inactivation_rules").([]interface{}); ok && len(rulesList) > 0 && rulesList[0] != nil {
+		rulesMap := rulesList[0].(map[string]interface{})
+		rules.removeQueueMemberships = rulesMap["remove_queue_memberships"].(bool)
+		rules.removeRoles = rulesMap["remove_roles"].(bool)
+		rules.removeStations = rulesMap["remove_stations"].(bool)
+	}
+	return rules
+}
+
+// inactivateUser applies the inactivation rules of the user and then sets it inactive, releasing its license
+func inactivateUser(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+	rules := buildInactivationRules(d)
+	email := d.Get("email").(string)
+
+	if rules.removeQueueMemberships {
+		queueIds, resp, err := proxy.getUserQueueIds(ctx, d.Id())
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get queues of user %s error: %s", d.Id(), err), resp)
+		}
+		log.Printf("Removing user %s from %d queues", email, len(queueIds))
+		for _, queueId := range queueIds {
+			resp, err := proxy.removeUserFromQueue(ctx, d.Id(), queueId)
+			if err != nil && !util.IsStatus404(resp) {
+				return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove user %s from queue %s error: %s", d.Id(), queueId, err), resp)
+			}
+		}
+	}
+
+	if rules.removeRoles {
+		log.Printf("Removing roles of user %s", email)
+		if resp, err := proxy.removeUserRoles(ctx, d.Id()); err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove roles of user %s error: %s", d.Id(), err), resp)
+		}
+	}
+
+	if rules.removeStations {
+		log.Printf("Removing stations of user %s", email)
+		if resp, err := proxy.removeUserStations(ctx, d.Id()); err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove stations of user %s error: %s", d.Id(), err), resp)
+		}
+	}
 
-		return nil, updateUser(ctx, d, meta)
+	log.Printf("Setting user %s inactive", email)
+	return executeUpdateUser(ctx, d, proxy, platformclientv2.Updateuser{
+		State: platformclientv2.String("inactive"),
 	})
 }
 
@@ -0,0 +1,345 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_users_bulk.go file provisions the users of a CSV or JSON source file. Each user is created,
+updated or restored with the same API calls as the genesyscloud_user resource, with a bounded number of users in flight.
+*/
+
+func createUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	return applyUsersBulk(ctx, d, meta)
+}
+
+func updateUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applyUsersBulk(ctx, d, meta)
+}
+
+func readUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUserProxy(sdkConfig)
+
+	log.Printf("Reading users bulk %s", d.Id())
+	existingIds, diagErr := getBulkExistingUserIds(ctx, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+	userIds := make(map[string]bool)
+	for _, id := range existingIds {
+		userIds[id] = true
+	}
+
+	// Users deleted outside of Terraform are flagged so that the next apply provisions them again
+	outcomes := buildBulkUserOutcomes(d.Get("users").([]interface{}))
+	for email, outcome := range outcomes {
+		if outcome.FlagMissing(userIds) {
+			log.Printf("User %s %s of users bulk %s no longer exists", outcome.Email, outcome.Id, d.Id())
+			outcomes[email] = outcome
+		}
+	}
+	_ = d.Set("users", flattenBulkUserOutcomes(outcomes))
+
+	log.Printf("Read users bulk %s", d.Id())
+	return nil
+}
+
+func deleteUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUserProxy(sdkConfig)
+	destroyAction := d.Get("destroy_action").(string)
+
+	var outcomes []bulkUserOutcome
+	for _, outcome := range buildBulkUserOutcomes(d.Get("users").([]interface{})) {
+		if outcome.Id != "" && outcome.Outcome != bulk.OutcomeMissing {
+			outcomes = append(outcomes, outcome)
+		}
+	}
+
+	log.Printf("Destroying users bulk %s, %s %d users", d.Id(), destroyAction, len(outcomes))
+
+	var (
+		diags      diag.Diagnostics
+		diagsMutex sync.Mutex
+	)
+	bulk.ForEachConcurrently(outcomes, d.Get("max_concurrency").(int), func(outcome bulkUserOutcome) {
+		var diagErr diag.Diagnostics
+		switch destroyAction {
+		case "deactivate":
+			if outcome.Outcome != bulkOutcomeDeactivated {
+				diagErr = deactivateBulkUser(ctx, proxy, outcome.Id)
+			}
+		case "delete":
+			userData := ResourceUser().Data(nil)
+			userData.SetId(outcome.Id)
+			_ = userData.Set("email", outcome.Email)
+			diagErr = deleteUser(ctx, userData, meta)
+		}
+		if diagErr != nil {
+			diagsMutex.Lock()
+			diags = append(diags, diagErr...)
+			diagsMutex.Unlock()
+		}
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Destroyed users bulk %s", d.Id())
+	return nil
+}
+
+// customizeUsersBulkDiff plans an update when the source file changed or when users failed or went missing, so that
+// they are provisioned again even though the configuration did not change
+func customizeUsersBulkDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return bulk.CustomizeDiff(diff, "users", "filepath", "file_content_hash", "deactivate_missing")
+}
+
+// applyUsersBulk provisions every user of the source file and deactivates the users removed from it when requested.
+// A user that fails to provision does not stop the others, its error is recorded in the users attribute instead.
+func applyUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUserProxy(sdkConfig)
+	path := d.Get("filepath").(string)
+	concurrency := d.Get("max_concurrency").(int)
+
+	records, err := readBulkUserRecords(path)
+	if err != nil {
+		return util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Invalid source file %s", path), err)
+	}
+	levels, err := orderBulkUserRecords(records)
+	if err != nil {
+		return util.BuildDiagnosticError(bulkResourceName, fmt.Sprintf("Invalid managers in source file %s", path), err)
+	}
+
+	existingIds, diagErr := getBulkExistingUserIds(ctx, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Provisioning %d users from %s", len(records), path)
+
+	previous := buildBulkUserOutcomes(d.Get("users").([]interface{}))
+	outcomes := make(map[string]bulkUserOutcome)
+	var outcomesMutex sync.Mutex
+
+	// Each level only starts once the managers it references have been provisioned
+	for _, level := range levels {
+		bulk.ForEachConcurrently(level, concurrency, func(record bulkUserRecord) {
+			email := strings.ToLower(record.Email)
+			outcomesMutex.Lock()
+			managerId, managerErr := resolveBulkUserManager(record, outcomes, existingIds)
+			outcomesMutex.Unlock()
+
+			var outcome bulkUserOutcome
+			if managerErr != nil {
+				outcome = bulkUserOutcome{Email: record.Email, Result: bulk.Result{Id: existingIds[email], Outcome: bulk.OutcomeFailed, Error: managerErr.Error()}}
+			} else {
+				outcome = provisionBulkUser(ctx, proxy, record, managerId, existingIds[email], previous[email])
+			}
+
+			outcomesMutex.Lock()
+			outcomes[email] = outcome
+			outcomesMutex.Unlock()
+		})
+	}
+
+	// Users removed from the source file are deactivated, or simply no longer managed
+	var removed []bulkUserOutcome
+	for email, outcome := range previous {
+		if _, inFile := outcomes[email]; inFile || outcome.Id == "" || !d.Get("deactivate_missing").(bool) {
+			continue
+		}
+		if outcome.Outcome == bulkOutcomeDeactivated {
+			outcomes[email] = outcome
+			continue
+		}
+		if existingIds[email] == outcome.Id {
+			removed = append(removed, outcome)
+		}
+	}
+	bulk.ForEachConcurrently(removed, concurrency, func(outcome bulkUserOutcome) {
+		outcome.Outcome = bulkOutcomeDeactivated
+		outcome.Error = ""
+		outcome.RecordHash = ""
+		if diagErr := deactivateBulkUser(ctx, proxy, outcome.Id); diagErr != nil {
+			outcome.Fail(diagErr)
+		}
+		outcomesMutex.Lock()
+		outcomes[strings.ToLower(outcome.Email)] = outcome
+		outcomesMutex.Unlock()
+	})
+
+	_ = d.Set("users", flattenBulkUserOutcomes(outcomes))
+
+	var failed []string
+	for _, outcome := range outcomes {
+		if outcome.Outcome == bulk.OutcomeFailed {
+			failed = append(failed, outcome.Email)
+		}
+	}
+	if len(failed) > 0 {
+		return bulk.FailureWarning(bulkResourceName, d.Id(), "users", failed, len(outcomes))
+	}
+
+	log.Printf("Provisioned %d users from %s", len(records), path)
+	return nil
+}
+
+// provisionBulkUser creates, restores or updates a single user, skipping users that have not changed since the last apply
+func provisionBulkUser(ctx context.Context, proxy *userProxy, record bulkUserRecord, managerId string, existingId string, previous bulkUserOutcome) bulkUserOutcome {
+	outcome := bulkUserOutcome{Email: record.Email, Result: bulk.Result{Id: existingId, RecordHash: bulk.RecordHash(record)}}
+
+	if previous.IsUnchanged(existingId, outcome.RecordHash) {
+		outcome.Outcome = bulk.OutcomeUnchanged
+		return outcome
+	}
+
+	var diagErr diag.Diagnostics
+	switch {
+	case existingId != "":
+		outcome.Outcome = bulk.OutcomeUpdated
+		diagErr = updateBulkUser(ctx, proxy, existingId, record, managerId)
+	default:
+		// Restore a deleted user with the same email rather than failing on the conflict
+		var deletedId *string
+		deletedId, diagErr = getDeletedUserId(record.Email, proxy)
+		if diagErr != nil {
+			break
+		}
+		if deletedId != nil {
+			outcome.Id = *deletedId
+			outcome.Outcome = bulkOutcomeRestored
+			diagErr = restoreUserState(ctx, *deletedId, record.Email, "deleted", record.state(), proxy)
+			if diagErr == nil {
+				diagErr = updateBulkUserAttributes(ctx, proxy, *deletedId, record, managerId, true)
+			}
+		} else {
+			outcome.Outcome = bulk.OutcomeCreated
+			outcome.Id, diagErr = createBulkUser(ctx, proxy, record, managerId)
+		}
+	}
+
+	if diagErr.HasError() {
+		log.Printf("Failed to provision user %s: %s", record.Email, bulk.DiagnosticsMessage(diagErr))
+		outcome.Fail(diagErr)
+	}
+	return outcome
+}
+
+// createBulkUser creates the user of a record and returns its ID, which is set even if the user failed to update
+func createBulkUser(ctx context.Context, proxy *userProxy, record bulkUserRecord, managerId string) (string, diag.Diagnostics) {
+	log.Printf("Creating user %s", record.Email)
+	createUser := buildBulkCreateUser(record)
+	user, resp, err := proxy.createUser(ctx, &createUser)
+	if err != nil {
+		return "", util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to create user %s error: %s", record.Email, err), resp)
+	}
+	return *user.Id, updateBulkUserAttributes(ctx, proxy, *user.Id, record, managerId, false)
+}
+
+// updateBulkUser updates an existing user to match its record. Like the genesyscloud_user resource, a user being
+// deactivated is updated first and deactivated last.
+func updateBulkUser(ctx context.Context, proxy *userProxy, userId string, record bulkUserRecord, managerId string) diag.Diagnostics {
+	log.Printf("Updating user %s", record.Email)
+	state := record.state()
+	if state == "active" {
+		if diagErr := executeUpdateUserById(ctx, userId, proxy, platformclientv2.Updateuser{State: &state}); diagErr != nil {
+			return diagErr
+		}
+	}
+	if diagErr := updateBulkUserAttributes(ctx, proxy, userId, record, managerId, true); diagErr != nil {
+		return diagErr
+	}
+	if state == "inactive" {
+		return executeUpdateUserById(ctx, userId, proxy, platformclientv2.Updateuser{State: &state})
+	}
+	return nil
+}
+
+// updateBulkUserAttributes sets every attribute of a record other than the state. The skills, languages and profile
+// skills are only set when the record includes them, and the division is only moved if requested.
+func updateBulkUserAttributes(ctx context.Context, proxy *userProxy, userId string, record bulkUserRecord, managerId string, moveDivision bool) diag.Diagnostics {
+	if moveDivision && record.DivisionId != "" {
+		if resp, err := proxy.updateUserDivision(ctx, userId, record.DivisionId); err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update division for user %s error: %s", userId, err), resp)
+		}
+	}
+	if diagErr := executeUpdateUserById(ctx, userId, proxy, buildBulkUpdateUser(record, managerId)); diagErr != nil {
+		return diagErr
+	}
+	if record.RoutingSkills != nil {
+		if diagErr := updateUserRoutingSkills(userId, record.RoutingSkills, proxy); diagErr != nil {
+			return diagErr
+		}
+	}
+	if record.RoutingLanguages != nil {
+		if diagErr := setUserRoutingLanguages(userId, record.RoutingLanguages, proxy); diagErr != nil {
+			return diagErr
+		}
+	}
+	if record.ProfileSkills != nil {
+		if diagErr := setUserProfileSkills(userId, record.ProfileSkills, proxy); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+// resolveBulkUserManager returns the user ID of the manager of a record, looking up managers identified by email among
+// the users already provisioned and the existing users of the org
+func resolveBulkUserManager(record bulkUserRecord, outcomes map[string]bulkUserOutcome, existingIds map[string]string) (string, error) {
+	managerEmail := record.managerEmail()
+	if managerEmail == "" {
+		return record.Manager, nil
+	}
+	if outcome, ok := outcomes[managerEmail]; ok {
+		if outcome.Outcome == bulk.OutcomeFailed || outcome.Id == "" {
+			return "", fmt.Errorf("manager %s failed to provision", record.Manager)
+		}
+		return outcome.Id, nil
+	}
+	if id, ok := existingIds[managerEmail]; ok {
+		return id, nil
+	}
+	return "", fmt.Errorf("manager %s not found", record.Manager)
+}
+
+// getBulkExistingUserIds maps the lower case email of every active and inactive user to its ID. The users are not
+// cached, as the cached versions would be stale once the users are updated.
+func getBulkExistingUserIds(ctx context.Context, proxy *userProxy) (map[string]string, diag.Diagnostics) {
+	existingIds := make(map[string]string)
+	for _, state := range []string{"active", "inactive"} {
+		users, resp, err := proxy.getUsersByState(ctx, state)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(bulkResourceName, fmt.Sprintf("Failed to get %s users error: %s", state, err), resp)
+		}
+		for _, user := range *users {
+			if user.Id != nil && user.Email != nil {
+				existingIds[strings.ToLower(*user.Email)] = *user.Id
+			}
+		}
+	}
+	return existingIds, nil
+}
+
+func deactivateBulkUser(ctx context.Context, proxy *userProxy, userId string) diag.Diagnostics {
+	log.Printf("Deactivating user %s", userId)
+	return executeUpdateUserById(ctx, userId, proxy, platformclientv2.Updateuser{
+		State: platformclientv2.String("inactive"),
+	})
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUsersBulk(t *testing.T) {
+	t.Parallel()
+	var (
+		bulkResource = "test-users-bulk"
+		fullName     = bulkResourceName + "." + bulkResource
+		managerEmail = "terraform-" + uuid.NewString() + "@user.com"
+		agentEmail   = "terraform-" + uuid.NewString() + "@user.com"
+		sourceFile   = filepath.Join(t.TempDir(), "users.csv")
+	)
+
+	writeSource := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(sourceFile, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	header := "email,name,title,manager,profile_skills\n"
+	manager := fmt.Sprintf("%s,Manager Terraform,Manager,,Go\n", managerEmail)
+	agent := fmt.Sprintf("%s,Agent Terraform,Agent,%s,Java;Go\n", agentEmail, managerEmail)
+	writeSource(header + manager + agent)()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create both users, the manager before the agent reporting to them
+				Config: GenerateUsersBulkResource(bulkResource, sourceFile, true, "delete"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "users.*", map[string]string{"email": managerEmail, "outcome": bulk.OutcomeCreated}),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "users.*", map[string]string{"email": agentEmail, "outcome": bulk.OutcomeCreated}),
+				),
+			},
+			{
+				// Update the agent only
+				PreConfig: writeSource(header + manager + fmt.Sprintf("%s,Agent Terraform,Senior Agent,%s,Java;Go\n", agentEmail, managerEmail)),
+				Config:    GenerateUsersBulkResource(bulkResource, sourceFile, true, "delete"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "users.*", map[string]string{"email": managerEmail, "outcome": bulk.OutcomeUnchanged}),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "users.*", map[string]string{"email": agentEmail, "outcome": bulk.OutcomeUpdated}),
+				),
+			},
+			{
+				// Remove the agent from the file
+				PreConfig: writeSource(header + manager),
+				Config:    GenerateUsersBulkResource(bulkResource, sourceFile, true, "delete"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullName, "users.*", map[string]string{"email": agentEmail, "outcome": bulkOutcomeDeactivated}),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
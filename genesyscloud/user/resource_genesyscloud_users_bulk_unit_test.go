@@ -0,0 +1,230 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseBulkUserCsv(t *testing.T) {
+	content := `email,name,department,manager,acd_auto_answer,profile_skills,routing_skills,routing_languages
+jane@example.com,Jane Doe,Support,,true,Billing;Refunds,skill-1:4.5;skill-2:1,lang-1:3
+john@example.com,John Doe,,jane@example.com,,,,
+`
+	records, err := parseBulkUserCsv(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, bulkUserRecord{
+		Email:            "jane@example.com",
+		Name:             "Jane Doe",
+		Department:       "Support",
+		AcdAutoAnswer:    true,
+		ProfileSkills:    []string{"Billing", "Refunds"},
+		RoutingSkills:    map[string]float64{"skill-1": 4.5, "skill-2": 1},
+		RoutingLanguages: map[string]int{"lang-1": 3},
+	}, records[0])
+
+	// Empty list columns leave the attribute unmanaged
+	assert.Nil(t, records[1].RoutingSkills)
+	assert.Nil(t, records[1].ProfileSkills)
+	assert.Equal(t, "jane@example.com", records[1].managerEmail())
+
+	_, err = parseBulkUserCsv(strings.NewReader("email,name,phone\n"))
+	assert.EqualError(t, err, "unknown column phone")
+
+	_, err = parseBulkUserCsv(strings.NewReader("email,name,routing_skills\njane@example.com,Jane,skill-1\n"))
+	assert.EqualError(t, err, "row 2: routing_skills: skill-1 must be written as id:proficiency")
+
+	_, err = parseBulkUserCsv(strings.NewReader("email,name,routing_languages\njane@example.com,Jane,lang-1:2.5\n"))
+	assert.EqualError(t, err, "row 2: routing_languages: proficiency of lang-1 must be a whole number")
+}
+
+func TestUnitParseBulkUserJson(t *testing.T) {
+	content := `[
+		{"email": "jane@example.com", "name": "Jane Doe", "routing_skills": {"skill-1": 3}},
+		{"email": "john@example.com", "name": "John Doe", "state": "inactive", "certifications": ["CCNA"]}
+	]`
+	records, err := parseBulkUserJson(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"skill-1": 3}, records[0].RoutingSkills)
+	assert.Equal(t, "inactive", records[1].State)
+	assert.Equal(t, []string{"CCNA"}, records[1].Certifications)
+
+	_, err = parseBulkUserJson(strings.NewReader(`[{"email": "jane@example.com", "phone": "555"}]`))
+	assert.Error(t, err)
+}
+
+func TestUnitValidateBulkUserRecords(t *testing.T) {
+	err := validateBulkUserRecords([]bulkUserRecord{
+		{Email: "jane@example.com", Name: "Jane"},
+		{Email: "JANE@example.com", Name: "Jane Again"},
+		{Name: "No Email"},
+		{Email: "john@example.com", State: "deleted", Manager: "john@example.com"},
+		{Email: "ann@example.com", Name: "Ann", RoutingSkills: map[string]float64{"skill-1": 6}},
+	})
+	assert.EqualError(t, err, strings.Join([]string{
+		"user JANE@example.com appears more than once",
+		"user 3 has no email",
+		"user john@example.com has no name",
+		"user john@example.com has state deleted, expected active or inactive",
+		"user john@example.com is their own manager",
+		"user ann@example.com has routing skill skill-1 with proficiency 6, expected 0 to 5",
+	}, "\n"))
+}
+
+func TestUnitOrderBulkUserRecords(t *testing.T) {
+	levels, err := orderBulkUserRecords([]bulkUserRecord{
+		{Email: "agent@example.com", Manager: "Lead@example.com"},
+		{Email: "lead@example.com", Manager: "director@example.com"},
+		{Email: "director@example.com", Manager: "ceo@example.com"},
+		{Email: "other@example.com", Manager: "8e7d1a5c-manager-id"},
+	})
+	assert.NoError(t, err)
+
+	var emails [][]string
+	for _, level := range levels {
+		var levelEmails []string
+		for _, record := range level {
+			levelEmails = append(levelEmails, record.Email)
+		}
+		emails = append(emails, levelEmails)
+	}
+	// Managers outside the file do not delay their reports
+	assert.Equal(t, [][]string{
+		{"director@example.com", "other@example.com"},
+		{"lead@example.com"},
+		{"agent@example.com"},
+	}, emails)
+
+	_, err = orderBulkUserRecords([]bulkUserRecord{
+		{Email: "a@example.com", Manager: "b@example.com"},
+		{Email: "b@example.com", Manager: "a@example.com"},
+	})
+	assert.EqualError(t, err, "user a@example.com is part of a manager cycle")
+}
+
+func TestUnitResolveBulkUserManager(t *testing.T) {
+	outcomes := map[string]bulkUserOutcome{
+		"lead@example.com":   {Email: "lead@example.com", Result: bulk.Result{Id: "lead-id", Outcome: bulk.OutcomeCreated}},
+		"failed@example.com": {Email: "failed@example.com", Result: bulk.Result{Outcome: bulk.OutcomeFailed}},
+	}
+	existingIds := map[string]string{"director@example.com": "director-id"}
+
+	resolve := func(manager string) (string, error) {
+		return resolveBulkUserManager(bulkUserRecord{Email: "agent@example.com", Manager: manager}, outcomes, existingIds)
+	}
+
+	id, err := resolve("manager-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "manager-id", id)
+
+	id, err = resolve("Lead@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "lead-id", id)
+
+	id, err = resolve("director@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "director-id", id)
+
+	_, err = resolve("failed@example.com")
+	assert.EqualError(t, err, "manager failed@example.com failed to provision")
+
+	_, err = resolve("nobody@example.com")
+	assert.EqualError(t, err, "manager nobody@example.com not found")
+}
+
+func TestUnitBuildBulkUserRequests(t *testing.T) {
+	record := bulkUserRecord{
+		Email:          "jane@example.com",
+		Name:           "Jane Doe",
+		Title:          "Agent",
+		DivisionId:     "division-id",
+		AcdAutoAnswer:  true,
+		Certifications: []string{"Billing"},
+	}
+
+	createUser := buildBulkCreateUser(record)
+	assert.Equal(t, "active", *createUser.State)
+	assert.Equal(t, "jane@example.com", *createUser.Email)
+	assert.Equal(t, "Agent", *createUser.Title)
+	assert.Equal(t, "division-id", *createUser.DivisionId)
+
+	updateUser := buildBulkUpdateUser(record, "manager-id")
+	assert.Nil(t, updateUser.State)
+	assert.Equal(t, "manager-id", *updateUser.Manager)
+	assert.True(t, *updateUser.AcdAutoAnswer)
+	assert.Equal(t, []string{"Billing"}, *updateUser.Certifications)
+
+	// Attributes missing from the record are left unmanaged
+	record.DivisionId = ""
+	record.Certifications = nil
+	assert.Nil(t, buildBulkCreateUser(record).DivisionId)
+	assert.Nil(t, buildBulkUpdateUser(record, "").Certifications)
+}
+
+func TestUnitProvisionBulkUserUpdated(t *testing.T) {
+	version := 2
+	var updates []platformclientv2.Updateuser
+	proxy := &userProxy{}
+	proxy.getUserByIdAttr = func(ctx context.Context, p *userProxy, id string, expand []string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "user-id", id)
+		return &platformclientv2.User{Id: &id, Version: &version}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateUserAttr = func(ctx context.Context, p *userProxy, id string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, version, *updateUser.Version)
+		updates = append(updates, *updateUser)
+		return &platformclientv2.User{Id: &id}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	// A user being deactivated is updated first and deactivated last
+	record := bulkUserRecord{Email: "jane@example.com", Name: "Jane Doe", State: "inactive"}
+	outcome := provisionBulkUser(context.Background(), proxy, record, "manager-id", "user-id", bulkUserOutcome{})
+	assert.Equal(t, bulk.OutcomeUpdated, outcome.Outcome, outcome.Error)
+	assert.Equal(t, "user-id", outcome.Id)
+	assert.Equal(t, bulk.RecordHash(record), outcome.RecordHash)
+	if assert.Len(t, updates, 2) {
+		assert.Nil(t, updates[0].State)
+		assert.Equal(t, "manager-id", *updates[0].Manager)
+		assert.Equal(t, "inactive", *updates[1].State)
+	}
+
+	// An active user is activated first, in case it was deactivated outside of the source file
+	updates = nil
+	record.State = ""
+	outcome = provisionBulkUser(context.Background(), proxy, record, "", "user-id", bulkUserOutcome{})
+	assert.Equal(t, bulk.OutcomeUpdated, outcome.Outcome, outcome.Error)
+	if assert.Len(t, updates, 2) {
+		assert.Equal(t, "active", *updates[0].State)
+		assert.Equal(t, "Jane Doe", *updates[1].Name)
+	}
+}
+
+func TestUnitProvisionBulkUserUnchanged(t *testing.T) {
+	record := bulkUserRecord{Email: "jane@example.com", Name: "Jane Doe"}
+	previous := bulkUserOutcome{Email: record.Email, Result: bulk.Result{Id: "user-id", Outcome: bulk.OutcomeCreated, RecordHash: bulk.RecordHash(record)}}
+
+	// No API calls are made for a user whose record has not changed, so no proxy is needed
+	outcome := provisionBulkUser(context.Background(), nil, record, "", "user-id", previous)
+	assert.Equal(t, bulk.OutcomeUnchanged, outcome.Outcome)
+	assert.Equal(t, "user-id", outcome.Id)
+	assert.Equal(t, previous.RecordHash, outcome.RecordHash)
+
+	record.Title = "Agent"
+	assert.NotEqual(t, previous.RecordHash, bulk.RecordHash(record))
+}
+
+func TestUnitBulkUserOutcomesRoundTrip(t *testing.T) {
+	outcomes := map[string]bulkUserOutcome{
+		"john@example.com": {Email: "john@example.com", Result: bulk.Result{Outcome: bulk.OutcomeFailed, Error: "manager x@example.com not found"}},
+		"jane@example.com": {Email: "Jane@example.com", Result: bulk.Result{Id: "user-id", Outcome: bulk.OutcomeCreated, RecordHash: "hash"}},
+	}
+	users := flattenBulkUserOutcomes(outcomes)
+	assert.Equal(t, "Jane@example.com", users[0].(map[string]interface{})["email"])
+	assert.Equal(t, outcomes, buildBulkUserOutcomes(users))
+}
@@ -0,0 +1,383 @@
+package user
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_users_bulk_utils.go file reads the users of a genesyscloud_users_bulk source file and plans
+the order in which they are provisioned.
+*/
+
+const (
+	// Outcomes of users in addition to those shared by the bulk resources
+	bulkOutcomeRestored    = "restored"
+	bulkOutcomeDeactivated = "deactivated"
+
+	// Separates the ID and proficiency of skills and languages in a CSV source file
+	bulkProficiencySeparator = ":"
+)
+
+// bulkUserRecord is a single user of a genesyscloud_users_bulk source file. Skills and languages map an ID to a
+// proficiency, a nil map or slice leaves the attribute unmanaged.
+type bulkUserRecord struct {
+	Email            string             `json:"email"`
+	Name             string             `json:"name"`
+	State            string             `json:"state,omitempty"`
+	DivisionId       string             `json:"division_id,omitempty"`
+	Department       string             `json:"department,omitempty"`
+	Title            string             `json:"title,omitempty"`
+	Manager          string             `json:"manager,omitempty"`
+	AcdAutoAnswer    bool               `json:"acd_auto_answer,omitempty"`
+	ProfileSkills    []string           `json:"profile_skills,omitempty"`
+	Certifications   []string           `json:"certifications,omitempty"`
+	RoutingSkills    map[string]float64 `json:"routing_skills,omitempty"`
+	RoutingLanguages map[string]int     `json:"routing_languages,omitempty"`
+}
+
+// bulkUserOutcome is the result of provisioning a user, stored in the users attribute. The ID of the result is the
+// user ID.
+type bulkUserOutcome struct {
+	Email string
+	bulk.Result
+}
+
+// managerEmail returns the email of the manager when the manager is identified by email rather than user ID
+func (r bulkUserRecord) managerEmail() string {
+	if strings.Contains(r.Manager, "@") {
+		return strings.ToLower(r.Manager)
+	}
+	return ""
+}
+
+// readBulkUserRecords reads the users of a CSV or JSON source file, depending on its extension
+func readBulkUserRecords(path string) ([]bulkUserRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open source file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	var records []bulkUserRecord
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		records, err = parseBulkUserCsv(file)
+	case ".json":
+		records, err = parseBulkUserJson(file)
+	default:
+		return nil, fmt.Errorf("source file %s must have a .csv or .json extension", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse source file %s: %v", path, err)
+	}
+	return records, validateBulkUserRecords(records)
+}
+
+func parseBulkUserJson(reader io.Reader) ([]bulkUserRecord, error) {
+	var records []bulkUserRecord
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// parseBulkUserCsv reads a CSV file with a header row naming the columns after the JSON fields of a record. List
+// columns are separated by semicolons and skills and languages are written as id:proficiency.
+func parseBulkUserCsv(reader io.Reader) ([]bulkUserRecord, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	rows, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("missing header row")
+	}
+
+	header := rows[0]
+	for _, column := range header {
+		if !isBulkUserColumn(column) {
+			return nil, fmt.Errorf("unknown column %s", column)
+		}
+	}
+
+	var records []bulkUserRecord
+	for i, row := range rows[1:] {
+		var record bulkUserRecord
+		for j, column := range header {
+			if err := setBulkUserColumn(&record, column, strings.TrimSpace(row[j])); err != nil {
+				return nil, fmt.Errorf("row %d: %v", i+2, err)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func isBulkUserColumn(column string) bool {
+	return setBulkUserColumn(&bulkUserRecord{}, column, "") == nil
+}
+
+func setBulkUserColumn(record *bulkUserRecord, column string, value string) error {
+	switch column {
+	case "email":
+		record.Email = value
+	case "name":
+		record.Name = value
+	case "state":
+		record.State = value
+	case "division_id":
+		record.DivisionId = value
+	case "department":
+		record.Department = value
+	case "title":
+		record.Title = value
+	case "manager":
+		record.Manager = value
+	case "acd_auto_answer":
+		if value == "" {
+			return nil
+		}
+		autoAnswer, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("acd_auto_answer %s is not a boolean", value)
+		}
+		record.AcdAutoAnswer = autoAnswer
+	case "profile_skills":
+		record.ProfileSkills = bulk.SplitList(value)
+	case "certifications":
+		record.Certifications = bulk.SplitList(value)
+	case "routing_skills":
+		if value == "" {
+			return nil
+		}
+		record.RoutingSkills = make(map[string]float64)
+		for _, item := range bulk.SplitList(value) {
+			id, proficiency, err := splitBulkProficiency(item)
+			if err != nil {
+				return fmt.Errorf("routing_skills: %v", err)
+			}
+			record.RoutingSkills[id] = proficiency
+		}
+	case "routing_languages":
+		if value == "" {
+			return nil
+		}
+		record.RoutingLanguages = make(map[string]int)
+		for _, item := range bulk.SplitList(value) {
+			id, proficiency, err := splitBulkProficiency(item)
+			if err != nil {
+				return fmt.Errorf("routing_languages: %v", err)
+			}
+			if proficiency != float64(int(proficiency)) {
+				return fmt.Errorf("routing_languages: proficiency of %s must be a whole number", id)
+			}
+			record.RoutingLanguages[id] = int(proficiency)
+		}
+	default:
+		return fmt.Errorf("unknown column %s", column)
+	}
+	return nil
+}
+
+func splitBulkProficiency(item string) (string, float64, error) {
+	id, value, found := strings.Cut(item, bulkProficiencySeparator)
+	if !found {
+		return "", 0, fmt.Errorf("%s must be written as id%sproficiency", item, bulkProficiencySeparator)
+	}
+	proficiency, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("proficiency of %s is not a number", id)
+	}
+	return strings.TrimSpace(id), proficiency, nil
+}
+
+// validateBulkUserRecords checks the records for the mistakes that would otherwise fail every user they affect
+func validateBulkUserRecords(records []bulkUserRecord) error {
+	var errs []error
+	emails := make(map[string]bool)
+	for i, record := range records {
+		if record.Email == "" {
+			errs = append(errs, fmt.Errorf("user %d has no email", i+1))
+			continue
+		}
+		email := strings.ToLower(record.Email)
+		if emails[email] {
+			errs = append(errs, fmt.Errorf("user %s appears more than once", record.Email))
+		}
+		emails[email] = true
+
+		if record.Name == "" {
+			errs = append(errs, fmt.Errorf("user %s has no name", record.Email))
+		}
+		if record.State != "" && record.State != "active" && record.State != "inactive" {
+			errs = append(errs, fmt.Errorf("user %s has state %s, expected active or inactive", record.Email, record.State))
+		}
+		if strings.EqualFold(record.Manager, record.Email) {
+			errs = append(errs, fmt.Errorf("user %s is their own manager", record.Email))
+		}
+		for id, proficiency := range record.RoutingSkills {
+			if proficiency < 0 || proficiency > 5 {
+				errs = append(errs, fmt.Errorf("user %s has routing skill %s with proficiency %v, expected 0 to 5", record.Email, id, proficiency))
+			}
+		}
+		for id, proficiency := range record.RoutingLanguages {
+			if proficiency < 0 || proficiency > 5 {
+				errs = append(errs, fmt.Errorf("user %s has routing language %s with proficiency %d, expected 0 to 5", record.Email, id, proficiency))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// orderBulkUserRecords groups the records into levels so that a manager identified by email is always provisioned in
+// an earlier level than the users reporting to them. Users in the same level can be provisioned concurrently.
+func orderBulkUserRecords(records []bulkUserRecord) ([][]bulkUserRecord, error) {
+	byEmail := make(map[string]bulkUserRecord)
+	for _, record := range records {
+		byEmail[strings.ToLower(record.Email)] = record
+	}
+
+	levels := make(map[string]int)
+	var levelOf func(email string, path map[string]bool) (int, error)
+	levelOf = func(email string, path map[string]bool) (int, error) {
+		if level, ok := levels[email]; ok {
+			return level, nil
+		}
+		if path[email] {
+			return 0, fmt.Errorf("user %s is part of a manager cycle", byEmail[email].Email)
+		}
+		level := 0
+		if manager := byEmail[email].managerEmail(); manager != "" {
+			if _, inFile := byEmail[manager]; inFile {
+				path[email] = true
+				managerLevel, err := levelOf(manager, path)
+				delete(path, email)
+				if err != nil {
+					return 0, err
+				}
+				level = managerLevel + 1
+			}
+		}
+		levels[email] = level
+		return level, nil
+	}
+
+	var ordered [][]bulkUserRecord
+	for _, record := range records {
+		level, err := levelOf(strings.ToLower(record.Email), make(map[string]bool))
+		if err != nil {
+			return nil, err
+		}
+		for len(ordered) <= level {
+			ordered = append(ordered, nil)
+		}
+		ordered[level] = append(ordered[level], record)
+	}
+	return ordered, nil
+}
+
+// state returns the state of the user of a record, which defaults to active like the genesyscloud_user resource
+func (r bulkUserRecord) state() string {
+	if r.State == "" {
+		return "active"
+	}
+	return r.State
+}
+
+// buildBulkCreateUser builds the request body creating the user of a record. The attributes that can only be set once
+// the user exists are applied by updateBulkUserAttributes.
+func buildBulkCreateUser(record bulkUserRecord) platformclientv2.Createuser {
+	createUser := platformclientv2.Createuser{
+		Name:       platformclientv2.String(record.Name),
+		State:      platformclientv2.String(record.state()),
+		Title:      platformclientv2.String(record.Title),
+		Department: platformclientv2.String(record.Department),
+		Email:      platformclientv2.String(record.Email),
+	}
+	if record.DivisionId != "" {
+		createUser.DivisionId = platformclientv2.String(record.DivisionId)
+	}
+	return createUser
+}
+
+// buildBulkUpdateUser builds the request body updating the user of a record. The state is not included, as it must be
+// updated on its own.
+func buildBulkUpdateUser(record bulkUserRecord, managerId string) platformclientv2.Updateuser {
+	updateUser := platformclientv2.Updateuser{
+		Name:          platformclientv2.String(record.Name),
+		Department:    platformclientv2.String(record.Department),
+		Title:         platformclientv2.String(record.Title),
+		Manager:       platformclientv2.String(managerId),
+		AcdAutoAnswer: platformclientv2.Bool(record.AcdAutoAnswer),
+		Email:         platformclientv2.String(record.Email),
+	}
+	if record.Certifications != nil {
+		certifications := append([]string{}, record.Certifications...)
+		updateUser.Certifications = &certifications
+	}
+	return updateUser
+}
+
+func buildBulkUserOutcomes(users []interface{}) map[string]bulkUserOutcome {
+	outcomes := make(map[string]bulkUserOutcome)
+	for _, user := range users {
+		userMap := user.(map[string]interface{})
+		outcome := bulkUserOutcome{
+			Email: userMap["email"].(string),
+			Result: bulk.Result{
+				Id:         userMap["user_id"].(string),
+				Outcome:    userMap["outcome"].(string),
+				Error:      userMap["error"].(string),
+				RecordHash: userMap["record_hash"].(string),
+			},
+		}
+		outcomes[strings.ToLower(outcome.Email)] = outcome
+	}
+	return outcomes
+}
+
+func flattenBulkUserOutcomes(outcomes map[string]bulkUserOutcome) []interface{} {
+	emails := make([]string, 0, len(outcomes))
+	for email := range outcomes {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	users := make([]interface{}, 0, len(outcomes))
+	for _, email := range emails {
+		outcome := outcomes[email]
+		users = append(users, map[string]interface{}{
+			"email":       outcome.Email,
+			"user_id":     outcome.Id,
+			"outcome":     outcome.Outcome,
+			"error":       outcome.Error,
+			"record_hash": outcome.RecordHash,
+		})
+	}
+	return users
+}
+
+func GenerateUsersBulkResource(resourceID string, filepath string, deactivateMissing bool, destroyAction string) string {
+	return fmt.Sprintf(`resource "genesyscloud_users_bulk" "%s" {
+		filepath           = "%s"
+		file_content_hash  = filesha256("%s")
+		deactivate_missing = %v
+		destroy_action     = "%s"
+	}
+	`, resourceID, filepath, filepath, deactivateMissing, destroyAction)
+}
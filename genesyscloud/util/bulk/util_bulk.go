@@ -0,0 +1,178 @@
+package bulk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The bulk package holds the plumbing shared by the resources provisioning every item of a source file, such as
+genesyscloud_users_bulk and genesyscloud_telephony_providers_edges_phones_bulk. An item that fails to provision does not
+stop the others. The outcome of each item is stored in a list attribute of the resource instead, so that the failed
+items and the items deleted outside Terraform are provisioned again on the next apply.
+*/
+
+const (
+	OutcomeCreated   = "created"
+	OutcomeUpdated   = "updated"
+	OutcomeUnchanged = "unchanged"
+	OutcomeFailed    = "failed"
+	OutcomeMissing   = "missing"
+
+	// ListSeparator separates the values of list columns in a CSV source file
+	ListSeparator = ";"
+)
+
+// Result is the outcome of provisioning a single item, embedded in the outcome types of the bulk resources
+type Result struct {
+	// Id is the ID of the object provisioned for the item, set even if the object failed to update
+	Id         string
+	Outcome    string
+	Error      string
+	RecordHash string
+}
+
+// NeedsRetry reports whether the item failed or went missing since the last apply and should be provisioned again
+func (r Result) NeedsRetry() bool {
+	return r.Outcome == OutcomeFailed || r.Outcome == OutcomeMissing
+}
+
+// IsUnchanged reports whether the item was provisioned from the same record by the last apply and its object still exists
+func (r Result) IsUnchanged(existingId string, recordHash string) bool {
+	return existingId != "" && r.Id == existingId && r.RecordHash == recordHash && !r.NeedsRetry()
+}
+
+// Fail records the errors of an item that failed to provision. The record hash is cleared so that the item is
+// provisioned again even if its record does not change.
+func (r *Result) Fail(diags diag.Diagnostics) {
+	r.Outcome = OutcomeFailed
+	r.Error = DiagnosticsMessage(diags)
+	r.RecordHash = ""
+}
+
+// FlagMissing flags an item whose object was deleted outside Terraform, and reports whether it did
+func (r *Result) FlagMissing(existingIds map[string]bool) bool {
+	if r.Id == "" || r.Outcome == OutcomeFailed || r.Outcome == OutcomeMissing || existingIds[r.Id] {
+		return false
+	}
+	r.Outcome = OutcomeMissing
+	r.RecordHash = ""
+	return true
+}
+
+// RecordHash returns a digest of a record used to skip items that have not changed since the last apply
+func RecordHash(record interface{}) string {
+	// Maps are marshalled with sorted keys so the digest is stable
+	content, _ := json.Marshal(record)
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// SplitList splits the value of a list column, dropping empty values
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// DiagnosticsMessage joins the errors of diagnostics into the single line stored in the outcome of an item
+func DiagnosticsMessage(diags diag.Diagnostics) string {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", message, d.Detail)
+		}
+		messages = append(messages, message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// CustomizeDiff plans an update of the outcomes attribute when one of the source attributes changed or when items
+// failed or went missing, so that they are provisioned again even though the configuration did not change
+func CustomizeDiff(diff *schema.ResourceDiff, outcomesAttr string, sourceAttrs ...string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChanges(sourceAttrs...) {
+		return diff.SetNewComputed(outcomesAttr)
+	}
+	for _, item := range diff.Get(outcomesAttr).([]interface{}) {
+		itemMap := item.(map[string]interface{})
+		if (Result{Outcome: itemMap["outcome"].(string)}).NeedsRetry() {
+			log.Printf("%s of %s have failed or are missing, planning an update", outcomesAttr, diff.Id())
+			return diff.SetNewComputed(outcomesAttr)
+		}
+	}
+	return nil
+}
+
+// FailureWarning warns about the items that failed to provision, which are listed in the outcomes attribute
+func FailureWarning(resourceType string, id string, outcomesAttr string, failed []string, total int) diag.Diagnostics {
+	if len(failed) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%d of %d %s failed to provision", len(failed), total, outcomesAttr),
+		Detail:   fmt.Sprintf("See the %s attribute of %s %s for the errors. The failed %s are retried on the next apply: %s", outcomesAttr, resourceType, id, outcomesAttr, strings.Join(failed, ", ")),
+	}}
+}
+
+// ForEachConcurrently calls fn for every item with at most concurrency calls in flight and waits for all of them
+func ForEachConcurrently[T any](items []T, concurrency int, fn func(T)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, item := range items {
+		item := item
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			fn(item)
+		}()
+	}
+	wg.Wait()
+}
+
+// ForEachInBatches calls fn for every item, batchSize items at a time. Each batch starts once the previous one is done.
+func ForEachInBatches[T any](items []T, batchSize int, fn func(T)) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		var wg sync.WaitGroup
+		for _, item := range items[start:end] {
+			item := item
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fn(item)
+			}()
+		}
+		wg.Wait()
+	}
+}
@@ -0,0 +1,146 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBulkResult(t *testing.T) {
+	result := Result{Id: "id-1", Outcome: OutcomeCreated, RecordHash: "hash"}
+	assert.True(t, result.IsUnchanged("id-1", "hash"))
+	assert.False(t, result.IsUnchanged("id-1", "other-hash"))
+	assert.False(t, result.IsUnchanged("id-2", "hash"))
+	assert.False(t, result.IsUnchanged("", "hash"))
+
+	// Objects deleted outside Terraform are flagged once
+	assert.False(t, result.FlagMissing(map[string]bool{"id-1": true}))
+	assert.True(t, result.FlagMissing(map[string]bool{}))
+	assert.Equal(t, Result{Id: "id-1", Outcome: OutcomeMissing}, result)
+	assert.False(t, result.FlagMissing(map[string]bool{}))
+	assert.True(t, result.NeedsRetry())
+
+	result.Fail(diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "ignored"},
+		{Severity: diag.Error, Summary: "Failed to update", Detail: "not found"},
+		{Severity: diag.Error, Summary: "Failed to assign"},
+	})
+	assert.Equal(t, OutcomeFailed, result.Outcome)
+	assert.Equal(t, "Failed to update: not found; Failed to assign", result.Error)
+	assert.True(t, result.NeedsRetry())
+
+	// A failed item that never got an object is not missing
+	assert.False(t, (&Result{Outcome: OutcomeFailed}).FlagMissing(map[string]bool{}))
+}
+
+func TestUnitBulkSplitList(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, SplitList(" a ;; b;"))
+	assert.Nil(t, SplitList(""))
+}
+
+func TestUnitBulkCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filepath": {Type: schema.TypeString, Required: true},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"outcome": {Type: schema.TypeString, Computed: true},
+				}},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return CustomizeDiff(diff, "items", "filepath")
+		},
+	}
+	state := func(outcome string) *terraform.InstanceState {
+		return &terraform.InstanceState{ID: "bulk-id", Attributes: map[string]string{
+			"id":              "bulk-id",
+			"filepath":        "items.csv",
+			"items.#":         "1",
+			"items.0.outcome": outcome,
+		}}
+	}
+	config := func(path string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{"filepath": path})
+	}
+
+	diff, err := resource.SimpleDiff(context.Background(), state(OutcomeCreated), config("items.csv"), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Attributes)
+
+	diff, err = resource.SimpleDiff(context.Background(), state(OutcomeFailed), config("items.csv"), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["items.#"].NewComputed)
+
+	diff, err = resource.SimpleDiff(context.Background(), state(OutcomeCreated), config("other.csv"), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["items.#"].NewComputed)
+}
+
+func TestUnitBulkFailureWarning(t *testing.T) {
+	assert.Nil(t, FailureWarning("genesyscloud_users_bulk", "bulk-id", "users", nil, 3))
+
+	diags := FailureWarning("genesyscloud_users_bulk", "bulk-id", "users", []string{"jane@example.com"}, 3)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "1 of 3 users failed to provision", diags[0].Summary)
+	assert.Equal(t, "See the users attribute of genesyscloud_users_bulk bulk-id for the errors. The failed users are retried on the next apply: jane@example.com", diags[0].Detail)
+}
+
+func TestUnitBulkForEachConcurrently(t *testing.T) {
+	items := make([]int, 50)
+	var (
+		mutex    sync.Mutex
+		inFlight int
+		peak     int
+		calls    int
+	)
+	ForEachConcurrently(items, 3, func(int) {
+		mutex.Lock()
+		inFlight++
+		calls++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mutex.Unlock()
+
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+	})
+	assert.Equal(t, 50, calls)
+	assert.LessOrEqual(t, peak, 3)
+}
+
+func TestUnitBulkForEachInBatches(t *testing.T) {
+	items := make([]int, 7)
+	for i := range items {
+		items[i] = i
+	}
+	var (
+		mutex   sync.Mutex
+		batches [][]int
+		batch   []int
+	)
+	// Items of a batch run at the same time, so a batch is recorded once all of its items have been seen
+	ForEachInBatches(items, 3, func(item int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		batch = append(batch, item)
+		if len(batch) == 3 || item == 6 {
+			batches = append(batches, batch)
+			batch = nil
+		}
+	})
+	assert.Len(t, batches, 3)
+	assert.ElementsMatch(t, []int{0, 1, 2}, batches[0])
+	assert.ElementsMatch(t, []int{3, 4, 5}, batches[1])
+	assert.Equal(t, []int{6}, batches[2])
+}
@@ -0,0 +1,359 @@
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+The rrule package parses the iCal Recurrence Rules (RFC 5545) used by Genesys Cloud schedules and expands them into
+concrete occurrences. Only the rule parts commonly used by Genesys Cloud schedules are expanded. Other rule parts are
+accepted, so that rules Genesys Cloud accepts are not rejected, and are listed in Rule.UnsupportedParts.
+*/
+
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// maxOccurrences caps how many occurrences a single expansion can return
+const maxOccurrences = 10000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum is a BYDAY value. N is the optional ordinal, e.g. 2 for "2MO" (second Monday) or -1 for "-1FR" (last Friday). 0 means every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonth    []int
+	ByMonthDay []int
+	WeekStart  time.Weekday
+	// UnsupportedParts are the names of the rule parts that Between does not evaluate, e.g. BYSETPOS
+	UnsupportedParts []string
+}
+
+// Parse parses an RRULE string such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE". An optional "RRULE:" prefix is accepted.
+// Dates in UNTIL without a time zone are interpreted in loc. Parse only fails on rules that are malformed or set an invalid value.
+func Parse(input string, loc *time.Location) (*Rule, error) {
+	input = strings.TrimPrefix(strings.TrimSpace(input), "RRULE:")
+	if input == "" {
+		return nil, fmt.Errorf("rrule is empty")
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	rule := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(input, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return nil, fmt.Errorf("invalid rrule part '%s', expected NAME=VALUE", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("rrule part %s is set more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch value {
+			case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+				rule.Freq = value
+			default:
+				err = fmt.Errorf("unsupported FREQ '%s', expected one of DAILY, WEEKLY, MONTHLY, YEARLY", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parsePositiveInt(value)
+		case "COUNT":
+			rule.Count, err = parsePositiveInt(value)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseUntil(value, loc)
+			rule.Until = &until
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYMONTH":
+			rule.ByMonth, err = parseIntList(value, 1, 12, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(value, 1, 31, true)
+		case "WKST":
+			weekday, ok := weekdays[value]
+			if !ok {
+				err = fmt.Errorf("invalid weekday '%s'", value)
+			}
+			rule.WeekStart = weekday
+		default:
+			rule.UnsupportedParts = append(rule.UnsupportedParts, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("rrule must set FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("rrule must not set both COUNT and UNTIL")
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != FreqMonthly && rule.Freq != FreqYearly {
+			return nil, fmt.Errorf("invalid BYDAY: ordinal weekdays are only allowed with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+	return rule, nil
+}
+
+// Between returns the start of every occurrence of the rule that starts at or after from and before to.
+// dtstart is the start of the first occurrence, and the time of day of every occurrence. UnsupportedParts are ignored,
+// so callers that need exact occurrences should check that the rule has none.
+func (r *Rule) Between(dtstart, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+	if !to.After(from) {
+		return occurrences
+	}
+
+	loc := dtstart.Location()
+	startDay := time.Date(dtstart.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, loc)
+	matched := 0
+	for day := startDay; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !r.matches(day, startDay, dtstart) {
+			continue
+		}
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), loc)
+		if occurrence.Before(dtstart) {
+			continue
+		}
+		if r.Until != nil && occurrence.After(*r.Until) {
+			break
+		}
+		matched++
+		if r.Count > 0 && matched > r.Count {
+			break
+		}
+		if !occurrence.Before(from) && occurrence.Before(to) {
+			occurrences = append(occurrences, occurrence)
+			if len(occurrences) >= maxOccurrences {
+				break
+			}
+		}
+	}
+	return occurrences
+}
+
+// matches reports whether the rule has an occurrence on day
+func (r *Rule) matches(day, startDay, dtstart time.Time) bool {
+	switch r.Freq {
+	case FreqDaily:
+		if daysBetween(startDay, day)%r.Interval != 0 {
+			return false
+		}
+		return r.matchesByMonth(day) && r.matchesByMonthDay(day) && r.matchesByDay(day, false)
+	case FreqWeekly:
+		if daysBetween(r.weekStartOf(startDay), r.weekStartOf(day))/7%r.Interval != 0 {
+			return false
+		}
+		if !r.matchesByMonth(day) {
+			return false
+		}
+		if len(r.ByDay) == 0 {
+			return day.Weekday() == dtstart.Weekday()
+		}
+		return r.matchesByDay(day, false)
+	case FreqMonthly:
+		months := (day.Year()-startDay.Year())*12 + int(day.Month()) - int(startDay.Month())
+		if months%r.Interval != 0 || !r.matchesByMonth(day) {
+			return false
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			return day.Day() == dtstart.Day()
+		}
+		return r.matchesByMonthDay(day) && r.matchesByDay(day, false)
+	case FreqYearly:
+		if (day.Year()-startDay.Year())%r.Interval != 0 {
+			return false
+		}
+		if len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			return day.Month() == dtstart.Month() && day.Day() == dtstart.Day()
+		}
+		if !r.matchesByMonth(day) {
+			return false
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			return day.Day() == dtstart.Day()
+		}
+		// Ordinal weekdays count within the month when BYMONTH is set and within the year otherwise
+		return r.matchesByMonthDay(day) && r.matchesByDay(day, len(r.ByMonth) == 0)
+	}
+	return false
+}
+
+func (r *Rule) matchesByMonth(day time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, month := range r.ByMonth {
+		if int(day.Month()) == month {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesByMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+	for _, monthDay := range r.ByMonthDay {
+		if monthDay == day.Day() || (monthDay < 0 && daysInMonth+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesByDay(day time.Time, withinYear bool) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, weekdayNum := range r.ByDay {
+		if day.Weekday() != weekdayNum.Weekday {
+			continue
+		}
+		if weekdayNum.N == 0 {
+			return true
+		}
+
+		var first, last time.Time
+		if withinYear {
+			first = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+			last = time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, day.Location())
+		} else {
+			first = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+			last = time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location())
+		}
+		if weekdayNum.N > 0 && daysBetween(first, day)/7+1 == weekdayNum.N {
+			return true
+		}
+		if weekdayNum.N < 0 && daysBetween(day, last)/7+1 == -weekdayNum.N {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) weekStartOf(day time.Time) time.Time {
+	offset := (int(day.Weekday()) - int(r.WeekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// daysBetween returns the number of calendar days from a to b. It is not affected by daylight saving time changes.
+func daysBetween(a, b time.Time) int {
+	aDate := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	bDate := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(bDate.Sub(aDate).Hours() / 24)
+}
+
+func parsePositiveInt(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("'%s' must be a positive integer", value)
+	}
+	return number, nil
+}
+
+func parseIntList(value string, lowest, highest int, allowNegative bool) ([]int, error) {
+	var numbers []int
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", item)
+		}
+		magnitude := number
+		if allowNegative && number < 0 {
+			magnitude = -number
+		}
+		if magnitude < lowest || magnitude > highest {
+			return nil, fmt.Errorf("%s is not between %d and %d", item, lowest, highest)
+		}
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday '%s'", item)
+		}
+		weekday, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday '%s'", item)
+		}
+		weekdayNum := WeekdayNum{Weekday: weekday}
+		if ordinal := item[:len(item)-2]; ordinal != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(ordinal, "+"))
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid weekday ordinal '%s'", item)
+			}
+			weekdayNum.N = n
+		}
+		days = append(days, weekdayNum)
+	}
+	return days, nil
+}
+
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	layouts := []struct {
+		layout string
+		utc    bool
+	}{
+		{"20060102T150405Z", true},
+		{"20060102T150405", false},
+		{"20060102", false},
+	}
+	for _, l := range layouts {
+		parseLoc := loc
+		if l.utc {
+			parseLoc = time.UTC
+		}
+		if until, err := time.ParseInLocation(l.layout, value, parseLoc); err == nil {
+			if l.layout == "20060102" {
+				// A date-only UNTIL includes the whole day
+				until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("'%s' must be a date (YYYYMMDD) or a date-time (YYYYMMDDTHHMMSS or YYYYMMDDTHHMMSSZ)", value)
+}
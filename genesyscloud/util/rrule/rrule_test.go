@@ -0,0 +1,114 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnitParseRrule(t *testing.T) {
+	valid := []string{
+		"FREQ=DAILY;INTERVAL=1",
+		"FREQ=WEEKLY;BYDAY=SU",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR;WKST=SU",
+		"FREQ=MONTHLY;BYDAY=-1FR",
+		"FREQ=MONTHLY;BYMONTHDAY=-1,15",
+		"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25;UNTIL=20301231T000000Z",
+		"FREQ=DAILY;COUNT=10",
+		"FREQ=DAILY;INTERVAL=01",
+		"FREQ=YEARLY;INTERVAL=01;BYMONTH=12;BYMONTHDAY=06",
+	}
+	for _, input := range valid {
+		if _, err := Parse(input, time.UTC); err != nil {
+			t.Errorf("expected %s to be valid, got %v", input, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"INTERVAL=1",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;COUNT=3;UNTIL=20300101",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;BYSETPOS",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	}
+	for _, input := range invalid {
+		if _, err := Parse(input, time.UTC); err == nil {
+			t.Errorf("expected %s to be invalid", input)
+		}
+	}
+}
+
+func TestUnitParseRruleUnsupportedParts(t *testing.T) {
+	// Rule parts that are not expanded are accepted and listed
+	rule, err := Parse("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;BYHOUR=9", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rule.UnsupportedParts) != 2 || rule.UnsupportedParts[0] != "BYSETPOS" || rule.UnsupportedParts[1] != "BYHOUR" {
+		t.Errorf("expected unsupported parts BYSETPOS and BYHOUR, got %v", rule.UnsupportedParts)
+	}
+
+	rule, err = Parse("FREQ=DAILY;INTERVAL=02", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Interval != 2 || len(rule.UnsupportedParts) != 0 {
+		t.Errorf("expected an interval of 2 and no unsupported parts, got %d and %v", rule.Interval, rule.UnsupportedParts)
+	}
+}
+
+func TestUnitRruleBetween(t *testing.T) {
+	dtstart := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC) // A Monday
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		rrule    string
+		expected []string
+	}{
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", []string{"2024-01-01", "2024-01-03", "2024-01-15", "2024-01-17", "2024-01-29", "2024-01-31"}},
+		{"FREQ=DAILY;COUNT=3", []string{"2024-01-01", "2024-01-02", "2024-01-03"}},
+		{"FREQ=DAILY;INTERVAL=10;UNTIL=20240121", []string{"2024-01-01", "2024-01-11", "2024-01-21"}},
+		{"FREQ=MONTHLY;BYDAY=-1FR", []string{"2024-01-26"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", []string{"2024-01-31"}},
+		{"FREQ=MONTHLY;BYDAY=2TU", []string{"2024-01-09"}},
+		{"FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=15", []string{"2024-01-15"}},
+	}
+
+	for _, testCase := range testCases {
+		rule, err := Parse(testCase.rrule, time.UTC)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", testCase.rrule, err)
+		}
+		occurrences := rule.Between(dtstart, from, to)
+		if len(occurrences) != len(testCase.expected) {
+			t.Errorf("%s: expected %d occurrences, got %v", testCase.rrule, len(testCase.expected), occurrences)
+			continue
+		}
+		for i, occurrence := range occurrences {
+			if occurrence.Format("2006-01-02") != testCase.expected[i] || occurrence.Hour() != 9 {
+				t.Errorf("%s: expected occurrence %d to be %s at 09:00, got %v", testCase.rrule, i, testCase.expected[i], occurrence)
+			}
+		}
+	}
+}
+
+func TestUnitRruleBetweenCountsFromStart(t *testing.T) {
+	dtstart := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=DAILY;COUNT=5", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the last two of the five occurrences fall in the window
+	occurrences := rule.Between(dtstart, time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC), time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	if len(occurrences) != 2 {
+		t.Errorf("expected 2 occurrences, got %v", occurrences)
+	}
+}
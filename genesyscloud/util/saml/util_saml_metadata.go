@@ -0,0 +1,306 @@
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+The saml package lets the genesyscloud_idp_* resources be configured from the SAML metadata published by an identity provider.
+The metadata is resolved at plan time so that the certificates and endpoints it contains show up in the plan, and so that
+expired certificates are caught before anything is sent to Genesys Cloud.
+*/
+
+const (
+	bindingHttpRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	bindingHttpPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	defaultCertificateExpiryWarningDays = 30
+)
+
+// Metadata holds the parts of an identity provider's SAML metadata that Genesys Cloud needs
+type Metadata struct {
+	IssuerUri    string
+	TargetUri    string
+	SloUri       string
+	Certificates []string
+}
+
+type entityDescriptor struct {
+	XMLName        xml.Name
+	EntityId       string             `xml:"entityID,attr"`
+	IdpDescriptors []idpSsoDescriptor `xml:"IDPSSODescriptor"`
+	Entities       []entityDescriptor `xml:"EntityDescriptor"`
+}
+
+type idpSsoDescriptor struct {
+	KeyDescriptors       []keyDescriptor `xml:"KeyDescriptor"`
+	SingleSignOnServices []endpoint      `xml:"SingleSignOnService"`
+	SingleLogoutServices []endpoint      `xml:"SingleLogoutService"`
+}
+
+type keyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// MetadataXmlSchema is the schema of the metadata_xml attribute shared by the IdP resources
+func MetadataXmlSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:   "SAML metadata XML of the identity provider. When set, `issuer_uri`, `target_uri`, `slo_uri` and `certificates` are read from the metadata unless they are configured explicitly.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"metadata_url"},
+	}
+}
+
+// MetadataUrlSchema is the schema of the metadata_url attribute shared by the IdP resources
+func MetadataUrlSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:   "Local file path or URL of the SAML metadata of the identity provider. The file is read, or the URL downloaded, every time Terraform plans this resource, so planning fails while the URL cannot be reached. When set, `issuer_uri`, `target_uri`, `slo_uri` and `certificates` are read from the metadata unless they are configured explicitly.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"metadata_xml"},
+	}
+}
+
+// CertificateExpiryWarningDaysSchema is the schema of the certificate_expiry_warning_days attribute shared by the IdP resources
+func CertificateExpiryWarningDaysSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "Warn when applying this resource if a certificate expires within this number of days. Defaults to 30. Set to 0 to disable the warning. Expired certificates always fail the plan.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// ParseMetadata reads the first identity provider found in SAML metadata
+func ParseMetadata(reader io.Reader) (*Metadata, error) {
+	var root entityDescriptor
+	if err := xml.NewDecoder(reader).Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to parse SAML metadata: %v", err)
+	}
+
+	entities := []entityDescriptor{root}
+	if root.XMLName.Local == "EntitiesDescriptor" {
+		entities = root.Entities
+	}
+	for _, entity := range entities {
+		if len(entity.IdpDescriptors) == 0 {
+			continue
+		}
+		descriptor := entity.IdpDescriptors[0]
+		metadata := &Metadata{
+			IssuerUri: entity.EntityId,
+			TargetUri: preferredLocation(descriptor.SingleSignOnServices),
+			SloUri:    preferredLocation(descriptor.SingleLogoutServices),
+		}
+		for _, key := range descriptor.KeyDescriptors {
+			// Keys without a use may be used for both signing and encryption
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+			for _, certificate := range key.Certificates {
+				certificate = strings.Join(strings.Fields(certificate), "")
+				if certificate != "" && !contains(metadata.Certificates, certificate) {
+					metadata.Certificates = append(metadata.Certificates, certificate)
+				}
+			}
+		}
+		if metadata.IssuerUri == "" {
+			return nil, fmt.Errorf("SAML metadata has no entityID")
+		}
+		if len(metadata.Certificates) == 0 {
+			return nil, fmt.Errorf("SAML metadata of %s has no signing certificate", metadata.IssuerUri)
+		}
+		return metadata, nil
+	}
+	return nil, fmt.Errorf("SAML metadata has no IDPSSODescriptor")
+}
+
+// ReadMetadata parses the inline metadata XML or, when it is empty, the metadata at the given file path or URL
+func ReadMetadata(metadataXml string, metadataUrl string) (*Metadata, error) {
+	if metadataXml != "" {
+		return ParseMetadata(strings.NewReader(metadataXml))
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(metadataUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SAML metadata from %s: %v", metadataUrl, err)
+	}
+	if file != nil {
+		defer file.Close()
+	} else if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	return ParseMetadata(reader)
+}
+
+// ParseCertificate parses a PEM or base64 DER encoded certificate
+func ParseCertificate(certificate string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+		if err != nil {
+			return nil, fmt.Errorf("certificate is neither PEM nor base64 encoded DER: %v", err)
+		}
+		der = decoded
+	}
+
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %v", err)
+	}
+	return parsed, nil
+}
+
+// CustomizeIdpDiff fills the issuer, endpoints and certificates of an IdP resource from its SAML metadata, requires the
+// issuer and certificates without metadata, and fails the plan when one of the certificates of a new IdP or of changed
+// certificates has already expired
+func CustomizeIdpDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	metadataXml := diff.Get("metadata_xml").(string)
+	metadataUrl := diff.Get("metadata_url").(string)
+	metadataKnown := diff.NewValueKnown("metadata_xml") && diff.NewValueKnown("metadata_url")
+	if (metadataXml != "" || metadataUrl != "") && metadataKnown {
+		metadata, err := ReadMetadata(metadataXml, metadataUrl)
+		if err != nil {
+			return err
+		}
+
+		// Explicitly configured values take precedence over the metadata
+		values := map[string]interface{}{
+			"issuer_uri":   metadata.IssuerUri,
+			"target_uri":   metadata.TargetUri,
+			"slo_uri":      metadata.SloUri,
+			"certificates": metadata.Certificates,
+		}
+		for key, value := range values {
+			if isConfigured(diff, key) {
+				continue
+			}
+			if err := diff.SetNew(key, value); err != nil {
+				return err
+			}
+		}
+	} else if metadataKnown {
+		if diff.Get("issuer_uri").(string) == "" && !isConfigured(diff, "issuer_uri") {
+			return fmt.Errorf("issuer_uri must be set when neither metadata_xml nor metadata_url is set")
+		}
+		// Certificates that are not configured are unknown on create, configured ones are only unknown until they are computed
+		if len(diff.Get("certificates").([]interface{})) == 0 && (diff.NewValueKnown("certificates") || !isConfigured(diff, "certificates")) {
+			return fmt.Errorf("certificates must be set when neither metadata_xml nor metadata_url is set")
+		}
+	}
+
+	// An IdP whose certificate expired must not fail the plans of a workspace that leaves it unchanged
+	if !diff.NewValueKnown("certificates") || (diff.Id() != "" && !diff.HasChanges("certificates", "metadata_xml", "metadata_url")) {
+		return nil
+	}
+	now := time.Now()
+	for _, certificate := range diff.Get("certificates").([]interface{}) {
+		certificate, _ := certificate.(string)
+		parsed, err := ParseCertificate(certificate)
+		if err != nil {
+			// Genesys Cloud validates the format of the certificates, only their expiry is checked here
+			log.Printf("Unable to check the expiry of an IdP certificate: %v", err)
+			continue
+		}
+		if !now.Before(parsed.NotAfter) {
+			return fmt.Errorf("certificate %s expired on %s", describeCertificate(parsed), parsed.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// CertificateExpiryWarnings returns a warning for each certificate of the resource that expires within certificate_expiry_warning_days.
+// The attribute has no schema default so that imported resources match the configuration when it is not set.
+func CertificateExpiryWarnings(d *schema.ResourceData) diag.Diagnostics {
+	warningDays := defaultCertificateExpiryWarningDays
+	// 0 disables the warnings. More info about using deprecated GetOkExists: https://github.com/hashicorp/terraform-plugin-sdk/issues/817
+	if value, ok := d.GetOkExists("certificate_expiry_warning_days"); ok {
+		warningDays = value.(int)
+	}
+	return certificateExpiryWarnings(d.Get("certificates").([]interface{}), warningDays, time.Now())
+}
+
+func certificateExpiryWarnings(certificates []interface{}, warningDays int, now time.Time) diag.Diagnostics {
+	var warnings diag.Diagnostics
+	if warningDays == 0 {
+		return warnings
+	}
+	for _, certificate := range certificates {
+		certificate, _ := certificate.(string)
+		parsed, err := ParseCertificate(certificate)
+		if err != nil || now.AddDate(0, 0, warningDays).Before(parsed.NotAfter) {
+			continue
+		}
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("IdP certificate %s expires on %s", describeCertificate(parsed), parsed.NotAfter.Format(time.RFC3339)),
+			Detail:   fmt.Sprintf("The certificate expires within %d days. Update the certificate before it expires or users will not be able to sign in.", warningDays),
+		})
+	}
+	return warnings
+}
+
+// isConfigured reports whether the attribute is set in the configuration, as opposed to being computed or read from state
+func isConfigured(diff *schema.ResourceDiff, key string) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsKnown() && !rawConfig.IsNull() && rawConfig.Type().IsObjectType() {
+		return !rawConfig.GetAttr(key).IsNull()
+	}
+	_, ok := diff.GetOk(key)
+	return ok
+}
+
+// preferredLocation picks the HTTP-Redirect endpoint, falling back to HTTP-POST and then to the first endpoint
+func preferredLocation(endpoints []endpoint) string {
+	for _, binding := range []string{bindingHttpRedirect, bindingHttpPost} {
+		for _, e := range endpoints {
+			if e.Binding == binding {
+				return e.Location
+			}
+		}
+	}
+	if len(endpoints) > 0 {
+		return endpoints[0].Location
+	}
+	return ""
+}
+
+// describeCertificate names a certificate by its subject, or by its serial number when it has no subject
+func describeCertificate(certificate *x509.Certificate) string {
+	if subject := certificate.Subject.String(); subject != "" {
+		return fmt.Sprintf("%q", subject)
+	}
+	return "with serial number " + certificate.SerialNumber.String()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package saml
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateCertificate returns a base64 DER encoded self-signed certificate expiring at notAfter
+func generateCertificate(t *testing.T, commonName string, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func generateMetadata(signingCert, encryptionCert string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="http://www.okta.com/exk123">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+        %s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://example.okta.com/app/slo"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://example.okta.com/app/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://example.okta.com/app/sso/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, signingCert, encryptionCert)
+}
+
+func TestUnitParseSamlMetadata(t *testing.T) {
+	signingCert := generateCertificate(t, "signing", time.Now().AddDate(1, 0, 0))
+	encryptionCert := generateCertificate(t, "encryption", time.Now().AddDate(1, 0, 0))
+
+	// Certificates are often wrapped over several lines in metadata
+	var wrapped []string
+	for remaining := signingCert; remaining != ""; {
+		line := remaining
+		if len(line) > 64 {
+			line = line[:64]
+		}
+		wrapped = append(wrapped, line)
+		remaining = remaining[len(line):]
+	}
+
+	metadata, err := ParseMetadata(strings.NewReader(generateMetadata(strings.Join(wrapped, "\n"), encryptionCert)))
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		IssuerUri:    "http://www.okta.com/exk123",
+		TargetUri:    "https://example.okta.com/app/sso/redirect",
+		SloUri:       "https://example.okta.com/app/slo",
+		Certificates: []string{signingCert},
+	}, metadata)
+
+	// The identity provider is also found inside an EntitiesDescriptor
+	entities := `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">` +
+		`<EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>` +
+		strings.TrimPrefix(generateMetadata(signingCert, encryptionCert), `<?xml version="1.0" encoding="UTF-8"?>`) +
+		`</EntitiesDescriptor>`
+	metadata, err = ParseMetadata(strings.NewReader(entities))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://www.okta.com/exk123", metadata.IssuerUri)
+
+	_, err = ParseMetadata(strings.NewReader(`<EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>`))
+	assert.EqualError(t, err, "SAML metadata has no IDPSSODescriptor")
+
+	_, err = ParseMetadata(strings.NewReader("not xml"))
+	assert.Error(t, err)
+}
+
+func TestUnitReadSamlMetadataFromFile(t *testing.T) {
+	signingCert := generateCertificate(t, "signing", time.Now().AddDate(1, 0, 0))
+	path := filepath.Join(t.TempDir(), "metadata.xml")
+	assert.NoError(t, os.WriteFile(path, []byte(generateMetadata(signingCert, signingCert)), 0644))
+
+	metadata, err := ReadMetadata("", path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{signingCert}, metadata.Certificates)
+
+	_, err = ReadMetadata("", filepath.Join(t.TempDir(), "missing.xml"))
+	assert.Error(t, err)
+}
+
+func TestUnitParseCertificate(t *testing.T) {
+	notAfter := time.Now().AddDate(0, 6, 0).UTC().Truncate(time.Second)
+	certificate := generateCertificate(t, "idp", notAfter)
+
+	parsed, err := ParseCertificate(certificate)
+	assert.NoError(t, err)
+	assert.Equal(t, notAfter, parsed.NotAfter)
+
+	der, _ := base64.StdEncoding.DecodeString(certificate)
+	parsed, err = ParseCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	assert.NoError(t, err)
+	assert.Equal(t, "idp", parsed.Subject.CommonName)
+
+	_, err = ParseCertificate("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestUnitCertificateExpiryWarnings(t *testing.T) {
+	now := time.Now()
+	certificates := []interface{}{
+		generateCertificate(t, "soon", now.AddDate(0, 0, 10)),
+		generateCertificate(t, "later", now.AddDate(0, 0, 90)),
+		"not a certificate",
+	}
+
+	warnings := certificateExpiryWarnings(certificates, 30, now)
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0].Summary, `"CN=soon"`)
+
+	assert.Len(t, certificateExpiryWarnings(certificates, 120, now), 2)
+	assert.Empty(t, certificateExpiryWarnings(certificates, 0, now))
+}
+
+func TestUnitCertificateExpiryWarningDays(t *testing.T) {
+	certificates := []interface{}{generateCertificate(t, "soon", time.Now().AddDate(0, 0, 10))}
+
+	// Without certificate_expiry_warning_days the warning period is 30 days
+	d := schema.TestResourceDataRaw(t, testIdpResource().Schema, map[string]interface{}{
+		"certificates": certificates,
+	})
+	assert.Len(t, CertificateExpiryWarnings(d), 1)
+
+	d = schema.TestResourceDataRaw(t, testIdpResource().Schema, map[string]interface{}{
+		"certificates":                    certificates,
+		"certificate_expiry_warning_days": 0,
+	})
+	assert.Empty(t, CertificateExpiryWarnings(d))
+
+	d = schema.TestResourceDataRaw(t, testIdpResource().Schema, map[string]interface{}{
+		"certificates":                    certificates,
+		"certificate_expiry_warning_days": 5,
+	})
+	assert.Empty(t, CertificateExpiryWarnings(d))
+}
+
+// testIdpResource mirrors the attributes shared by the genesyscloud_idp_* resources
+func testIdpResource() *schema.Resource {
+	optionalComputed := func(valueType schema.ValueType) *schema.Schema {
+		s := &schema.Schema{Type: valueType, Optional: true, Computed: true}
+		if valueType == schema.TypeList {
+			s.Elem = &schema.Schema{Type: schema.TypeString}
+		}
+		return s
+	}
+	return &schema.Resource{
+		CustomizeDiff: CustomizeIdpDiff,
+		Schema: map[string]*schema.Schema{
+			"issuer_uri":                      optionalComputed(schema.TypeString),
+			"target_uri":                      optionalComputed(schema.TypeString),
+			"slo_uri":                         optionalComputed(schema.TypeString),
+			"certificates":                    optionalComputed(schema.TypeList),
+			"metadata_xml":                    MetadataXmlSchema(),
+			"metadata_url":                    MetadataUrlSchema(),
+			"certificate_expiry_warning_days": CertificateExpiryWarningDaysSchema(),
+		},
+	}
+}
+
+func TestUnitCustomizeIdpDiff(t *testing.T) {
+	signingCert := generateCertificate(t, "signing", time.Now().AddDate(1, 0, 0))
+	idpResource := testIdpResource()
+
+	diff, err := idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata_xml": generateMetadata(signingCert, signingCert),
+		"target_uri":   "https://example.com/explicit",
+	}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://www.okta.com/exk123", diff.Attributes["issuer_uri"].New)
+	assert.Equal(t, "https://example.com/explicit", diff.Attributes["target_uri"].New)
+	assert.Equal(t, "https://example.okta.com/app/slo", diff.Attributes["slo_uri"].New)
+	assert.Equal(t, signingCert, diff.Attributes["certificates.0"].New)
+
+	// Explicitly configured issuer and certificates also take precedence over the metadata
+	configuredCert := generateCertificate(t, "configured", time.Now().AddDate(1, 0, 0))
+	diff, err = idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata_xml": generateMetadata(signingCert, signingCert),
+		"issuer_uri":   "https://example.com/issuer",
+		"certificates": []interface{}{configuredCert},
+	}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/issuer", diff.Attributes["issuer_uri"].New)
+	assert.Equal(t, "https://example.okta.com/app/sso/redirect", diff.Attributes["target_uri"].New)
+	assert.Equal(t, "1", diff.Attributes["certificates.#"].New)
+	assert.Equal(t, configuredCert, diff.Attributes["certificates.0"].New)
+
+	// An expired certificate read from the metadata fails the plan
+	_, err = idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata_xml": generateMetadata(generateCertificate(t, "expired metadata", time.Now().AddDate(0, 0, -1)), signingCert),
+	}), nil)
+	assert.ErrorContains(t, err, `certificate "CN=expired metadata" expired on`)
+
+	_, err = idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"issuer_uri":   "https://example.com/issuer",
+		"certificates": []interface{}{generateCertificate(t, "expired", time.Now().AddDate(0, 0, -1))},
+	}), nil)
+	assert.ErrorContains(t, err, `certificate "CN=expired" expired on`)
+
+	_, err = idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"certificates": []interface{}{signingCert},
+	}), nil)
+	assert.EqualError(t, err, "issuer_uri must be set when neither metadata_xml nor metadata_url is set")
+
+	_, err = idpResource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"issuer_uri": "https://example.com/issuer",
+	}), nil)
+	assert.EqualError(t, err, "certificates must be set when neither metadata_xml nor metadata_url is set")
+
+	// The certificates of an existing IdP are only checked when they or the metadata change
+	expiredCert := generateCertificate(t, "expired", time.Now().AddDate(0, 0, -1))
+	state := &terraform.InstanceState{
+		ID: "idp",
+		Attributes: map[string]string{
+			"id":             "idp",
+			"issuer_uri":     "https://example.com/issuer",
+			"certificates.#": "1",
+			"certificates.0": expiredCert,
+		},
+	}
+	_, err = idpResource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"issuer_uri":   "https://example.com/issuer",
+		"certificates": []interface{}{expiredCert},
+	}), nil)
+	assert.NoError(t, err)
+
+	_, err = idpResource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"issuer_uri":   "https://example.com/issuer",
+		"certificates": []interface{}{expiredCert, signingCert},
+	}), nil)
+	assert.ErrorContains(t, err, `certificate "CN=expired" expired on`)
+}
@@ -1,6 +1,8 @@
 package stringmap
 
 import (
+	"sort"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -90,3 +92,13 @@ func MergeSingularMaps[T, U comparable](m1, m2 map[T]U) map[T]U {
 
 	return result
 }
+
+// SortedKeys returns the keys of a map in ascending order, so that messages and attributes built from the map are stable
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
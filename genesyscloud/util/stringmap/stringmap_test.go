@@ -57,3 +57,14 @@ func TestUnitMergeMapsStrings(t *testing.T) {
 		t.Errorf("Unexpected result (-want +got):\n%s", diff)
 	}
 }
+
+func TestUnitSortedKeys(t *testing.T) {
+	result := SortedKeys(map[string]int{"queue": 1, "group": 2, "user": 3})
+	expected := []string{"group", "queue", "user"}
+	if diff := cmp.Diff(expected, result); diff != "" {
+		t.Errorf("Unexpected result (-want +got):\n%s", diff)
+	}
+	if result := SortedKeys(map[string]bool{}); len(result) != 0 {
+		t.Errorf("Expected no keys, got %v", result)
+	}
+}
@@ -36,6 +36,8 @@ func FlattenTelephonyProperties(properties interface{}) (string, diag.Diagnostic
 	return string(propertiesBytes), nil
 }
 
+// CustomizePhoneBaseSettingsPropertiesDiff validates the properties against the schema of the phone metabase and fills in
+// the properties not set in the configuration from the current phone base settings
 func CustomizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	// Defaults must be set on missing properties
 	if !diff.NewValueKnown("properties") {
@@ -43,12 +45,17 @@ func CustomizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return nil
 	}
 
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	configMap, err := normalizedConfigProperties(diff, "phone_meta_base_id", "phone", getPhoneMetabaseProperties, sdkConfig)
+	if err != nil {
+		return err
+	}
+
 	id := diff.Id()
 	if id == "" {
-		return nil
+		return setNormalizedProperties(diff, configMap)
 	}
 
-	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
 	// Retrieve defaults from the settings
@@ -60,9 +67,11 @@ func CustomizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return fmt.Errorf("failed to read phone base settings %s: %s", id, getErr)
 	}
 
-	return applyPropertyDefaults(diff, phoneBaseSetting.Properties)
+	return applyPropertyDefaults(diff, configMap, phoneBaseSetting.Properties)
 }
 
+// CustomizeTrunkBaseSettingsPropertiesDiff validates the properties against the schema of the trunk metabase and fills in
+// the properties not set in the configuration from the current trunk base settings
 func CustomizeTrunkBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	// Defaults must be set on missing properties
 	if !diff.NewValueKnown("properties") {
@@ -70,12 +79,17 @@ func CustomizeTrunkBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return nil
 	}
 
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	configMap, err := normalizedConfigProperties(diff, "trunk_meta_base_id", "trunk", getTrunkMetabaseProperties, sdkConfig)
+	if err != nil {
+		return err
+	}
+
 	id := diff.Id()
 	if id == "" {
-		return nil
+		return setNormalizedProperties(diff, configMap)
 	}
 
-	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
 	// Retrieve defaults from the settings
@@ -87,10 +101,12 @@ func CustomizeTrunkBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return fmt.Errorf("failed to read phone base settings %s: %s", id, getErr)
 	}
 
-	return applyPropertyDefaults(diff, trunkBaseSetting.Properties)
+	return applyPropertyDefaults(diff, configMap, trunkBaseSetting.Properties)
 }
 
-func applyPropertyDefaults(diff *schema.ResourceDiff, properties *map[string]interface{}) error {
+// normalizedConfigProperties parses the properties of the configuration and, when the metabase is known, validates and
+// normalizes them against the metabase schema
+func normalizedConfigProperties(diff *schema.ResourceDiff, metabaseAttr, kind string, getProperties metabasePropertiesFunc, sdkConfig *platformclientv2.Configuration) (map[string]interface{}, error) {
 	// Parse resource properties into map
 	propertiesJson := diff.Get("properties").(string)
 	configMap := map[string]interface{}{}
@@ -98,9 +114,42 @@ func applyPropertyDefaults(diff *schema.ResourceDiff, properties *map[string]int
 		propertiesJson = "{}" // empty object by default
 	}
 	if err := json.Unmarshal([]byte(propertiesJson), &configMap); err != nil {
-		return fmt.Errorf("failure to parse properties for %s: %s", diff.Id(), err)
+		return nil, fmt.Errorf("failure to parse properties for %s: %s", diff.Id(), err)
 	}
 
+	metabaseId, _ := diff.Get(metabaseAttr).(string)
+	if !diff.NewValueKnown(metabaseAttr) || metabaseId == "" {
+		// The metabase is not known until apply. The properties are checked by the API.
+		return configMap, nil
+	}
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() && rawConfig.Type().IsObjectType() && rawConfig.GetAttr("properties").IsNull() {
+		// Only the properties set in the configuration are checked. The others are read from Genesys Cloud.
+		return configMap, nil
+	}
+	schemas, err := loadMetabaseSchema(kind, getProperties, sdkConfig, metabaseId)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeBaseSettingsProperties(kind, metabaseId, configMap, schemas); err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+// setNormalizedProperties plans the normalized properties. Keys are sorted when marshalled, so the plan shows the
+// properties that change instead of a replacement of the whole JSON document.
+func setNormalizedProperties(diff *schema.ResourceDiff, configMap map[string]interface{}) error {
+	if diff.Get("properties").(string) == "" {
+		return nil
+	}
+	result, err := json.Marshal(configMap)
+	if err != nil {
+		return fmt.Errorf("failure to marshal properties for %s: %s", diff.Id(), err)
+	}
+	return diff.SetNew("properties", string(result))
+}
+
+func applyPropertyDefaults(diff *schema.ResourceDiff, configMap map[string]interface{}, properties *map[string]interface{}) error {
 	// For each property in the schema, check if a value is set in the config
 	if properties != nil {
 		for name, prop := range *properties {
@@ -108,17 +157,32 @@ func applyPropertyDefaults(diff *schema.ResourceDiff, properties *map[string]int
 				// Just set a default value if the property wasn't specified
 				configMap[name] = prop
 			} else {
-				configMapProp := configMap[name].(map[string]interface{})
+				configMapProp, ok := configMap[name].(map[string]interface{})
+				if !ok {
+					continue
+				}
 				// Get the instance value from the config
-				instance := configMapProp["value"].(map[string]interface{})["instance"]
+				configValue, ok := configMapProp["value"].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				instance := configValue["instance"]
 				if instance == nil {
 					continue
 				}
+				apiProp, ok := prop.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				apiValue, ok := apiProp["value"].(map[string]interface{})
+				if !ok {
+					continue
+				}
 
 				// Assign the property from the API to the config
 				configMap[name] = prop
 				// Overwrite the instance because that's all we need to set
-				configMap[name].(map[string]interface{})["value"].(map[string]interface{})["instance"] = instance
+				apiValue["instance"] = instance
 			}
 		}
 	}
@@ -152,5 +216,13 @@ func CustomizePhonePropertiesDiff(ctx context.Context, diff *schema.ResourceDiff
 		}
 		return fmt.Errorf("failed to read phone %s: %s", id, getErr)
 	}
-	return applyPropertyDefaults(diff, phone.Properties)
+	propertiesJson := diff.Get("properties").(string)
+	configMap := map[string]interface{}{}
+	if propertiesJson == "" {
+		propertiesJson = "{}" // empty object by default
+	}
+	if err := json.Unmarshal([]byte(propertiesJson), &configMap); err != nil {
+		return fmt.Errorf("failure to parse properties for %s: %s", diff.Id(), err)
+	}
+	return applyPropertyDefaults(diff, configMap, phone.Properties)
 }
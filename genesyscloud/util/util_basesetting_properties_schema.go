@@ -0,0 +1,272 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+/*
+util_basesetting_properties_schema.go validates the properties of trunk and phone base settings against the schema of
+their metabase. The template of a metabase lists every property the metabase supports together with its type, allowed
+values and default value, so property names, types and values can be checked when the plan is created.
+*/
+
+// basePropertySchema is the schema of a single metabase property
+type basePropertySchema struct {
+	Type    string
+	Enum    []interface{}
+	Minimum *float64
+	Maximum *float64
+	Items   *basePropertySchema
+}
+
+// metabasePropertiesFunc returns the properties of the template of a metabase
+type metabasePropertiesFunc func(sdkConfig *platformclientv2.Configuration, metabaseId string) (*map[string]interface{}, *platformclientv2.APIResponse, error)
+
+var (
+	// Package variables so the API calls can be replaced in unit tests
+	getTrunkMetabaseProperties metabasePropertiesFunc = func(sdkConfig *platformclientv2.Configuration, metabaseId string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		template, resp, err := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig).GetTelephonyProvidersEdgesTrunkbasesettingsTemplate(metabaseId)
+		if err != nil {
+			return nil, resp, err
+		}
+		return template.Properties, resp, nil
+	}
+	getPhoneMetabaseProperties metabasePropertiesFunc = func(sdkConfig *platformclientv2.Configuration, metabaseId string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		template, resp, err := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig).GetTelephonyProvidersEdgesPhonebasesettingsTemplate(metabaseId)
+		if err != nil {
+			return nil, resp, err
+		}
+		return template.Properties, resp, nil
+	}
+
+	// Metabase schemas do not change while the provider runs, so they are loaded once per metabase
+	metabaseSchemaCache   = make(map[string]map[string]basePropertySchema)
+	metabaseSchemaCacheMu sync.Mutex
+)
+
+// loadMetabaseSchema returns the property schemas of a metabase. kind is used to tell trunk and phone metabases apart in
+// the cache and in errors.
+func loadMetabaseSchema(kind string, getProperties metabasePropertiesFunc, sdkConfig *platformclientv2.Configuration, metabaseId string) (map[string]basePropertySchema, error) {
+	cacheKey := kind + "/" + metabaseId
+	metabaseSchemaCacheMu.Lock()
+	defer metabaseSchemaCacheMu.Unlock()
+	if schemas, ok := metabaseSchemaCache[cacheKey]; ok {
+		return schemas, nil
+	}
+
+	properties, resp, err := getProperties(sdkConfig, metabaseId)
+	if err != nil {
+		if IsStatus404(resp) {
+			return nil, fmt.Errorf("%s metabase %s does not exist", kind, metabaseId)
+		}
+		return nil, fmt.Errorf("failed to read the template of %s metabase %s: %s", kind, metabaseId, err)
+	}
+	schemas := make(map[string]basePropertySchema)
+	if properties != nil {
+		for name, property := range *properties {
+			if propertyMap, ok := property.(map[string]interface{}); ok {
+				schemas[name] = parseBasePropertySchema(propertyMap)
+			}
+		}
+	}
+	metabaseSchemaCache[cacheKey] = schemas
+	return schemas, nil
+}
+
+// parseBasePropertySchema reads the schema of a property from its template. The type is taken from the default value
+// when the template does not declare it.
+func parseBasePropertySchema(property map[string]interface{}) basePropertySchema {
+	propertySchema := basePropertySchema{}
+	if propertyType, ok := property["type"].(string); ok {
+		propertySchema.Type = propertyType
+	} else if value, ok := property["value"].(map[string]interface{}); ok {
+		propertySchema.Type = jsonTypeOf(value["default"])
+	}
+	if enum, ok := property["enum"].([]interface{}); ok {
+		propertySchema.Enum = enum
+	}
+	if minimum, ok := property["minimum"].(float64); ok {
+		propertySchema.Minimum = &minimum
+	}
+	if maximum, ok := property["maximum"].(float64); ok {
+		propertySchema.Maximum = &maximum
+	}
+	if items, ok := property["items"].(map[string]interface{}); ok {
+		itemsSchema := parseBasePropertySchema(items)
+		propertySchema.Items = &itemsSchema
+	}
+	return propertySchema
+}
+
+// jsonTypeOf returns the JSON schema type of a decoded JSON value, or an empty string for null
+func jsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return ""
+}
+
+// normalizeBaseSettingsProperties checks the properties set in the configuration against the metabase schema and
+// converts instance values written as strings to the type of the property, e.g. "25" to 25 for integer properties.
+// All problems are returned in a single error so they can be fixed at once.
+func normalizeBaseSettingsProperties(kind, metabaseId string, configMap map[string]interface{}, schemas map[string]basePropertySchema) error {
+	names := make([]string, 0, len(configMap))
+	for name := range configMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	knownNames := make([]string, 0, len(schemas))
+	for name := range schemas {
+		knownNames = append(knownNames, name)
+	}
+
+	var problems []string
+	for _, name := range names {
+		propertySchema, ok := schemas[name]
+		if !ok {
+			problem := fmt.Sprintf("property %q is not defined by %s metabase %s", name, kind, metabaseId)
+			if match, found := ClosestMatch(name, knownNames); found {
+				problem += fmt.Sprintf(", did you mean %q?", match)
+			}
+			problems = append(problems, problem)
+			continue
+		}
+
+		property, ok := configMap[name].(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("property %q must be an object with a value", name))
+			continue
+		}
+		if property["value"] == nil {
+			continue
+		}
+		value, ok := property["value"].(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("property %q must have a value object holding the instance", name))
+			continue
+		}
+		instance, set := value["instance"]
+		if !set || instance == nil {
+			continue
+		}
+
+		instance = coerceBasePropertyValue(instance, propertySchema)
+		if err := validateBasePropertyValue(instance, propertySchema); err != nil {
+			problems = append(problems, fmt.Sprintf("property %q: %s", name, err))
+			continue
+		}
+		value["instance"] = instance
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid properties:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// coerceBasePropertyValue converts strings holding numbers or booleans to the type of the property. Values that cannot
+// be converted are returned unchanged so the validation can report them.
+func coerceBasePropertyValue(value interface{}, propertySchema basePropertySchema) interface{} {
+	switch propertySchema.Type {
+	case "integer", "number":
+		if s, ok := value.(string); ok {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				return f
+			}
+		}
+	case "boolean":
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+				return b
+			}
+		}
+	case "array":
+		if items, ok := value.([]interface{}); ok && propertySchema.Items != nil {
+			coerced := make([]interface{}, len(items))
+			for i, item := range items {
+				coerced[i] = coerceBasePropertyValue(item, *propertySchema.Items)
+			}
+			return coerced
+		}
+	}
+	return value
+}
+
+// validateBasePropertyValue checks the type, allowed values and range of an instance value
+func validateBasePropertyValue(value interface{}, propertySchema basePropertySchema) error {
+	valueType := jsonTypeOf(value)
+	switch propertySchema.Type {
+	case "":
+		// Properties without a declared or default type accept any value
+	case "number":
+		if valueType != "integer" && valueType != "number" {
+			return fmt.Errorf("expected a number, got %s", describeJsonType(valueType))
+		}
+	default:
+		if valueType != propertySchema.Type {
+			return fmt.Errorf("expected %s, got %s", describeJsonType(propertySchema.Type), describeJsonType(valueType))
+		}
+	}
+
+	if len(propertySchema.Enum) > 0 && !enumContains(propertySchema.Enum, value) {
+		allowed := make([]string, 0, len(propertySchema.Enum))
+		for _, e := range propertySchema.Enum {
+			allowed = append(allowed, fmt.Sprintf("%v", e))
+		}
+		return fmt.Errorf("%v is not one of the allowed values %s", value, strings.Join(allowed, ", "))
+	}
+	if number, ok := value.(float64); ok {
+		if propertySchema.Minimum != nil && number < *propertySchema.Minimum {
+			return fmt.Errorf("%v is less than the minimum %v", number, *propertySchema.Minimum)
+		}
+		if propertySchema.Maximum != nil && number > *propertySchema.Maximum {
+			return fmt.Errorf("%v is greater than the maximum %v", number, *propertySchema.Maximum)
+		}
+	}
+	if items, ok := value.([]interface{}); ok && propertySchema.Items != nil {
+		for i, item := range items {
+			if err := validateBasePropertyValue(item, *propertySchema.Items); err != nil {
+				return fmt.Errorf("element %d: %s", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func enumContains(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if fmt.Sprintf("%v", e) == fmt.Sprintf("%v", value) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeJsonType(jsonType string) string {
+	switch jsonType {
+	case "":
+		return "null"
+	case "array", "object", "integer":
+		return "an " + jsonType
+	}
+	return "a " + jsonType
+}
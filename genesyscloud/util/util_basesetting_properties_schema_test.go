@@ -0,0 +1,130 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+const testTrunkMetabaseTemplate = `{
+	"trunk_label": {"type": "string", "value": {"default": null, "instance": null}},
+	"trunk_transport_sip_dscp_value": {"type": "integer", "minimum": 0, "maximum": 63, "value": {"default": 24, "instance": 24}},
+	"trunk_media_disconnect_on_idle_rtp": {"type": "boolean", "value": {"default": true, "instance": true}},
+	"trunk_transport_protocol": {"type": "string", "enum": ["UDP", "TCP", "TLS"], "value": {"default": "UDP", "instance": "UDP"}},
+	"trunk_media_codec": {"type": "array", "items": {"type": "string", "enum": ["audio/pcmu", "audio/pcma", "audio/opus"]}, "value": {"default": ["audio/pcmu"], "instance": ["audio/pcmu"]}},
+	"trunk_max_dial_timeout": {"value": {"default": "1m", "instance": "1m"}}
+}`
+
+func testTrunkBaseSettingsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"trunk_meta_base_id": {Type: schema.TypeString, Required: true},
+			"properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: SuppressEquivalentJsonDiffs,
+			},
+		},
+		CustomizeDiff: CustomizeTrunkBaseSettingsPropertiesDiff,
+	}
+}
+
+func stubTrunkMetabaseProperties(t *testing.T) {
+	original := getTrunkMetabaseProperties
+	getTrunkMetabaseProperties = func(sdkConfig *platformclientv2.Configuration, metabaseId string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		properties := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(testTrunkMetabaseTemplate), &properties); err != nil {
+			t.Fatal(err)
+		}
+		return &properties, nil, nil
+	}
+	metabaseSchemaCache = make(map[string]map[string]basePropertySchema)
+	t.Cleanup(func() {
+		getTrunkMetabaseProperties = original
+		metabaseSchemaCache = make(map[string]map[string]basePropertySchema)
+	})
+}
+
+func planTrunkProperties(properties string) (*terraform.InstanceDiff, error) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"trunk_meta_base_id": "external_sip_pcv_byoc_carrier.json",
+		"properties":         properties,
+	})
+	meta := &provider.ProviderMeta{ClientConfig: platformclientv2.GetDefaultConfiguration()}
+	return testTrunkBaseSettingsResource().SimpleDiff(context.Background(), nil, config, meta)
+}
+
+func TestUnitBaseSettingsPropertiesNormalized(t *testing.T) {
+	stubTrunkMetabaseProperties(t)
+
+	diff, err := planTrunkProperties(`{
+		"trunk_transport_sip_dscp_value": {"value": {"instance": "46"}},
+		"trunk_label": {"value": {"instance": "Carrier A"}},
+		"trunk_media_disconnect_on_idle_rtp": {"value": {"instance": "false"}},
+		"trunk_media_codec": {"value": {"instance": ["audio/opus", "audio/pcmu"]}}
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	planned := diff.Attributes["properties"].New
+	expected := `{"trunk_label":{"value":{"instance":"Carrier A"}},` +
+		`"trunk_media_codec":{"value":{"instance":["audio/opus","audio/pcmu"]}},` +
+		`"trunk_media_disconnect_on_idle_rtp":{"value":{"instance":false}},` +
+		`"trunk_transport_sip_dscp_value":{"value":{"instance":46}}}`
+	if planned != expected {
+		t.Errorf("expected normalized properties %s, got %s", expected, planned)
+	}
+}
+
+func TestUnitBaseSettingsPropertiesInvalid(t *testing.T) {
+	stubTrunkMetabaseProperties(t)
+
+	_, err := planTrunkProperties(`{
+		"trunk_lable": {"value": {"instance": "Carrier A"}},
+		"trunk_transport_sip_dscp_value": {"value": {"instance": 64}},
+		"trunk_transport_protocol": {"value": {"instance": "SCTP"}},
+		"trunk_media_codec": {"value": {"instance": ["audio/g729"]}},
+		"trunk_media_disconnect_on_idle_rtp": {"value": {"instance": "sometimes"}},
+		"trunk_max_dial_timeout": {"value": {"instance": 60}}
+	}`)
+	if err == nil {
+		t.Fatal("expected the invalid properties to fail the plan")
+	}
+	for _, expected := range []string{
+		`"trunk_lable" is not defined by trunk metabase external_sip_pcv_byoc_carrier.json, did you mean "trunk_label"?`,
+		`"trunk_transport_sip_dscp_value": 64 is greater than the maximum 63`,
+		`"trunk_transport_protocol": SCTP is not one of the allowed values UDP, TCP, TLS`,
+		`"trunk_media_codec": element 0: audio/g729 is not one of the allowed values`,
+		`"trunk_media_disconnect_on_idle_rtp": expected a boolean, got a string`,
+		`"trunk_max_dial_timeout": expected a string, got an integer`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestUnitBaseSettingsPropertiesUnknownMetabase(t *testing.T) {
+	original := getTrunkMetabaseProperties
+	getTrunkMetabaseProperties = func(sdkConfig *platformclientv2.Configuration, metabaseId string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: 404}, fmt.Errorf("API Error: 404 - metabase not found")
+	}
+	metabaseSchemaCache = make(map[string]map[string]basePropertySchema)
+	t.Cleanup(func() {
+		getTrunkMetabaseProperties = original
+		metabaseSchemaCache = make(map[string]map[string]basePropertySchema)
+	})
+
+	_, err := planTrunkProperties(`{"trunk_label": {"value": {"instance": "Carrier A"}}}`)
+	if err == nil || !strings.Contains(err.Error(), "trunk metabase external_sip_pcv_byoc_carrier.json does not exist") {
+		t.Errorf("expected an unknown metabase error, got %v", err)
+	}
+}
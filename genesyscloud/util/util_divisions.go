@@ -67,3 +67,38 @@ func UpdateObjectDivision(d *schema.ResourceData, objType string, sdkConfig *pla
 	}
 	return nil
 }
+
+// DivisionObjectMoveFunc moves objects of one type into a division with a single call to the division object-move API
+type DivisionObjectMoveFunc func(divisionID string, objType string, objectIDs []string) (*platformclientv2.APIResponse, error)
+
+// MoveObjectsToDivision moves objects of one type into a division in batches of batchSize. When a batch fails, its objects
+// are moved one at a time so that only the objects that could not be moved are returned, mapped to their error.
+func MoveObjectsToDivision(move DivisionObjectMoveFunc, divisionID string, objType string, objectIDs []string, batchSize int) map[string]error {
+	failed := make(map[string]error)
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	for start := 0; start < len(objectIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(objectIDs) {
+			end = len(objectIDs)
+		}
+		batch := objectIDs[start:end]
+
+		log.Printf("Moving %d %s objects to division %s", len(batch), objType, divisionID)
+		if _, err := move(divisionID, objType, batch); err == nil {
+			continue
+		} else if len(batch) == 1 {
+			failed[batch[0]] = err
+			continue
+		}
+
+		log.Printf("Failed to move a batch of %s objects to division %s, moving them one at a time", objType, divisionID)
+		for _, id := range batch {
+			if _, err := move(divisionID, objType, []string{id}); err != nil {
+				failed[id] = err
+			}
+		}
+	}
+	return failed
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v143/platformclientv2"
+)
+
+func TestUnitMoveObjectsToDivision(t *testing.T) {
+	var calls [][]string
+	move := func(divisionID string, objType string, objectIDs []string) (*platformclientv2.APIResponse, error) {
+		calls = append(calls, objectIDs)
+		for _, id := range objectIDs {
+			if id == "locked" {
+				return nil, fmt.Errorf("object %s cannot be moved", id)
+			}
+		}
+		return nil, nil
+	}
+
+	failed := MoveObjectsToDivision(move, "division", "QUEUE", []string{"a", "b", "locked", "c", "d"}, 2)
+
+	expectedCalls := [][]string{{"a", "b"}, {"locked", "c"}, {"locked"}, {"c"}, {"d"}}
+	if !reflect.DeepEqual(calls, expectedCalls) {
+		t.Errorf("expected calls %v, got %v", expectedCalls, calls)
+	}
+	if len(failed) != 1 || failed["locked"] == nil {
+		t.Errorf("expected only the locked object to fail, got %v", failed)
+	}
+}
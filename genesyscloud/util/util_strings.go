@@ -45,3 +45,57 @@ func GetUniqueString() string {
 	hasher.Write([]byte(uuid.NewString()))
 	return strconv.FormatUint(uint64(hasher.Sum32()), 10)
 }
+
+// ClosestMatch returns the candidate with the smallest edit distance to target, ignoring case. ok is false when no
+// candidate is close enough to be a likely typo of target.
+func ClosestMatch(target string, candidates []string) (match string, ok bool) {
+	lowerTarget := strings.ToLower(target)
+	best := -1
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(lowerTarget, strings.ToLower(candidate))
+		if best == -1 || distance < best || (distance == best && candidate < match) {
+			best = distance
+			match = candidate
+		}
+	}
+	// Allow roughly one edit for every three characters
+	maxDistance := len(target)/3 + 1
+	if best == -1 || best > maxDistance {
+		return "", false
+	}
+	return match, true
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if previous[j-1]+cost < current[j] {
+				current[j] = previous[j-1] + cost
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// StringValue returns the value of an optional string of the SDK, or an empty string if it is not set
+func StringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
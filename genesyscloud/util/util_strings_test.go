@@ -0,0 +1,30 @@
+package util
+
+import "testing"
+
+func TestUnitClosestMatch(t *testing.T) {
+	candidates := []string{"queue", "skill", "wrapupCode", "language"}
+
+	if match, ok := ClosestMatch("queu", candidates); !ok || match != "queue" {
+		t.Errorf("expected queue, got %s (%t)", match, ok)
+	}
+	if match, ok := ClosestMatch("WrapUpCode", candidates); !ok || match != "wrapupCode" {
+		t.Errorf("expected wrapupCode, got %s (%t)", match, ok)
+	}
+	if match, ok := ClosestMatch("conversation", candidates); ok {
+		t.Errorf("expected no match, got %s", match)
+	}
+	if _, ok := ClosestMatch("queue", nil); ok {
+		t.Errorf("expected no match without candidates")
+	}
+}
+
+func TestUnitStringValue(t *testing.T) {
+	value := "queue"
+	if result := StringValue(&value); result != "queue" {
+		t.Errorf("expected queue, got %s", result)
+	}
+	if result := StringValue(nil); result != "" {
+		t.Errorf("expected an empty string, got %s", result)
+	}
+}
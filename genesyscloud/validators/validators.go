@@ -13,6 +13,7 @@ import (
 
 	files "terraform-provider-genesyscloud/genesyscloud/util/files"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	recurrence "terraform-provider-genesyscloud/genesyscloud/util/rrule"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -89,6 +90,14 @@ func ValidateRrule(rrule interface{}, _ cty.Path) diag.Diagnostics {
 				}
 			}
 		}
+
+		// Parse the rule so that malformed rule parts and invalid values are caught at plan time. Rule parts the parser
+		// does not expand, e.g. BYSETPOS, are accepted.
+		if input != "" {
+			if _, err := recurrence.Parse(input, time.UTC); err != nil {
+				return diag.Errorf("Invalid rrule %s: %v", input, err)
+			}
+		}
 		return nil
 	}
 	return diag.Errorf("Provided rrule %v is not in string format", rrule)
@@ -2,6 +2,8 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
 	"sync"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
@@ -15,6 +17,7 @@ import (
 	architectSchedules "terraform-provider-genesyscloud/genesyscloud/architect_schedules"
 	userPrompt "terraform-provider-genesyscloud/genesyscloud/architect_user_prompt"
 	authDivision "terraform-provider-genesyscloud/genesyscloud/auth_division"
+	authDivisionAssignment "terraform-provider-genesyscloud/genesyscloud/auth_division_assignment"
 	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
 	authorizatioProduct "terraform-provider-genesyscloud/genesyscloud/authorization_product"
 	integrationInstagram "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_integrations_instagram"
@@ -22,7 +25,9 @@ import (
 	cMessageSettingsDefault "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_settings_default"
 	supportedContent "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_supportedcontent"
 	cmSupportedContentDefault "terraform-provider-genesyscloud/genesyscloud/conversations_messaging_supportedcontent_default"
+	effectiveAccess "terraform-provider-genesyscloud/genesyscloud/effective_access"
 	employeeperformanceExternalmetricsDefinition "terraform-provider-genesyscloud/genesyscloud/employeeperformance_externalmetrics_definitions"
+	expiringCredentials "terraform-provider-genesyscloud/genesyscloud/expiring_credentials"
 	externalContacts "terraform-provider-genesyscloud/genesyscloud/external_contacts"
 	flowLogLevel "terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
 	flowMilestone "terraform-provider-genesyscloud/genesyscloud/flow_milestone"
@@ -81,6 +86,7 @@ import (
 	knowledgeDocument "terraform-provider-genesyscloud/genesyscloud/knowledge_document"
 	location "terraform-provider-genesyscloud/genesyscloud/location"
 	routingQueueConditionalGroupRouting "terraform-provider-genesyscloud/genesyscloud/routing_queue_conditional_group_routing"
+	routingQueueMembers "terraform-provider-genesyscloud/genesyscloud/routing_queue_members"
 	routingQueueOutboundEmailAddress "terraform-provider-genesyscloud/genesyscloud/routing_queue_outbound_email_address"
 	routingSettings "terraform-provider-genesyscloud/genesyscloud/routing_settings"
 	routingSkill "terraform-provider-genesyscloud/genesyscloud/routing_skill"
@@ -89,6 +95,7 @@ import (
 	routingUtilization "terraform-provider-genesyscloud/genesyscloud/routing_utilization"
 	routingUtilizationLabel "terraform-provider-genesyscloud/genesyscloud/routing_utilization_label"
 	"terraform-provider-genesyscloud/genesyscloud/scripts"
+	siteDialPlan "terraform-provider-genesyscloud/genesyscloud/site_dial_plan"
 	"terraform-provider-genesyscloud/genesyscloud/station"
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
 	workitem "terraform-provider-genesyscloud/genesyscloud/task_management_workitem"
@@ -98,9 +105,11 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/team"
 	"terraform-provider-genesyscloud/genesyscloud/telephony_provider_edges_trunkbasesettings"
 	did "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did"
+	didInventory "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_inventory"
 	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
 	edgeGroup "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_edge_group"
 	extPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_extension_pool"
+	edgesHealth "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_health"
 	lineBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_linebasesettings"
 	edgePhone "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phone"
 	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
@@ -145,6 +154,15 @@ var resourceExporters map[string]*resourceExporter.ResourceExporter
 func main() {
 	var debugMode bool
 
+	// Commands that act on an org directly rather than serving the provider
+	if len(os.Args) > 1 && os.Args[1] == emergencyGroup.CommandName {
+		if err := emergencyGroup.RunCommand(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
@@ -173,6 +191,7 @@ func registerResources() {
 	regInstance := &RegisterInstance{}
 	authRole.SetRegistrar(regInstance)                                     //Registering auth_role
 	authDivision.SetRegistrar(regInstance)                                 //Registering auth_division
+	authDivisionAssignment.SetRegistrar(regInstance)                       //Registering auth_division_assignment
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
@@ -234,6 +253,8 @@ func registerResources() {
 	routingEmailRoute.SetRegistrar(regInstance)                            //Registering routing email route
 	did.SetRegistrar(regInstance)                                          //Registering telephony did
 	didPool.SetRegistrar(regInstance)                                      //Registering telephony did pools
+	didInventory.SetRegistrar(regInstance)                                 //Registering telephony did inventory
+	siteDialPlan.SetRegistrar(regInstance)                                 //Registering site dial plan test
 	archIvr.SetRegistrar(regInstance)                                      //Registering architect ivr
 	workbin.SetRegistrar(regInstance)                                      //Registering task management workbin
 	workitemSchema.SetRegistrar(regInstance)                               //Registering task management workitem schema
@@ -244,9 +265,12 @@ func registerResources() {
 	team.SetRegistrar(regInstance)                                         //Registering team
 	telephony_provider_edges_trunkbasesettings.SetRegistrar(regInstance)   //Registering telephony_provider_edges_trunkbasesettings package
 	edgeGroup.SetRegistrar(regInstance)                                    //Registering edges edge group
+	edgesHealth.SetRegistrar(regInstance)                                  //Registering edges edge and trunk health
 	webDeployConfig.SetRegistrar(regInstance)                              //Registering webdeployments_config
 	webDeployDeploy.SetRegistrar(regInstance)                              //Registering webdeployments_deploy
 	authorizatioProduct.SetRegistrar(regInstance)                          //Registering Authorization Product
+	effectiveAccess.SetRegistrar(regInstance)                              //Registering effective access
+	expiringCredentials.SetRegistrar(regInstance)                          //Registering expiring credentials
 	extPool.SetRegistrar(regInstance)                                      //Registering Extension Pool
 	phoneBaseSettings.SetRegistrar(regInstance)                            //Registering Phone Base Settings
 	lineBaseSettings.SetRegistrar(regInstance)                             //Registering Line Base Settings
@@ -260,6 +284,7 @@ func registerResources() {
 	routingQueue.SetRegistrar(regInstance)                                 //Registering routing queue
 	routingQueueConditionalGroupRouting.SetRegistrar(regInstance)          //Registering routing queue conditional group routing
 	routingQueueOutboundEmailAddress.SetRegistrar(regInstance)             //Registering routing queue outbound email address
+	routingQueueMembers.SetRegistrar(regInstance)                          //Registering routing queue members
 	outboundContactListContact.SetRegistrar(regInstance)                   //Registering outbound contact list contact
 	routingSettings.SetRegistrar(regInstance)                              //Registering routing Settings
 	routingUtilization.SetRegistrar(regInstance)                           //Registering routing utilization